@@ -70,6 +70,7 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.Mountpath) {
 	}()
 
 	g.checkEnable(action, mi)
+	dlMpath(mi.Path, true /*enable*/)
 
 	tstats := g.t.statsT.(*stats.Trunner)
 	for _, disk := range mi.Disks {
@@ -130,6 +131,7 @@ func (g *fsprungroup) doDD(action string, flags uint64, mpath string, dontResilv
 	if err != nil || rmi == nil {
 		return nil, err
 	}
+	dlMpath(rmi.Path, false /*enable*/)
 	if numAvail == 0 {
 		nlog.Errorf("%s: lost (via %q) the last available mountpath %q", g.t.si, action, rmi)
 		g.postDD(rmi, action, nil /*xaction*/, nil /*error*/) // go ahead to disable/detach
@@ -437,6 +437,7 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		PubNet:     pubAddr,
 		ControlNet: ctrlAddr,
 		DataNet:    dataAddr,
+		Weight:     config.Weight, // (travels with the join request; see HrwName2TWeighted)
 	}
 	if l := len(pubExtra); l > 0 {
 		h.si.PubExtra = make([]meta.NetInfo, l)
@@ -758,6 +759,7 @@ func (h *htrun) notifyTerm(n core.Notif, err error, aborted bool) {
 	h._nfy(n, err, apc.Finished, aborted)
 }
 func (h *htrun) notifyProgress(n core.Notif) { h._nfy(n, nil, apc.Progress, false) }
+func (h *htrun) notifyStarted(n core.Notif)  { h._nfy(n, nil, apc.Started, false) }
 
 func (h *htrun) _nfy(n core.Notif, err error, upon string, aborted bool) {
 	var (
@@ -767,7 +769,7 @@ func (h *htrun) _nfy(n core.Notif, err error, upon string, aborted bool) {
 		args  = allocBcArgs()
 		nodes = args.selected
 	)
-	debug.Assert(upon == apc.Progress || upon == apc.Finished)
+	debug.Assert(upon == apc.Progress || upon == apc.Finished || upon == apc.Started)
 	if len(dsts) == 1 && dsts[0] == equalIC {
 		for pid, psi := range smap.Pmap {
 			if smap.IsIC(psi) && pid != h.si.ID() && !psi.InMaintOrDecomm() {
@@ -194,11 +194,14 @@ func (ic *ic) xstatusAll(w http.ResponseWriter, r *http.Request, query url.Value
 			if err := nl.Err(); err != nil {
 				status.ErrMsg = err.Error()
 			}
+			_describe(nl, status)
 			vec = append(vec, *status)
 		}
 	} else {
 		for _, nl := range nls {
-			vec = append(vec, *nl.Status())
+			status := nl.Status()
+			_describe(nl, status)
+			vec = append(vec, *status)
 		}
 	}
 	b := cos.MustMarshal(vec)
@@ -253,6 +256,20 @@ func (ic *ic) xstatusOne(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// long polling (compare with api.WaitForXactionIC)
+	if s := r.URL.Query().Get(apc.QparamLongPoll); s != "" {
+		maxWait, err := time.ParseDuration(s)
+		if err != nil || maxWait <= 0 || maxWait > maxLongPollWait {
+			ic.p.writeErrStatusf(w, r, http.StatusBadRequest, "invalid %s=%q (expecting duration in the range (0, %v])",
+				apc.QparamLongPoll, s, maxLongPollWait)
+			return
+		}
+		if !ic.p.notifs.waitFinished(r.Context(), nl, maxWait) {
+			ic.p.writeErrStatusf(w, r, http.StatusTooManyRequests, "too many long-polling clients (max %d)", maxLongPollWaiters)
+			return
+		}
+	}
+
 	// refresh NotifStatus
 	var (
 		config   = cmn.GCO.Get()
@@ -264,11 +281,19 @@ func (ic *ic) xstatusOne(w http.ResponseWriter, r *http.Request) {
 	if err := nl.Err(); err != nil {
 		status.ErrMsg = err.Error()
 	}
-	b := cos.MustMarshal(status) // TODO: include stats, e.g., progress when ready
+	_describe(nl, status)
+	b := cos.MustMarshal(status)
 	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
 	w.Write(b)
 }
 
+func _describe(nl nl.Listener, status *nl.Status) {
+	nl.RLock()
+	status.Desc = nl.Describe()
+	status.Pct, status.PctApprox = nl.Pct()
+	nl.RUnlock()
+}
+
 // verb /v1/ic
 func (ic *ic) handler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
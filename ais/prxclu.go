@@ -74,6 +74,8 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.ic.xstatusAll(w, r, query)
 	case apc.WhatQueryXactStats:
 		p.xquery(w, r, what, query)
+	case apc.WhatJobsSummary:
+		p.writeJSON(w, r, p.notifs.summary(), what)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
 	case apc.WhatNodeStats:
@@ -82,6 +84,8 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatObjLocate:
+		p.qcluLocate(w, r, what)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -232,6 +236,74 @@ func (p *proxy) qcluSysinfo(w http.ResponseWriter, r *http.Request, what string,
 	p.writeJSON(w, r, out, what)
 }
 
+// GET /v1/cluster/bucket-name/object-name?what=obj_locate
+// HRW-scored list of all targets vs. targets that actually store the object
+// (the two differ when, for instance, rebalance is pending or in progress)
+func (p *proxy) qcluLocate(w http.ResponseWriter, r *http.Request, what string) {
+	apireq := apiReqAlloc(2, apc.URLPathClu.L, false /*dpq*/)
+	defer apiReqFree(apireq)
+	if err := p.parseReq(w, r, apireq); err != nil {
+		return
+	}
+	bckArgs := bctx{p: p, w: w, r: r, bck: apireq.bck, perms: apc.AceObjHEAD, query: apireq.query, dontHeadRemote: true}
+	bck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	var (
+		objName = apireq.items[1]
+		smap    = p.owner.smap.get()
+		uname   = bck.MakeUname(objName)
+		out     = &cmn.ObjLocation{Bck: *bck.Bucket(), Name: objName}
+	)
+	owner, err := smap.HrwName2T(uname)
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	scored := smap.HrwTargetScores(uname)
+	out.Targets = make([]cmn.ObjLocTarget, len(scored))
+	for i, s := range scored {
+		out.Targets[i] = cmn.ObjLocTarget{
+			ID:    s.Node.ID(),
+			Score: s.Score,
+			Owner: s.Node.ID() == owner.ID(),
+			Maint: s.Node.InMaintOrDecomm(),
+		}
+	}
+
+	// ask all targets (including those in maintenance) - local lookup only, no side effects
+	q := bck.NewQuery()
+	q.Set(apc.QparamSilent, "true")
+	q.Set(apc.QparamFltPresence, strconv.Itoa(apc.FltPresent))
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodHead, Path: apc.URLPathObjects.Join(bck.Name, objName), Query: q}
+	args.to = core.Targets
+	args.smap = smap
+	args.ignoreMaintenance = true
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	hdrLoc := cmn.PropToHeader("location")
+	for _, res := range results {
+		if res.err != nil {
+			if res.status != http.StatusNotFound {
+				nlog.Warningln(p.String(), "locate", bck.Cname(objName), "at", res.si.StringEx(), "err:", res.err)
+			}
+			continue
+		}
+		for i := range out.Targets {
+			if out.Targets[i].ID == res.si.ID() {
+				out.Targets[i].Present = true
+				out.Targets[i].Location = res.header.Get(hdrLoc)
+				break
+			}
+		}
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
 func (p *proxy) getRemAisVec(refresh bool) (*meta.RemAisVec, error) {
 	smap := p.owner.smap.get()
 	si, errT := smap.GetRandTarget()
@@ -744,13 +816,14 @@ func (p *proxy) rereg(nsi, osi *meta.Snode) bool {
 	if !p.NodeStarted() {
 		return true
 	}
-	if osi.Eq(nsi) && osi.Flags == nsi.Flags {
+	if osi.Eq(nsi) && osi.Flags == nsi.Flags && osi.Weight == nsi.Weight {
 		nlog.Infoln(p.String(), "node", nsi.StringEx(), "is already _in_ - nothing to do")
 		return false
 	}
 
 	// NOTE: also ref0417 (ais/earlystart)
-	nlog.Warningf("%s: renewing %s(flags %s) => %s(flags %s)", p, osi.StringEx(), osi.Fl2S(), nsi.StringEx(), nsi.Fl2S())
+	nlog.Warningf("%s: renewing %s(flags %s, weight %d) => %s(flags %s, weight %d)", p, osi.StringEx(), osi.Fl2S(), osi.Weight,
+		nsi.StringEx(), nsi.Fl2S(), nsi.Weight)
 	return true
 }
 
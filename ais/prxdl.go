@@ -5,9 +5,12 @@
 package ais
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"sort"
 	"strconv"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/nl"
@@ -66,6 +70,10 @@ func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 			p.writeErrAct(w, r, items[0])
 			return
 		}
+		if items[0] == apc.Remove && msg.ObjName != "" {
+			p.writeErrf(w, r, "cannot remove a single object (%q) of the download job %q (abort it instead)", msg.ObjName, msg.ID)
+			return
+		}
 	}
 	if msg.ID != "" && p.ic.redirectToIC(w, r) {
 		return
@@ -81,15 +89,22 @@ func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 
 // POST /v1/download
 func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == apc.URLPathDownloadStream.S {
+		p.dlstream(w, r)
+		return
+	}
 	if _, err := p.parseURL(w, r, apc.URLPathDownload.L, 0, false); err != nil {
 		return
 	}
 
-	jobID := dload.PrefixJobID + cos.GenUUID() // prefix to visually differentiate vs. xaction IDs
-
-	body, err := cos.ReadAllN(r.Body, r.ContentLength)
+	encoding := r.Header.Get(cos.HdrContentEncoding)
+	body, err := dload.ReadRequest(r.Body, encoding, r.ContentLength)
 	if err != nil {
-		p.writeErrStatusf(w, r, http.StatusInternalServerError, "failed to receive download request: %v", err)
+		ecode := http.StatusInternalServerError
+		if encoding != "" {
+			ecode = http.StatusBadRequest
+		}
+		p.writeErrStatusf(w, r, ecode, "failed to receive download request: %v", err)
 		return
 	}
 	dlb, dlBase, ok := p.validateDownload(w, r, body)
@@ -97,6 +112,21 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// next page of an open multi-object job: broadcast to append (targets validate)
+	payload, err := dload.ParseAppendRequest(dlb)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if payload != nil {
+		if _, ecode, err := p.dlstart(r, cos.GenUUID(), payload.JobID, body); err != nil {
+			p.writeErrStatusf(w, r, ecode, "Error appending to download job %q: %v", payload.JobID, err)
+			return
+		}
+		p.dlpostResp(w, &dload.DlPostResp{ID: payload.JobID})
+		return
+	}
+
 	var progressInterval = dload.DownloadProgressInterval
 	if dlBase.ProgressInterval != "" {
 		ival, err := time.ParseDuration(dlBase.ProgressInterval)
@@ -107,25 +137,69 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 		progressInterval = ival
 	}
 
-	xid := cos.GenUUID()
-	if ecode, err := p.dlstart(r, xid, jobID, body); err != nil {
-		p.writeErrStatusf(w, r, ecode, "Error starting download: %v", err)
-		return
+	var (
+		jobID = dload.PrefixJobID + cos.GenUUID() // prefix to visually differentiate vs. xaction IDs
+		xid   = cos.GenUUID()
+	)
+	failed, ecode, err := p.dlstart(r, xid, jobID, body)
+	smap := p.owner.smap.get()
+	tmap := smap.Tmap.ActiveMap()
+	if err != nil {
+		for tid := range failed {
+			delete(tmap, tid)
+		}
+		if dlBase.OnPartial != dload.PartialAccept || len(tmap) == 0 {
+			p.writeErrStatusf(w, r, ecode, "Error starting download: %v", err)
+			return
+		}
+		nlog.Warningf("%s: download job %s started on %d target(s), failed on %d: %v", p, jobID, len(tmap), len(failed), err)
 	}
 
 	// HACK:
 	// download _job_ vs download xaction, see abortReq() in ais/prxnotif
-	smap := p.owner.smap.get()
 	nl := dload.NewDownloadNL(
 		jobID,            // jobID != xid
 		string(dlb.Type), // instead of apc.ActDownload xaction kind
-		&smap.Smap,
+		tmap,
 		progressInterval,
 	)
+	resp := &dload.DlPostResp{ID: jobID, Renamed: dload.Renamed(&dlb)}
+	if len(failed) > 0 {
+		bck := meta.CloneBck(&dlBase.Bck)
+		nl.DispatchErrs = dload.DispatchErrs(&dlb, bck, &smap.Smap, failed)
+		resp.Partial = true
+		for tid := range failed {
+			resp.FailedTargets = append(resp.FailedTargets, tid)
+		}
+		slices.Sort(resp.FailedTargets)
+	}
 	nl.SetOwner(equalIC)
 	p.ic.registerEqual(regIC{nl: nl, smap: smap})
 
-	b := cos.MustMarshal(dload.DlPostResp{ID: jobID})
+	p.dlpostResp(w, resp)
+}
+
+// POST /v1/download/stream
+// transient (stream-through) download: redirect to any target that then fetches from the origin
+// and streams the content back without storing (the request body is resent by the client)
+func (p *proxy) dlstream(w http.ResponseWriter, r *http.Request) {
+	if cmn.GCO.Get().Downloader.MaxStreamSize <= 0 {
+		p.writeErrStatusf(w, r, http.StatusForbidden, "%s: stream-through download is disabled (see 'downloader.max_stream_size')", p)
+		return
+	}
+	smap := p.owner.smap.get()
+	tsi, err := smap.GetRandTarget()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+// (the job ID also serves as the cursor to append more objects - see `MultiBody.Append`)
+func (*proxy) dlpostResp(w http.ResponseWriter, resp *dload.DlPostResp) {
+	b := cos.MustMarshal(resp)
 	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
 	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
 	w.Write(b)
@@ -164,6 +238,8 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 
 	var (
 		validResponses = make([]*callResult, 0, respCnt) // TODO: avoid allocation
+		errs           []error
+		ecode          int
 		err            error
 	)
 	for _, res := range results {
@@ -172,17 +248,26 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 			continue
 		}
 		if res.status != http.StatusNotFound {
-			return nil, res.status, res.err
+			// report all failed targets (with the most severe status)
+			errs = append(errs, fmt.Errorf("%s: %w", res.si, res.err))
+			ecode = max(ecode, res.status)
+			continue
 		}
 		notFoundCnt++
 		err = res.err
 	}
+	if len(errs) > 0 {
+		return nil, ecode, errors.Join(errs...)
+	}
 	if notFoundCnt == respCnt { // All responded with 404.
 		return nil, http.StatusNotFound, err
 	}
 
 	switch method {
 	case http.MethodGet:
+		if msg.History {
+			return dlhistory(validResponses)
+		}
 		if msg.ID == "" {
 			// If ID is empty, return the list of downloads
 			aggregate := make(map[string]*dload.Job)
@@ -218,6 +303,9 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 			}
 			stResp = stResp.Aggregate(&status)
 		}
+		if dnl, ok := p.notifs.entry(msg.ID).(*dload.NotifDownloadListerner); ok {
+			stResp = dnl.AddDispatchErrs(stResp)
+		}
 		body := cos.MustMarshal(stResp)
 		return body, http.StatusOK, nil
 	case http.MethodDelete:
@@ -229,6 +317,33 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 	}
 }
 
+// aggregate per-target summaries of completed jobs (most recently finished first)
+func dlhistory(results []*callResult) ([]byte, int, error) {
+	aggregate := make(map[string]*dload.HistEntry)
+	for _, res := range results {
+		if len(res.bytes) == 0 {
+			continue
+		}
+		var parsed map[string]*dload.HistEntry
+		if err := jsoniter.Unmarshal(res.bytes, &parsed); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		for id, entry := range parsed {
+			if prev, ok := aggregate[id]; ok {
+				prev.Aggregate(entry)
+			} else {
+				aggregate[id] = entry
+			}
+		}
+	}
+	hist := make(dload.HistEntries, 0, len(aggregate))
+	for _, entry := range aggregate {
+		hist = append(hist, entry)
+	}
+	sort.Sort(hist)
+	return cos.MustMarshal(hist), http.StatusOK, nil
+}
+
 func (p *proxy) dlstatus(nl nl.Listener, config *cmn.Config) []byte {
 	// bcast
 	p.notifs.bcastGetStats(nl, config.Periodic.NotifTime.D())
@@ -250,11 +365,14 @@ func (p *proxy) dlstatus(nl nl.Listener, config *cmn.Config) []byte {
 		resp = resp.Aggregate(dlStatus)
 		return true
 	})
-
+	if dnl, ok := nl.(*dload.NotifDownloadListerner); ok {
+		resp = dnl.AddDispatchErrs(resp)
+	}
 	return cos.MustMarshal(resp)
 }
 
-func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (ecode int, err error) {
+// returns the targets that failed to start the job, if any (and the first failure)
+func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (failed map[string]error, ecode int, err error) {
 	var (
 		config = cmn.GCO.Get()
 		query  = make(url.Values, 2)
@@ -270,10 +388,14 @@ func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (ecode
 
 	ecode = http.StatusOK
 	for _, res := range results {
-		if res.err != nil {
+		if res.err == nil {
+			continue
+		}
+		if failed == nil {
+			failed = make(map[string]error, 2)
 			ecode, err = res.status, res.err
-			break
 		}
+		failed[res.si.ID()] = res.err
 	}
 	freeBcastRes(results)
 	return
@@ -290,11 +412,113 @@ func (p *proxy) validateDownload(w http.ResponseWriter, r *http.Request, body []
 		p.writeErr(w, r, err)
 		return
 	}
+	if err := dlBase.NameRule.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := dlBase.CksumManifest.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if _, err := dload.ParseDeadline(dlBase.Deadline, time.Now()); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if _, err := dload.ParseStartAfter(dlBase.StartAfter, time.Now()); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := dload.ValidateOnPartial(dlBase.OnPartial); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
 	bck := meta.CloneBck(&dlBase.Bck)
 	args := bctx{p: p, w: w, r: r, reqBody: body, bck: bck, perms: apc.AccessRW}
 	args.createAIS = true
-	if _, err := args.initAndTry(); err == nil {
+	bck, err := args.initAndTry()
+	if err != nil {
+		return
+	}
+	dlBase.Bck = *bck.Bucket() // (initialized)
+	switch dlb.Type {
+	case dload.TypeBackend:
+		ok = p.validateDlBuckets(w, r, &dlb, body)
+	case dload.TypeRange:
+		ok = p.validateDlRange(w, r, &dlb)
+	case dload.TypeMulti:
+		ok = p.validateDlMulti(w, r, &dlb, &dlBase.Bck, body)
+	default:
 		ok = true
 	}
 	return
 }
+
+// range download: fail early (before broadcasting) if the template expands to an unexpected
+// or excessive number of objects
+func (p *proxy) validateDlRange(w http.ResponseWriter, r *http.Request, dlb *dload.Body) bool {
+	var payload dload.RangeBody
+	if err := jsoniter.Unmarshal(dlb.RawMessage, &payload); err != nil {
+		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "download message", cos.BHead(dlb.RawMessage), err)
+		p.writeErr(w, r, err)
+		return false
+	}
+	if err := payload.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return false
+	}
+	config := cmn.GCO.Get()
+	if _, err := payload.ParseTemplate(config.Downloader.MaxRangeCount()); err != nil {
+		p.writeErr(w, r, err)
+		return false
+	}
+	return true
+}
+
+// mixed-provider multi-download: validate (and, if need be, add to BMD) the same-named
+// buckets of the per-object providers
+func (p *proxy) validateDlMulti(w http.ResponseWriter, r *http.Request, dlb *dload.Body, bck *cmn.Bck, body []byte) bool {
+	var payload dload.MultiBody
+	if err := jsoniter.Unmarshal(dlb.RawMessage, &payload); err != nil {
+		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "download message", cos.BHead(dlb.RawMessage), err)
+		p.writeErr(w, r, err)
+		return false
+	}
+	if len(payload.ObjProviders) == 0 {
+		return true
+	}
+	if err := payload.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return false
+	}
+	payload.Bck = *bck
+	for _, b := range payload.ProviderBcks() {
+		args := bctx{p: p, w: w, r: r, reqBody: body, bck: meta.CloneBck(&b), perms: apc.AccessRW}
+		args.createAIS = true
+		if _, err := args.initAndTry(); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// multi-bucket backend download: validate (and, if need be, add to BMD) all the buckets
+func (p *proxy) validateDlBuckets(w http.ResponseWriter, r *http.Request, dlb *dload.Body, body []byte) bool {
+	var payload dload.BackendBody
+	if err := jsoniter.Unmarshal(dlb.RawMessage, &payload); err != nil {
+		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "download message", cos.BHead(dlb.RawMessage), err)
+		p.writeErr(w, r, err)
+		return false
+	}
+	if err := payload.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return false
+	}
+	for i := range payload.Buckets {
+		bck := meta.CloneBck(&payload.Buckets[i].Bck)
+		args := bctx{p: p, w: w, r: r, reqBody: body, bck: bck, perms: apc.AccessRW}
+		if _, err := args.initAndTry(); err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,6 +35,12 @@ import (
 
 const notifsName = "p-notifs"
 
+// long polling (see `waitFinished`)
+const (
+	maxLongPollWaiters = 1024
+	maxLongPollWait    = 5 * time.Minute
+)
+
 type (
 	listeners struct {
 		m   map[string]nl.Listener // [UUID => NotifListener]
@@ -41,10 +48,22 @@ type (
 		mtx sync.RWMutex
 	}
 
+	// clients blocked waiting for listeners to finish
+	waiters struct {
+		m   map[string]*waiter // [UUID => waiter]
+		cnt atomic.Int32       // total number of clients (bounded by `maxLongPollWaiters`)
+		mu  sync.Mutex
+	}
+	waiter struct {
+		ch   chan struct{} // closed upon finishing
+		refs int
+	}
+
 	notifs struct {
 		p   *proxy
 		nls *listeners // running
 		fin *listeners // finished
+		lpw waiters    // long polling
 
 		added    []nl.Listener // reusable slice of `nl` to add to `nls`
 		removed  []nl.Listener // reusable slice of `nl` to remove from `nls`
@@ -54,6 +73,8 @@ type (
 
 		smapVer int64
 		mu      sync.Mutex
+
+		pausedAll atomic.Bool // skip stats sync for all listeners (see `pause`)
 	}
 	jsonNotifs struct {
 		Running  []*notifListenMsg `json:"running"`
@@ -103,7 +124,7 @@ func (n *notifs) init(p *proxy) {
 }
 
 // handle other nodes' notifications
-// verb /v1/notifs/[progress|finished] - apc.Progress and apc.Finished, respectively
+// verb /v1/notifs/[started|progress|finished] - apc.Started, apc.Progress, and apc.Finished, respectively
 func (n *notifs) handler(w http.ResponseWriter, r *http.Request) {
 	var (
 		notifMsg = &core.NotifMsg{}
@@ -120,7 +141,7 @@ func (n *notifs) handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if apiItems[0] != apc.Progress && apiItems[0] != apc.Finished {
+	if apiItems[0] != apc.Progress && apiItems[0] != apc.Finished && apiItems[0] != apc.Started {
 		n.p.writeErrf(w, r, "Invalid route /notifs/%s", apiItems[0])
 		return
 	}
@@ -139,17 +160,15 @@ func (n *notifs) handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var (
-		srcs    = nl.Notifiers()
-		tsi, ok = srcs[tid]
-	)
-	if !ok {
-		return
-	}
 	//
 	// NotifListener and notifMsg must have the same type
 	//
 	nl.RLock()
+	tsi, ok := nl.Notifiers()[tid] // (notifiers may get merged - see `listeners.merge`)
+	if !ok {
+		nl.RUnlock()
+		return
+	}
 	if nl.HasFinished(tsi) {
 		n.p.writeErrSilentf(w, r, http.StatusBadRequest,
 			"%s: duplicate %s from %s, %s", n.p.si, notifMsg, tid, nl)
@@ -159,18 +178,33 @@ func (n *notifs) handler(w http.ResponseWriter, r *http.Request) {
 	nl.RUnlock()
 
 	switch apiItems[0] {
+	case apc.Started:
+		nl.Lock()
+		first := nl.MarkStarted(tsi, time.Now().UnixNano())
+		nl.Unlock()
+		if first {
+			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+				nlog.Infoln(nl.String(), "started by", tid)
+			}
+			nl.OnStarted(nl)
+		}
 	case apc.Progress:
 		nl.Lock()
-		n._progress(nl, tsi, notifMsg)
+		firstErr := n._progress(nl, tsi, notifMsg)
 		nl.Unlock()
+		if firstErr != nil {
+			nl.OnFirstErr(nl, tid, firstErr)
+		}
 	case apc.Finished:
 		n._finished(nl, tsi, notifMsg)
 	} // default not needed - cannot happen
 }
 
-func (*notifs) _progress(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg) {
+// returns non-nil error iff it is the listener's first one (see `nl.ListenerBase.FE`)
+func (n *notifs) _progress(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg) (firstErr error) {
 	if msg.ErrMsg != "" {
-		nl.AddErr(errors.New(msg.ErrMsg))
+		nl.AddNodeErr(tsi.ID(), errors.New(msg.ErrMsg))
+		firstErr = n.firstErr(nl, tsi)
 	}
 	// when defined, `data must be valid encoded stats
 	if msg.Data != nil {
@@ -178,6 +212,15 @@ func (*notifs) _progress(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg) {
 		debug.AssertNoErr(err)
 		nl.SetStats(tsi.ID(), stats)
 	}
+	return firstErr
+}
+
+// PRECONDITION: `nl` should be under lock.
+func (*notifs) firstErr(nl nl.Listener, tsi *meta.Snode) error {
+	if !nl.MarkFirstErr() {
+		return nil
+	}
+	return errors.New(nl.NodeErrs()[tsi.ID()])
 }
 
 func (n *notifs) _finished(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg) {
@@ -202,8 +245,12 @@ func (n *notifs) _finished(nl nl.Listener, tsi *meta.Snode, msg *core.NotifMsg)
 		srcErr = errors.New(msg.ErrMsg)
 	}
 	done = n.markFinished(nl, tsi, srcErr, aborted)
+	firstErr := n.firstErr(nl, tsi)
 	nl.Unlock()
 
+	if firstErr != nil {
+		nl.OnFirstErr(nl, tsi.ID(), firstErr)
+	}
 	if done {
 		n.done(nl)
 	}
@@ -215,7 +262,11 @@ func (n *notifs) add(nl nl.Listener) (err error) {
 	if nl.ActiveCount() == 0 {
 		return fmt.Errorf("cannot add %q with no active notifiers", nl)
 	}
+	if _, finished := n.fin.entry(nl.UUID()); finished {
+		return // never back to running
+	}
 	if exists := n.nls.add(nl, false /*locked*/); exists {
+		n.nls.merge(nl)
 		return
 	}
 	nl.SetAddedTime()
@@ -274,6 +325,61 @@ func (n *notifs) findAll(flt nlFilter) (nls []nl.Listener) {
 	return
 }
 
+// Returns per-kind counts of running and finished listeners.
+// Both maps are read-locked for the duration (same order as `MarshalJSON`)
+// to produce a consistent snapshot.
+func (n *notifs) summary() nl.Summary {
+	out := make(nl.Summary, 8)
+	n.nls.mtx.RLock()
+	n.fin.mtx.RLock()
+	for _, listener := range n.nls.m {
+		_kindSum(out, listener).Running++
+	}
+	for _, listener := range n.fin.m {
+		ks := _kindSum(out, listener)
+		ks.Finished++
+		if listener.Aborted() || listener.Err() != nil {
+			ks.Failed++
+		}
+		ks.LastEnd = max(ks.LastEnd, listener.EndTime())
+	}
+	n.fin.mtx.RUnlock()
+	n.nls.mtx.RUnlock()
+	return out
+}
+
+func _kindSum(out nl.Summary, listener nl.Listener) *nl.KindSummary {
+	kind := listener.Kind()
+	ks, ok := out[kind]
+	if !ok {
+		ks = &nl.KindSummary{}
+		out[kind] = ks
+	}
+	return ks
+}
+
+// Pauses (or resumes) periodic stats sync for the given listener or, when uuid is empty, for all
+// listeners, including those added later. Paused listeners remain registered and continue to
+// receive notifications; upon resume, they catch up on the next housekeeping tick
+// (as in: targets that haven't reported within the interval are now tardy - see `bcastGetStats`).
+// Returns false if the listener is not running.
+func (n *notifs) pause(uuid string, paused bool) bool {
+	if uuid == "" {
+		n.pausedAll.Store(paused)
+		return true
+	}
+	n.nls.mtx.RLock()
+	listener, ok := n.nls.m[uuid]
+	n.nls.mtx.RUnlock()
+	if !ok {
+		return false
+	}
+	if listener.SetPaused(paused) {
+		nlog.Infoln(listener.String(), "stats sync paused:", paused)
+	}
+	return true
+}
+
 func (n *notifs) size() int32 {
 	return n.nls.l.Load() + n.fin.l.Load()
 }
@@ -289,7 +395,7 @@ func (*notifs) markFinished(nl nl.Listener, tsi *meta.Snode, srcErr error, abort
 		}
 	}
 	if srcErr != nil {
-		nl.AddErr(srcErr)
+		nl.AddNodeErr(tsi.ID(), srcErr)
 	}
 	return nl.ActiveCount() == 0 || aborted
 }
@@ -327,6 +433,7 @@ func (n *notifs) done(nl nl.Listener) {
 		}
 	}
 	nl.Callback(nl, time.Now().UnixNano())
+	n.lpw.wake(nl.UUID())
 }
 
 func abortReq(nl nl.Listener) cmn.HreqArgs {
@@ -370,14 +477,21 @@ func (n *notifs) housekeep(int64) time.Duration {
 		return hk.PruneActiveIval
 	}
 
+	pausedAll := n.pausedAll.Load() // (expiration is never paused)
 	n.nls.mtx.RLock()
 	n.tempnl = n.tempnl[:0]
 	for _, nl := range n.nls.m {
-		n.tempnl = append(n.tempnl, nl)
+		if expired(nl) || (!pausedAll && !nl.Paused()) {
+			n.tempnl = append(n.tempnl, nl)
+		}
 	}
 	n.nls.mtx.RUnlock()
 
 	for _, nl := range n.tempnl {
+		if expired(nl) {
+			n.expire(nl)
+			continue
+		}
 		n.bcastGetStats(nl, hk.PruneActiveIval)
 	}
 	// cleanup temp cloned notifs
@@ -386,6 +500,33 @@ func (n *notifs) housekeep(int64) time.Duration {
 	return hk.PruneActiveIval
 }
 
+// a listener registered with TTL (see `nl.Listener.SetTTL`) expires when none of its notifiers
+// has started or reported stats within the TTL since added (e.g., the xaction never started)
+func expired(nl nl.Listener) bool {
+	ttl := nl.TTL()
+	if ttl == 0 || nl.StartTime() != 0 || mono.Since(nl.AddedTime()) < ttl {
+		return false
+	}
+	nl.RLock()
+	defer nl.RUnlock()
+	for _, si := range nl.Notifiers() {
+		if nl.LastUpdated(si) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// move expired (orphaned) listener to `fin` with a timeout error
+func (n *notifs) expire(nl nl.Listener) {
+	err := fmt.Errorf("%s: %s timed out: not started within %v", n.p.si, nl, nl.TTL())
+	nlog.Warningln(err)
+	nl.Lock()
+	nl.AddErr(err)
+	nl.Unlock()
+	n.done(nl)
+}
+
 // conditional: query targets iff they delayed updating
 func (n *notifs) bcastGetStats(nl nl.Listener, dur time.Duration) {
 	var (
@@ -503,7 +644,7 @@ repeat:
 		}
 		err := &errNodeNotFound{n.p.si, smap, "abort " + nl.String() + " via 'smap-changed':", sid}
 		nl.Lock()
-		nl.AddErr(err)
+		nl.AddNodeErr(sid, err)
 		nl.SetAborted()
 		nl.Unlock()
 	}
@@ -526,12 +667,72 @@ repeat:
 
 	for _, nl := range remnl {
 		nl.Callback(nl, now)
+		n.lpw.wake(nl.UUID())
 	}
 	// cleanup
 	clear(remnl)
 	clear(remid)
 }
 
+//
+// long polling: block until a given listener finishes (including aborts via 'smap-changed')
+// or `maxWait` elapses, whichever comes first; returns false when there are too many waiting clients
+//
+
+func (n *notifs) waitFinished(ctx context.Context, nl nl.Listener, maxWait time.Duration) bool {
+	if n.lpw.cnt.Inc() > maxLongPollWaiters {
+		n.lpw.cnt.Dec()
+		return false
+	}
+	defer n.lpw.cnt.Dec()
+
+	uuid := nl.UUID()
+	w := n.lpw.get(uuid)
+	defer n.lpw.put(uuid, w)
+	if nl.Finished() {
+		return true
+	}
+	timer := time.NewTimer(maxWait)
+	select {
+	case <-w.ch:
+	case <-timer.C:
+	case <-ctx.Done(): // client went away
+	}
+	timer.Stop()
+	return true
+}
+
+func (lpw *waiters) get(uuid string) (w *waiter) {
+	lpw.mu.Lock()
+	if lpw.m == nil {
+		lpw.m = make(map[string]*waiter, 4)
+	}
+	if w = lpw.m[uuid]; w == nil {
+		w = &waiter{ch: make(chan struct{})}
+		lpw.m[uuid] = w
+	}
+	w.refs++
+	lpw.mu.Unlock()
+	return w
+}
+
+func (lpw *waiters) put(uuid string, w *waiter) {
+	lpw.mu.Lock()
+	if w.refs--; w.refs == 0 && lpw.m[uuid] == w {
+		delete(lpw.m, uuid)
+	}
+	lpw.mu.Unlock()
+}
+
+func (lpw *waiters) wake(uuid string) {
+	lpw.mu.Lock()
+	if w, ok := lpw.m[uuid]; ok {
+		close(w.ch)
+		delete(lpw.m, uuid)
+	}
+	lpw.mu.Unlock()
+}
+
 func _remini() (map[string]nl.Listener, cos.StrKVs) {
 	return make(map[string]nl.Listener, 1), make(cos.StrKVs, 1)
 }
@@ -579,11 +780,16 @@ func (n *notifs) UnmarshalJSON(data []byte) (err error) {
 // Identify the diff in ownership table and populate `added`, `removed` and `finished` slices
 // (under lock)
 func (n *notifs) apply(t *jsonNotifs) {
+	var merged []nl.Listener
 	added, removed, finished := n.added[:0], n.removed[:0], n.finished[:0]
 	n.nls.mtx.RLock()
 	n.fin.mtx.RLock()
 	for _, m := range t.Running {
-		if n.fin.exists(m.nl.UUID()) || n.nls.exists(m.nl.UUID()) {
+		if n.fin.exists(m.nl.UUID()) {
+			continue
+		}
+		if n.nls.exists(m.nl.UUID()) {
+			merged = append(merged, m.nl)
 			continue
 		}
 		added = append(added, m.nl)
@@ -601,6 +807,9 @@ func (n *notifs) apply(t *jsonNotifs) {
 	n.fin.mtx.RUnlock()
 	n.nls.mtx.RUnlock()
 
+	for _, nl := range merged {
+		n.nls.merge(nl)
+	}
 	if len(removed) == 0 && len(added) == 0 {
 		goto fin
 	}
@@ -667,6 +876,24 @@ func (l *listeners) add(nl nl.Listener, locked bool) (exists bool) {
 	return exists
 }
 
+// given a re-registered listener, merge it into the existing one with the same UUID
+// (see `nl.ListenerBase.Merge`)
+func (l *listeners) merge(nl nl.Listener) (added int) {
+	entry, exists := l.entry(nl.UUID())
+	if !exists || entry == nl {
+		return 0
+	}
+	nl.RLock()
+	entry.Lock()
+	added = entry.Merge(nl)
+	entry.Unlock()
+	nl.RUnlock()
+	if added > 0 && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln("merge", entry.Name(), "[ added notifiers:", added, "]")
+	}
+	return added
+}
+
 func (l *listeners) del(nl nl.Listener, locked bool) (ok bool) {
 	if !locked {
 		l.mtx.Lock()
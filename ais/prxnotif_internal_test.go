@@ -6,6 +6,7 @@ package ais
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"time"
@@ -33,6 +34,11 @@ func (*nopHB) set(time.Duration) bool        { return false }
 
 var _ hbTracker = (*nopHB)(nil)
 
+// (package `nl` is shadowed inside 'Describe' below)
+func onFirstErr(f func(daeID string, err error)) nl.ErrCallback {
+	return func(_ nl.Listener, daeID string, err error) { f(daeID, err) }
+}
+
 var _ = Describe("Notifications xaction test", func() {
 	// NOTE: constants and functions declared inside 'Describe' to avoid cluttering of `ais` namespace.
 	const (
@@ -176,6 +182,36 @@ var _ = Describe("Notifications xaction test", func() {
 			Expect(nl.ActiveNotifiers().Contains(target1ID)).To(BeFalse())
 		})
 
+		It("should invoke first-error callback once, before finishing", func() {
+			var (
+				cnt   int
+				daeID string
+				msg   string
+			)
+			nl.(*xact.NotifXactListener).FE = onFirstErr(func(id string, err error) {
+				cnt++
+				daeID, msg = id, err.Error()
+			})
+			nl.Lock()
+			firstErr := n._progress(nl, targets[target1ID], &core.NotifMsg{ErrMsg: "first error"})
+			nl.Unlock()
+			Expect(firstErr).NotTo(BeNil())
+			nl.OnFirstErr(nl, target1ID, firstErr)
+			Expect(cnt).To(Equal(1))
+			Expect(daeID).To(Equal(target1ID))
+			Expect(msg).To(Equal("first error"))
+			Expect(nl.Finished()).To(BeFalse())
+
+			nl.Lock()
+			firstErr = n._progress(nl, targets[target2ID], &core.NotifMsg{ErrMsg: "second error"})
+			nl.Unlock()
+			Expect(firstErr).To(BeNil())
+
+			snap := finishedXact(xid)
+			n._finished(nl, targets[target2ID], &core.NotifMsg{Data: cos.MustMarshal(snap), ErrMsg: "third error"})
+			Expect(cnt).To(Equal(1))
+		})
+
 		It("should finish when all the Notifiers finished", func() {
 			Expect(nl.FinCount()).To(BeEquivalentTo(0))
 			n.add(nl)
@@ -247,6 +283,132 @@ var _ = Describe("Notifications xaction test", func() {
 		})
 	})
 
+	Describe("summary", func() {
+		It("should count running, finished, and failed listeners per kind", func() {
+			n.add(nl)
+			other := xact.NewXactNL(cos.GenUUID(), apc.ActECEncode, &smap.Smap, targets)
+			n.add(other)
+			aborted := xact.NewXactNL(cos.GenUUID(), apc.ActLRU, &smap.Smap, targets)
+			n.add(aborted)
+
+			summary := n.summary()
+			Expect(summary).To(HaveLen(2))
+			Expect(summary[apc.ActECEncode].Running).To(Equal(2))
+			Expect(summary[apc.ActLRU].Running).To(Equal(1))
+
+			// finish `other` and abort `aborted`
+			checkRequest(n, notifRequest(target1ID, other.UUID(), apc.Finished, finishedXact(other.UUID())), http.StatusOK)
+			checkRequest(n, notifRequest(target2ID, other.UUID(), apc.Finished, finishedXact(other.UUID())), http.StatusOK)
+			checkRequest(n, notifRequest(target1ID, aborted.UUID(), apc.Finished, abortedXact(aborted.UUID())), http.StatusOK)
+
+			summary = n.summary()
+			ec, lru := summary[apc.ActECEncode], summary[apc.ActLRU]
+			Expect(ec.Running).To(Equal(1))
+			Expect(ec.Finished).To(Equal(1))
+			Expect(ec.Failed).To(Equal(0))
+			Expect(ec.LastEnd).To(Equal(other.EndTime()))
+			Expect(lru.Running).To(Equal(0))
+			Expect(lru.Finished).To(Equal(1))
+			Expect(lru.Failed).To(Equal(1))
+		})
+	})
+
+	Describe("merge", func() {
+		It("should union notifiers upon re-registration", func() {
+			n.add(nl)
+			Expect(nl.ActiveCount()).To(Equal(2))
+
+			const target3ID = "target3"
+			again := xact.NewXactNL(xid, apc.ActECEncode, &smap.Smap, getNodeMap(target1ID, target3ID))
+			again.SetTTL(time.Minute)
+			Expect(n.add(again)).NotTo(HaveOccurred())
+
+			entry, exists := n.nls.entry(xid)
+			Expect(exists).To(BeTrue())
+			Expect(entry).To(BeIdenticalTo(nl))
+			Expect(nl.Notifiers()).To(HaveLen(3))
+			Expect(nl.ActiveNotifiers()).To(HaveKey(target3ID))
+			Expect(nl.TTL()).To(Equal(time.Minute))
+
+			// the newly joined notifier must finish as well
+			checkRequest(n, notifRequest(target1ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			checkRequest(n, notifRequest(target2ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			Expect(nl.Finished()).To(BeFalse())
+			checkRequest(n, notifRequest(target3ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			Expect(nl.Finished()).To(BeTrue())
+		})
+
+		It("should not downgrade finished listener", func() {
+			n.add(nl)
+			checkRequest(n, notifRequest(target1ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			checkRequest(n, notifRequest(target2ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			Expect(nl.Finished()).To(BeTrue())
+
+			again := xact.NewXactNL(xid, apc.ActECEncode, &smap.Smap, getNodeMap("target3"))
+			Expect(n.add(again)).NotTo(HaveOccurred())
+			_, running := n.nls.entry(xid)
+			Expect(running).To(BeFalse())
+			Expect(nl.Merge(again)).To(BeZero())
+			Expect(nl.Notifiers()).To(HaveLen(2))
+		})
+	})
+
+	Describe("pause", func() {
+		It("should pause and resume stats sync for a running listener", func() {
+			n.add(nl)
+			Expect(n.pause(nl.UUID(), true)).To(BeTrue())
+			Expect(nl.Paused()).To(BeTrue())
+			Expect(n.entry(nl.UUID())).NotTo(BeNil())
+
+			Expect(n.pause(nl.UUID(), false)).To(BeTrue())
+			Expect(nl.Paused()).To(BeFalse())
+
+			Expect(n.pause(cos.GenUUID(), true)).To(BeFalse())
+		})
+
+		It("should pause and resume stats sync for all listeners", func() {
+			n.add(nl)
+			Expect(n.pause("", true)).To(BeTrue())
+			Expect(n.pausedAll.Load()).To(BeTrue())
+			Expect(nl.Paused()).To(BeFalse())
+
+			n.pause("", false)
+			Expect(n.pausedAll.Load()).To(BeFalse())
+		})
+	})
+
+	Describe("expire", func() {
+		It("should not expire listeners registered without TTL", func() {
+			n.add(nl)
+			Expect(expired(nl)).To(BeFalse())
+		})
+
+		It("should expire listener that has not started within TTL", func() {
+			nl.SetTTL(time.Millisecond)
+			n.add(nl)
+			time.Sleep(5 * time.Millisecond)
+			Expect(expired(nl)).To(BeTrue())
+
+			n.expire(nl)
+			_, running := n.nls.entry(nl.UUID())
+			Expect(running).To(BeFalse())
+			_, finished := n.fin.entry(nl.UUID())
+			Expect(finished).To(BeTrue())
+			Expect(nl.Finished()).To(BeTrue())
+			Expect(nl.Err()).To(HaveOccurred())
+		})
+
+		It("should not expire listener once notifiers have reported", func() {
+			nl.SetTTL(time.Millisecond)
+			n.add(nl)
+			nl.Lock()
+			nl.SetStats(target1ID, baseXact(xid))
+			nl.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			Expect(expired(nl)).To(BeFalse())
+		})
+	})
+
 	Describe("handler", func() {
 		It("should mark xaction finished when done", func() {
 			stats := finishedXact(xid)
@@ -290,4 +452,55 @@ var _ = Describe("Notifications xaction test", func() {
 			Expect(nl.FinCount()).To(BeEquivalentTo(2))
 		})
 	})
+
+	Describe("long polling", func() {
+		waitAsync := func(maxWait time.Duration) chan bool {
+			ch := make(chan bool, 1)
+			go func() { ch <- n.waitFinished(context.Background(), nl, maxWait) }()
+			time.Sleep(10 * time.Millisecond) // (registered)
+			return ch
+		}
+
+		It("should return as soon as xaction finishes", func() {
+			n.add(nl)
+			ch := waitAsync(time.Minute)
+			Consistently(ch, 50*time.Millisecond).ShouldNot(Receive())
+
+			checkRequest(n, notifRequest(target1ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			Consistently(ch, 50*time.Millisecond).ShouldNot(Receive())
+			checkRequest(n, notifRequest(target2ID, xid, apc.Finished, finishedXact(xid)), http.StatusOK)
+			Eventually(ch, time.Second).Should(Receive(BeTrue()))
+			Expect(nl.Finished()).To(BeTrue())
+			Expect(n.lpw.m).To(BeEmpty())
+		})
+
+		It("should time out while xaction is still running", func() {
+			n.add(nl)
+			Expect(n.waitFinished(context.Background(), nl, 20*time.Millisecond)).To(BeTrue())
+			Expect(nl.Finished()).To(BeFalse())
+			Expect(n.lpw.m).To(BeEmpty())
+			Expect(n.lpw.cnt.Load()).To(BeEquivalentTo(0))
+		})
+
+		It("should release waiters upon 'smap-changed' abort", func() {
+			n.add(nl)
+			ch := waitAsync(time.Minute)
+
+			smap := n.p.owner.smap.get()
+			smap.Tmap = getNodeMap(target1ID) // target 2 removed
+			smap.Version++
+			n.p.owner.smap.put(smap)
+			n.ListenSmapChanged()
+
+			Eventually(ch, time.Second).Should(Receive(BeTrue()))
+			Expect(nl.Aborted()).To(BeTrue())
+		})
+
+		It("should limit the number of waiting clients", func() {
+			n.add(nl)
+			n.lpw.cnt.Store(maxLongPollWaiters)
+			Expect(n.waitFinished(context.Background(), nl, time.Millisecond)).To(BeFalse())
+			Expect(n.lpw.cnt.Load()).To(BeEquivalentTo(maxLongPollWaiters))
+		})
+	})
 })
@@ -376,7 +376,10 @@ func (t *target) Run() error {
 
 	core.Tinit(t, config, true /*run hk*/)
 
-	bundle.InitSDM(config, apc.CompressNever) // shared streams; requires certloader when use-https
+	// shared streams; requires certloader when use-https
+	if err := bundle.InitSDM(config, apc.CompressNever, "" /*default trname*/); err != nil {
+		cos.ExitLog(err)
+	}
 
 	fatalErr, writeErr := t.checkRestarted(config)
 	if fatalErr != nil {
@@ -467,7 +470,7 @@ func (t *target) Run() error {
 
 	etl.Tinit()
 	dsort.Tinit(db, config)
-	dload.Init(db, &config.Client)
+	dload.Init(db)
 
 	err = t.htrun.run(config)
 
@@ -568,7 +571,7 @@ func (t *target) initRecvHandlers() {
 		{r: apc.Txn, h: t.txnHandler, net: accessNetIntraControl},
 		{r: apc.ObjStream, h: transport.RxAnyStream, net: accessControlData},
 
-		{r: apc.Download, h: t.downloadHandler, net: accessNetIntraControl},
+		{r: apc.Download, h: t.downloadHandler, net: accessNetAll}, // (public and intra-data: redirected stream-through only)
 		{r: apc.Sort, h: dsort.TargetHandler, net: accessControlData},
 		{r: apc.ETL, h: t.etlHandler, net: accessNetAll},
 
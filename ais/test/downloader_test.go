@@ -5,12 +5,17 @@
 package integration_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -1452,3 +1457,119 @@ func TestDownloadJobBytesThrottling(t *testing.T) {
 		hardLimit, resp.CurrentTasks[0].Downloaded,
 	)
 }
+
+// resumable download: kill the target mid-download, restore it, and resubmit -
+// the origin must see a range request that skips the already downloaded prefix
+func TestDownloadResumeAfterTargetRestart(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true, MinTargets: 2, RequiredDeployment: tools.ClusterTypeLocal})
+
+	const (
+		objName   = "resumable"
+		size      = 96 * cos.MiB // (must be at least 64MiB to resume)
+		chunk     = cos.MiB
+		chunkTime = 100 * time.Millisecond // ~10MiB/s
+	)
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		data       = make([]byte, size)
+		modTime    = time.Now()
+		ranges     []string
+		mu         sync.Mutex
+	)
+	r, err := readers.NewRand(size, cos.ChecksumNone)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadFull(r, data)
+	tassert.CheckFatal(t, err)
+
+	// origin: supports ranges, throttled
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rng := r.Header.Get(cos.HdrRange); rng != "" && r.Method == http.MethodGet {
+			mu.Lock()
+			ranges = append(ranges, rng)
+			mu.Unlock()
+		}
+		http.ServeContent(&slowWriter{w, chunk, chunkTime}, r, objName, modTime, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+	link := srv.URL + "/" + objName
+
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	smap := tools.GetClusterMap(t, proxyURL)
+	target, err := smap.HrwName2T(meta.CloneBck(&bck).MakeUname(objName))
+	tassert.CheckFatal(t, err)
+
+	id, err := api.DownloadSingle(baseParams, generateDownloadDesc(), bck, objName, link)
+	tassert.CheckFatal(t, err)
+
+	// wait for the first commit (16MiB) and then some
+	for deadline := time.Now().Add(time.Minute); ; time.Sleep(time.Second) {
+		resp, err := api.DownloadStatus(baseParams, id, true /*onlyActive*/)
+		tassert.CheckFatal(t, err)
+		if len(resp.CurrentTasks) > 0 && resp.CurrentTasks[0].Downloaded > 40*cos.MiB {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the download to make progress")
+		}
+	}
+
+	tlog.Logf("Killing %s mid-download\n", target.StringEx())
+	tcmd, err := tools.KillNode(target)
+	tassert.CheckFatal(t, err)
+	smap, err = tools.WaitForClusterState(proxyURL, "target removed", smap.Version, smap.CountActivePs(), smap.CountActiveTs()-1)
+	tassert.CheckFatal(t, err)
+
+	err = tools.RestoreNode(tcmd, false, apc.Target)
+	tassert.CheckFatal(t, err)
+	_, err = tools.WaitForClusterState(smap.Primary.URL(cmn.NetPublic), "target restored", smap.Version,
+		smap.CountActivePs(), smap.CountActiveTs()+1)
+	tassert.CheckFatal(t, err)
+	tools.WaitForRebalAndResil(t, baseParams)
+
+	id, err = api.DownloadSingle(baseParams, generateDownloadDesc(), bck, objName, link)
+	tassert.CheckFatal(t, err)
+	waitForDownload(t, id, 2*time.Minute)
+
+	status, err := api.DownloadStatus(baseParams, id, false /*onlyActive*/)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, status.FinishedCnt == 1, "expected the object to be downloaded (errs: %v)", status.Errs)
+
+	mu.Lock()
+	tassert.Fatalf(t, len(ranges) > 0, "expected the download to resume with a range request")
+	offset, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(ranges[0], cos.HdrRangeValPrefix), "-"), 10, 64)
+	mu.Unlock()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, offset >= 16*cos.MiB, "expected to resume past the first commit, got offset %d", offset)
+	tlog.Logf("Resumed at offset %s\n", cos.ToSizeIEC(offset, 0))
+
+	// content
+	var buf bytes.Buffer
+	_, err = api.GetObject(baseParams, bck, objName, &api.GetArgs{Writer: &buf})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(buf.Bytes(), data), "content mismatch")
+}
+
+type slowWriter struct {
+	w     http.ResponseWriter
+	chunk int
+	pause time.Duration
+}
+
+func (sw *slowWriter) Header() http.Header  { return sw.w.Header() }
+func (sw *slowWriter) WriteHeader(code int) { sw.w.WriteHeader(code) }
+
+func (sw *slowWriter) Write(b []byte) (n int, err error) {
+	for len(b) > 0 {
+		l := min(len(b), sw.chunk)
+		if _, err = sw.w.Write(b[:l]); err != nil {
+			return n, err
+		}
+		n += l
+		b = b[l:]
+		time.Sleep(sw.pause)
+	}
+	return n, nil
+}
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -33,6 +34,10 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 		respErr    error
 		statusCode int
 	)
+	if r.Method == http.MethodPost && r.URL.Path == apc.URLPathDownloadStream.S {
+		t.dlstream(w, r)
+		return
+	}
 	if !t.ensureIntraControl(w, r, false /* from primary */) {
 		return
 	}
@@ -87,6 +92,16 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err, http.StatusInternalServerError)
 			return
 		}
+		// next page of an open multi-object job
+		payload, err := dload.ParseAppendRequest(dlb)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if payload != nil {
+			response, statusCode, respErr = xdl.AppendJob(jobID, bck, payload)
+			break
+		}
 		dljob, err := dload.ParseStartRequest(bck, jobID, dlb, xdl)
 		if err != nil {
 			xdl.Abort(err)
@@ -99,11 +114,12 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 
 		dljob.AddNotif(&dload.NotifDownload{
 			Base: nl.Base{
-				When:     core.UponProgress,
+				When:     core.UponProgress | core.UponStart,
 				Interval: progressInterval,
 				Dsts:     []string{equalIC},
 				F:        t.notifyTerm,
 				P:        t.notifyProgress,
+				S:        t.notifyStarted,
 			},
 		}, dljob)
 		response, statusCode, respErr = xdl.Download(dljob)
@@ -141,7 +157,11 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 				}
 				regex = rgx
 			}
-			response, statusCode, respErr = dload.ListJobs(regex, msg.OnlyActive)
+			if msg.History {
+				response, statusCode, respErr = dload.ListHistory(regex)
+			} else {
+				response, statusCode, respErr = dload.ListJobs(regex, msg.OnlyActive)
+			}
 		}
 
 	case http.MethodDelete:
@@ -172,9 +192,12 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err, http.StatusInternalServerError)
 			return
 		}
-		if actdelete == apc.Abort {
+		switch {
+		case actdelete == apc.Abort && payload.ObjName != "":
+			response, statusCode, respErr = xdl.AbortObj(payload.ID, payload.ObjName)
+		case actdelete == apc.Abort:
 			response, statusCode, respErr = xdl.AbortJob(payload.ID)
-		} else { // apc.Remove
+		default: // apc.Remove
 			response, statusCode, respErr = xdl.RemoveJob(payload.ID)
 		}
 
@@ -209,3 +232,56 @@ func renewdl(xid string, bck *meta.Bck) (*dload.Xact, error) {
 	xctn := rns.Entry.Get()
 	return xctn.(*dload.Xact), nil
 }
+
+// notify the running downloader, if any, that a given mountpath has been enabled
+// (or attached) or is being disabled (or detached)
+func dlMpath(mpath string, enable bool) {
+	entry := xreg.GetRunning(xreg.Flt{Kind: apc.ActDownload})
+	if entry == nil {
+		return
+	}
+	xdl, ok := entry.Get().(*dload.Xact)
+	if !ok || xdl.Finished() {
+		return
+	}
+	if enable {
+		xdl.ReqEnableMountpath(mpath)
+	} else {
+		xdl.ReqDisableMountpath(mpath)
+	}
+}
+
+// POST /v1/download/stream (redirected by a gateway)
+func (t *target) dlstream(w http.ResponseWriter, r *http.Request) {
+	if isRedirect(r.URL.Query()) == "" {
+		t.writeErrf(w, r, "%s: %s must be redirected by a gateway", t, r.URL.Path)
+		return
+	}
+	maxSize := int64(cmn.GCO.Get().Downloader.MaxStreamSize)
+	if maxSize <= 0 {
+		t.writeErrStatusf(w, r, http.StatusForbidden, "%s: stream-through download is disabled (see 'downloader.max_stream_size')", t)
+		return
+	}
+	body := &dload.StreamBody{}
+	if err := cmn.ReadJSON(w, r, body); err != nil {
+		return
+	}
+	if err := body.Validate(); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	size, err := dload.Stream(r.Context(), w, body, maxSize)
+	if err == nil {
+		if cmn.Rom.FastV(4, cos.SmoduleDload) {
+			nlog.Infoln(t.String(), "streamed", body.String(), cos.ToSizeIEC(size, 2))
+		}
+		return
+	}
+	if !errors.Is(err, dload.ErrStreamAborted) {
+		t.writeErr(w, r, err)
+		return
+	}
+	// the status is out - abort the connection so that the client won't take truncated content for the whole
+	nlog.Warningln(t.String(), err, "[", cos.ToSizeIEC(size, 2), "]")
+	panic(http.ErrAbortHandler)
+}
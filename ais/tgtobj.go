@@ -1030,6 +1030,10 @@ func (goi *getOI) txfini() (ecode int, err error) {
 	if !goi.cold && !dpq.isGFN && !goi.lom.IsChunked() {
 		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
 	}
+	// conditional GET
+	if done, ecode, err := goi.precond(); done || err != nil {
+		return ecode, err
+	}
 	// open
 	lmfh, err = goi.lom.Open()
 	if err != nil {
@@ -1069,6 +1073,32 @@ func (goi *getOI) txfini() (ecode int, err error) {
 	return ecode, err
 }
 
+// If-Match: fail with 412 when the object's current ETag (see cmn.ObjETag) doesn't match;
+// If-None-Match: respond with 304 and no content when it does
+func (goi *getOI) precond() (done bool, ecode int, err error) {
+	var (
+		hdr     = goi.req.Header
+		ifMatch = hdr.Get(cos.HdrIfMatch)
+		ifNone  = hdr.Get(cos.HdrIfNoneMatch)
+	)
+	if ifMatch == "" && ifNone == "" {
+		return false, 0, nil
+	}
+	etag := cmn.ObjETag(goi.lom)
+	if ifMatch != "" && !cmn.MatchETag(ifMatch, etag) {
+		ecode = http.StatusPreconditionFailed
+		return false, ecode, cmn.NewErrFailedTo(goi.t, "GET", goi.lom.Cname(),
+			fmt.Errorf("%s %s does not match the current %q", cos.HdrIfMatch, ifMatch, etag), ecode)
+	}
+	if ifNone != "" && cmn.MatchETag(ifNone, etag) {
+		whdr := goi.w.Header()
+		whdr.Set(cos.HdrETag, `"`+etag+`"`)
+		goi.w.WriteHeader(http.StatusNotModified)
+		return true, 0, nil
+	}
+	return false, 0, nil
+}
+
 func (goi *getOI) _txrng(fqn string, lmfh cos.LomReader, whdr http.Header, hrng *htrange) (err error) {
 	var (
 		r          io.Reader
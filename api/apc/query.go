@@ -217,6 +217,9 @@ const (
 
 	// Notification target's node ID (usually, the node that initiates the operation).
 	QparamNotifyMe = "nft"
+
+	// (WhatOneXactStatus) long polling: block until the xaction finishes or this duration elapses, e.g. "30s"
+	QparamLongPoll = "lpw"
 )
 
 // QparamWhat enum.
@@ -246,16 +249,18 @@ const (
 	WhatSmapVote   = "smapvote"
 	WhatSysInfo    = "sysinfo"
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatObjLocate  = "obj_locate" // object placement: HRW scores and actual location (troubleshooting)
 
 	// log
 	WhatLog = "log"
 
 	// xactions
-	WhatOneXactStatus   = "status"      // IC status by uuid (returns a single matching xaction or none)
-	WhatAllXactStatus   = "status_all"  // ditto - all matching xactions
-	WhatXactStats       = "getxstats"   // stats: xaction by uuid
-	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
-	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatOneXactStatus   = "status"       // IC status by uuid (returns a single matching xaction or none)
+	WhatAllXactStatus   = "status_all"   // ditto - all matching xactions
+	WhatXactStats       = "getxstats"    // stats: xaction by uuid
+	WhatQueryXactStats  = "qryxstats"    // stats: all matching xactions
+	WhatAllRunningXacts = "running_all"  // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatJobsSummary     = "jobs_summary" // per-kind counts: running, (recently) finished, failed
 
 	// internal
 	WhatSnode    = "snode"
@@ -73,6 +73,7 @@ const (
 	FinishedAck = "finished_ack"
 	UList       = "list"
 	Remove      = "remove"
+	DlStream    = "stream" // transient download: stream the object to the client without storing it
 
 	LoadX509 = "load-x509"
 
@@ -100,6 +101,7 @@ const (
 
 	Finished = "finished"
 	Progress = "progress"
+	Started  = "started"
 )
 
 // internal use
@@ -179,6 +181,7 @@ var (
 	URLPathDownload       = urlpath(Version, Download)
 	URLPathDownloadAbort  = urlpath(Version, Download, Abort)
 	URLPathDownloadRemove = urlpath(Version, Download, Remove)
+	URLPathDownloadStream = urlpath(Version, Download, DlStream)
 
 	URLPathETL       = urlpath(Version, ETL)
 	URLPathETLObject = urlpath(Version, ETL, ETLObject)
@@ -310,6 +310,9 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 	if err := reqParams.checkResp(resp); err != nil {
 		return nil, err
 	}
+	if resp.StatusCode == http.StatusNotModified {
+		return wresp, nil // (conditional GET: no content)
+	}
 	// write _and_ compute client-side checksum
 	n, cksum, err := cos.CopyAndChecksum(w, resp.Body, nil, cksumType)
 	if err != nil {
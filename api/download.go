@@ -110,6 +110,24 @@ func DownloadGetList(bp BaseParams, regex string, onlyActive bool) (dlList dload
 	return
 }
 
+// DownloadHistory returns summaries of completed download jobs, including those that
+// have already been removed (see `DownloaderConf.HistoryMax`), most recently finished first;
+// optionally, filtered by description (regex)
+func DownloadHistory(bp BaseParams, regex string) (hist dload.HistEntries, err error) {
+	dlBody := dload.AdminBody{Regex: regex, History: true}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err = reqParams.DoReqAny(&hist)
+	FreeRp(reqParams)
+	return
+}
+
 func AbortDownload(bp BaseParams, id string) error {
 	dlBody := dload.AdminBody{ID: id}
 	bp.Method = http.MethodDelete
@@ -125,6 +143,23 @@ func AbortDownload(bp BaseParams, id string) error {
 	return err
 }
 
+// AbortDownloadObj cancels a single object of the running download job;
+// the rest of the job continues
+func AbortDownloadObj(bp BaseParams, id, objName string) error {
+	dlBody := dload.AdminBody{ID: id, ObjName: objName}
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadAbort.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 func RemoveDownload(bp BaseParams, id string) error {
 	dlBody := dload.AdminBody{ID: id}
 	bp.Method = http.MethodDelete
@@ -55,6 +55,9 @@ type (
 		//   For range formatting, see https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 		// E.g. blob download:
 		// * Header.Set(apc.HdrBlobDownload, "true")
+		// and c) conditional GET:
+		// * Header.Set(cos.HdrIfNoneMatch, `"<etag>"`) - see ObjAttrs.NotModified
+		// * Header.Set(cos.HdrIfMatch, `"<etag>"`) - fails with 412 when the object's current ETag is different
 		Header http.Header
 	}
 
@@ -66,6 +69,7 @@ type (
 	ObjAttrs struct {
 		wrespHeader http.Header
 		n           int64
+		status      int
 	}
 )
 
@@ -165,6 +169,11 @@ func (oah *ObjAttrs) RespHeader() http.Header {
 	return oah.wrespHeader
 }
 
+// conditional GET (`cos.HdrIfNoneMatch`): the object's current ETag matches, nothing's been written
+func (oah *ObjAttrs) NotModified() bool {
+	return oah.status == http.StatusNotModified
+}
+
 func GetObject(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs) (oah ObjAttrs, err error) {
 	var (
 		wresp     *wrappedResp
@@ -194,7 +203,7 @@ func GetObject(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs) (oah O
 	FreeRp(reqParams)
 	qfree(qall)
 	if err == nil {
-		oah.wrespHeader, oah.n = wresp.Header, wresp.n
+		oah.wrespHeader, oah.n, oah.status = wresp.Header, wresp.n, wresp.StatusCode
 	}
 	return oah, err
 }
@@ -227,7 +236,7 @@ func GetObjectWithValidation(bp BaseParams, bck cmn.Bck, objName string, args *G
 	resp.Body.Close()
 	FreeRp(reqParams)
 	if err == nil {
-		oah.wrespHeader, oah.n = wresp.Header, wresp.n
+		oah.wrespHeader, oah.n, oah.status = wresp.Header, wresp.n, wresp.StatusCode
 	} else if err.Error() == errNilCksum {
 		err = fmt.Errorf("%s is not checksummed, cannot validate", bck.Cname(objName))
 	}
@@ -384,6 +393,29 @@ func headobj(reqParams *ReqParams, noprops bool) (*cmn.ObjectProps, error) {
 	return op, err
 }
 
+// LocateObject returns all targets sorted by their respective HRW scores for the given object,
+// and whether (and where) each one of them currently stores it
+// (the HRW owner not storing the object usually means pending or ongoing rebalance).
+func LocateObject(bp BaseParams, bck cmn.Bck, objName string) (loc *cmn.ObjLocation, err error) {
+	q := qalloc()
+	bck.SetQuery(q)
+	q.Set(apc.QparamWhat, apc.WhatObjLocate)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	loc = &cmn.ObjLocation{}
+	_, err = reqParams.DoReqAny(loc)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return loc, err
+}
+
 // SetObjectCustomProps ================================================================================
 //
 // Given cos.StrKVs (map[string]string) keys and values, sets object's custom properties.
@@ -147,6 +147,21 @@ func GetOneXactionStatus(bp BaseParams, args *xact.ArgsMsg) (status *nl.Status,
 	return status, err
 }
 
+// same as above, except that the (IC) proxy holds the request until the xaction finishes
+// or `maxWait` elapses, whichever comes first - in the latter case, the returned
+// status is not finished (compare with WaitForXactionIC that polls at intervals)
+func LongPollXactionStatus(bp BaseParams, args *xact.ArgsMsg, maxWait time.Duration) (status *nl.Status, err error) {
+	status = &nl.Status{}
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatOneXactStatus)
+	q.Set(apc.QparamLongPoll, maxWait.String())
+
+	err = getxst(status, q, bp, args)
+
+	qfree(q)
+	return status, err
+}
+
 // same as above, except that it returns _all_ matching xactions
 func GetAllXactionStatus(bp BaseParams, args *xact.ArgsMsg) (matching nl.StatusVec, err error) {
 	q := qalloc()
@@ -165,6 +180,25 @@ func GetAllXactionStatus(bp BaseParams, args *xact.ArgsMsg) (matching nl.StatusV
 	return matching, err
 }
 
+// per-kind counts of running and recently finished jobs (a cluster-jobs overview)
+func GetJobsSummary(bp BaseParams) (summary nl.Summary, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatJobsSummary)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&summary)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return summary, err
+}
+
 func getxst(out any, q url.Values, bp BaseParams, args *xact.ArgsMsg) (err error) {
 	bp.Method = http.MethodGet
 	msg := xact.QueryMsg{ID: args.ID, Kind: args.Kind, Bck: args.Bck}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmd/cli/config"
@@ -57,6 +58,7 @@ type (
 		offset           int64
 		mapBegin, mapEnd teb.StstMap
 		outFile          *os.File
+		stopped          ratomic.Bool // interactive mode: canceled via Ctrl-C
 	}
 )
 
@@ -89,7 +91,11 @@ func Run(version, buildtime string, args []string) error {
 
 	a.init(version, emptyCmdline)
 
-	teb.Init(os.Stdout, cfg.NoColor)
+	teb.Init(os.Stdout, noColor)
+
+	if IsREPL(args) {
+		return a.repl(args[0])
+	}
 
 	// run
 	if err := a.runOnce(args); err != nil {
@@ -127,13 +133,16 @@ func (a *acli) runOnce(args []string) error {
 			briefPause(1)
 		}
 	}
-	return formatErr(err)
+	return &errExit{err: formatErr(err), code: exitCode(err)}
 }
 
 func (a *acli) runForever(args []string) error {
 	rate := a.longRun.refreshRate
 	for {
 		time.Sleep(rate)
+		if a.longRun.stopped.Load() {
+			return nil
+		}
 		printLongRunFooter(a.outWriter, a.longRun.lfooter)
 		if err := a.runOnce(args); err != nil {
 			return err
@@ -155,6 +164,9 @@ func (a *acli) runN(args []string) error {
 	fmt.Fprintln(a.outWriter, delim)
 	for ; a.longRun.iters < a.longRun.count; a.longRun.iters++ {
 		time.Sleep(a.longRun.refreshRate)
+		if a.longRun.stopped.Load() {
+			return nil
+		}
 		if err := a.runOnce(args); err != nil {
 			return err
 		}
@@ -168,7 +180,7 @@ func (a *acli) runN(args []string) error {
 func (a *acli) init(version string, emptyCmdline bool) {
 	app := a.app
 
-	if cfg.NoColor {
+	if noColor {
 		fcyan = fmt.Sprint
 		fred = fmt.Sprint
 		fblue = fmt.Sprint
@@ -190,7 +202,7 @@ func (a *acli) init(version string, emptyCmdline bool) {
 		Name:  "help, h",
 		Usage: "Show help",
 	}
-	app.Flags = []cli.Flag{cli.HelpFlag}
+	app.Flags = []cli.Flag{cli.HelpFlag, endpointFlag, dryRunAPIFlag, quietFlag, noColorFlag}
 
 	app.CommandNotFound = commandNotFoundHandler
 	app.OnUsageError = onUsageErrorHandler
@@ -205,7 +217,7 @@ func (a *acli) init(version string, emptyCmdline bool) {
 	}
 
 	// custom templates and help coloring
-	if !cfg.NoColor {
+	if !noColor {
 		cli.AppHelpTemplate = appColoredHelpTemplate
 		cli.CommandHelpTemplate = commandColoredHelpTemplate
 		cli.SubcommandHelpTemplate = subcommandColoredHelpTemplate
@@ -242,6 +254,7 @@ func (a *acli) setupCommands(emptyCmdline bool) {
 		showCmdPeformance,
 		remClusterCmd,
 		a.getAliasCmd(),
+		replCmd,
 	}
 
 	if k8sDetected {
@@ -311,7 +324,11 @@ func commandNotFoundHandler(c *cli.Context, cmd string) {
 	}
 	err := commandNotFoundError(c, cmd)
 	fmt.Fprint(c.App.ErrWriter, err)
-	os.Exit(1)
+	if replMode {
+		fmt.Fprintln(c.App.ErrWriter)
+		return
+	}
+	os.Exit(exitInvalid)
 }
 
 func onUsageErrorHandler(c *cli.Context, err error, _ bool) error {
@@ -288,7 +288,7 @@ func _archDone(c *cli.Context, msg *cmn.ArchiveBckMsg, a *archbck) {
 	if msg.ListRange.IsList() {
 		what = strings.Join(msg.ListRange.ObjNames, ", ")
 	}
-	fmt.Fprintf(c.App.Writer, "Archived %s/%s => %s\n", a.rsrc.bck.String(), what, a.dest())
+	actionDone(c, fmt.Sprintf("Archived %s/%s => %s", a.rsrc.bck.String(), what, a.dest()))
 }
 
 func putApndArchHandler(c *cli.Context) error {
@@ -338,7 +338,7 @@ func putApndArchHandler(c *cli.Context) error {
 			qflprn(archAppendOnlyFlag), qflprn(archAppendOrPutFlag))
 		actionWarn(c, warn)
 		if flagIsSet(c, yesFlag) {
-			fmt.Fprintf(c.App.ErrWriter, "Assuming %s - proceeding to execute...\n\n", qflprn(archAppendOrPutFlag))
+			actionNote(c, fmt.Sprintf("assuming %s - proceeding to execute...\n", qflprn(archAppendOrPutFlag)))
 		} else {
 			if ok := confirm(c, fmt.Sprintf("Proceed to execute 'archive put %s'?", flprn(archAppendOrPutFlag))); !ok {
 				return nil
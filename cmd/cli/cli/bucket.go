@@ -323,7 +323,7 @@ func showBucketProps(c *cli.Context) error {
 func headBckTable(c *cli.Context, props, defProps *cmn.Bprops, section string) (err error) {
 	var (
 		defList nvpairList
-		colored = !cfg.NoColor
+		colored = !noColor
 		compact = flagIsSet(c, compactPropFlag)
 	)
 	// List instead of map to keep properties in the same order always.
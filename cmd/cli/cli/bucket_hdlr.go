@@ -194,7 +194,9 @@ var (
 			listRangeProgressWaitFlags,
 			keepMDFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
+			verbObjRegexFlag,
 			dryRunFlag,
+			yesFlag,
 			nonRecursFlag, // (embedded prefix dopOLTP)
 			verboseFlag,   // NIY
 			nonverboseFlag,
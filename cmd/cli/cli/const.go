@@ -34,6 +34,7 @@ const (
 	commandTLS      = "tls"
 
 	commandSearch = "search"
+	commandREPL   = "repl"
 )
 
 // top-level `show`
@@ -63,10 +64,12 @@ const (
 	commandCreate    = "create"
 	commandGet       = "get"
 	commandList      = "ls"
+	commandLocate    = "locate"
 	commandSetCustom = "set-custom"
 	commandPut       = "put"
 	commandRemove    = "rm"
 	commandRename    = "mv"
+	commandRetry     = "retry"
 	commandSet       = "set"
 	commandStart     = apc.ActXactStart
 	commandStop      = apc.ActXactStop
@@ -455,6 +458,30 @@ var (
 			indent4 + "\ta/b that have names (relative to this directory) starting with the letter c",
 	}
 
+	// global (must precede the command)
+	endpointFlag = cli.StringFlag{
+		Name: "endpoint",
+		Usage: "AIS endpoint (URL of any gateway) to use for this invocation only, e.g.: 'ais --endpoint http://10.0.0.1:8080 ls';\n" +
+			indent4 + "\ttakes precedence over AIS_ENDPOINT environment and CLI config; fails fast if the cluster cannot be reached",
+	}
+	dryRunAPIFlag = cli.StringFlag{
+		Name: dryRunFlag.Name,
+		Usage: "Print the HTTP requests (method, path, query, and body) instead of sending them, e.g.: 'ais --dry-run=api rm ais://nnn --list a,b';\n" +
+			indent4 + "\tread-only lookups (HEAD, and GET other than object reads) are still sent; the only supported value: 'api'",
+	}
+
+	noColorFlag = cli.BoolFlag{
+		Name: "no-color",
+		Usage: "Disable colored output, e.g.: 'ais --no-color ls ais://nnn';\n" +
+			indent4 + "\tcolors are also disabled when the output is not a terminal, with NO_COLOR environment, and with '--json' or '--quiet'",
+	}
+
+	quietFlag = cli.BoolFlag{
+		Name: "quiet",
+		Usage: "Suppress informational output (confirmations, summaries, notes), e.g.: 'ais --quiet put ...';\n" +
+			indent4 + "\terrors and warnings are still printed, and so is explicitly requested output (e.g., 'ais get ... -', 'ais object show')",
+	}
+
 	//
 	// longRunFlags
 	//
@@ -498,6 +525,15 @@ var (
 		Usage: "Show all columns, including those with only zero values",
 	}
 
+	// rm and evict: select objects by name (client-side, via list-objects)
+	verbObjRegexFlag = cli.StringFlag{
+		Name: regexFlag.Name,
+		Usage: "Regular expression to select objects by name; the matching objects are listed and counted\n" +
+			indent4 + "\tprior to execution, e.g.:\n" +
+			indent4 + "\t'--regex \"\\.tmp$\"'\t- select all objects with names ending with '.tmp';\n" +
+			indent4 + "\t'--prefix logs/ --regex \"2024\"'\t- select objects from the virtual directory logs/ that contain '2024'",
+	}
+
 	regexJobsFlag = cli.StringFlag{
 		Name:  regexFlag.Name,
 		Usage: "Regular expression to select jobs by name, kind, or description, e.g.: --regex \"ec|mirror|elect\"",
@@ -571,6 +607,19 @@ var (
 		Usage: "Override the default hh:mm:ss (hours, minutes, seconds) time format - include calendar date as well",
 	}
 
+	// batch object stat (`ais show object BUCKET --prefix ...`)
+	objStatSortFlag = cli.StringFlag{
+		Name: "sort",
+		Usage: "Sort objects by: name (default), size, atime, or checksum; prefix with '-' for descending order, e.g.:\n" +
+			indent4 + "\t'--sort -size'\t- largest objects first;\n" +
+			indent4 + "\t'--sort atime'\t- least recently accessed first",
+	}
+	objStatConcFlag = cli.IntFlag{
+		Name:  "conc",
+		Usage: "Max number of concurrent HEAD requests (batch object stat)",
+		Value: 16,
+	}
+
 	topFlag = cli.IntFlag{
 		Name:  "top",
 		Usage: "Show top N most recent jobs (e.g., --top 5 to show the 5 most recent jobs)",
@@ -713,6 +762,61 @@ var (
 		Name:  "object-list,from",
 		Usage: "Path to file containing JSON array of object names to download",
 	}
+	dloadPollRetriesFlag = cli.IntFlag{
+		Name: "poll-retries",
+		Usage: "With '--progress': number of consecutive failures to fetch download status (e.g., when the gateway\n" +
+			indent4 + "\tis temporarily unreachable) to tolerate, with increasing backoff, before giving up",
+		Value: dfltPollRetries,
+	}
+	dloadExpectedCountFlag = cli.IntFlag{
+		Name: "expected-count",
+		Usage: "Range download: expected number of objects the template expands to (a safeguard against typos);\n" +
+			indent4 + "\tthe job is rejected (showing the actual count) if the two numbers differ",
+	}
+	dloadMirrorsFlag = cli.StringFlag{
+		Name: "mirrors",
+		Usage: "Single-object download: comma-separated fallback links, in order (e.g. other CDN endpoints of the same file);\n" +
+			indent4 + "\twhen the source link fails, each mirror is tried in turn before the download fails",
+	}
+	dloadStartAfterFlag = cli.StringFlag{
+		Name: "start-after",
+		Usage: "Delayed (scheduled) start: duration since submission (e.g. '6h') or RFC3339 time (e.g. '2025-11-01T02:00:00Z');\n" +
+			indent4 + "\tuntil then, the job is reported as scheduled and can be aborted (canceled) as usual",
+	}
+	dloadBackfillFlag = cli.BoolFlag{
+		Name: "backfill",
+		Usage: "Bucket download: download only the objects that are missing in the cluster, skipping (without comparing)\n" +
+			indent4 + "	those that are already present; unlike '--sync', keeps in-cluster objects that are no longer present remotely",
+	}
+	dloadVerifyExistingFlag = cli.BoolFlag{
+		Name: "verify-existing",
+		Usage: "Before skipping objects that already exist in the destination bucket, validate their checksums;\n" +
+			indent4 + "\tobjects that are unreadable or fail validation get re-downloaded (note: entails reading existing objects)",
+	}
+	dloadExtractFlag = cli.BoolFlag{
+		Name: "extract",
+		Usage: "Extract downloaded archives (" + archFormats + ")\n" +
+			indent4 + "\tand store archived files as separate objects (instead of the archives themselves)",
+	}
+	dloadExtractPrefixFlag = cli.StringFlag{
+		Name:  "extract-prefix",
+		Usage: "With '--extract': virtual destination directory for the extracted files",
+	}
+	dloadDecompressFlag = cli.BoolFlag{
+		Name: "decompress-encoding",
+		Usage: "Decode gzip- or deflate-encoded responses ('Content-Encoding') and store the decoded content;\n" +
+			indent4 + "\tby default, objects are stored exactly as received (e.g., pre-gzipped archives stay gzipped)",
+	}
+	dloadPriorityFlag = cli.IntFlag{
+		Name: "priority",
+		Usage: "Job priority, from -100 to 100 (default 0): pending objects of higher-priority jobs get downloaded first\n" +
+			indent4 + "\t(e.g., so that a small urgent job does not wait behind a large backfill)",
+	}
+	dloadMetadataFlag = cli.StringFlag{
+		Name: "metadata",
+		Usage: "Custom metadata to store with each downloaded object: comma-separated key=value pairs or JSON, e.g.:\n" +
+			indent4 + "\t--metadata 'dataset=imagenet,version=2' (to show, run 'ais show object BUCKET/OBJECT --props=all')",
+	}
 
 	// HuggingFace flags for downloading convenience
 	hfModelFlag = cli.StringFlag{
@@ -859,6 +963,21 @@ var (
 		Name:  "length",
 		Usage: "Object read length; default formatting: IEC (use '--units' to override)",
 	}
+	lastBytesFlag = cli.StringFlag{
+		Name:  "last",
+		Usage: "Read the last N bytes of the object (same as HTTP suffix range 'bytes=-N'); e.g.: '--last 1KiB'",
+	}
+
+	// conditional GET (the object's ETag: remote ETag, if present, or else its checksum value)
+	ifMatchFlag = cli.StringFlag{
+		Name:  "if-match",
+		Usage: "GET only if the object's current ETag matches the specified one (fail otherwise)",
+	}
+	ifNoneMatchFlag = cli.StringFlag{
+		Name: "if-none-match",
+		Usage: "GET only if the object's current ETag differs from the specified one;\n" +
+			indent1 + "\totherwise, write nothing and exit with code 6 (not modified)",
+	}
 
 	// NOTE:
 	// In many cases, stating that a given object "is present" will sound more appropriate and,
@@ -974,6 +1093,20 @@ var (
 		Usage: "Utilize built-in blob-downloader (and the corresponding alternative datapath) to read very large remote objects",
 	}
 
+	// ais get (client-side, single object)
+	getParallelFlag = cli.IntFlag{
+		Name: "parallel",
+		Usage: "Split GET of a single large object into the specified number of concurrent range reads\n" +
+			indent4 + "\tand write the results into the destination file at the corresponding offsets;\n" +
+			indent4 + "\tapplies only to objects of at least '--parallel-min-size' (otherwise, GET via a single stream)",
+	}
+	parallelMinSizeFlag = cli.StringFlag{
+		Name:  "parallel-min-size",
+		Value: "64MiB",
+		Usage: "Minimum object size to GET via concurrent range reads (see '--parallel'),\n" +
+			indent4 + "\tin IEC or SI units, or \"raw\" bytes (e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
+	}
+
 	// num-workers
 	noWorkers = indent4 + "\tuse (-1) to indicate single-threaded serial execution (ie., no workers);\n"
 
@@ -992,6 +1125,16 @@ var (
 		Value: 10,
 		Usage: "Number of concurrent shard-creating workers",
 	}
+	putFilesFlag = cli.StringFlag{
+		Name: "files",
+		Usage: "Path to a CSV file that lists source files and destination object names, one pair per line:\n" +
+			indent4 + "\t'local-path,object-name' (empty lines and lines starting with '#' are ignored);\n" +
+			indent4 + "\tobject names are relative to the destination (virtual) directory, if specified",
+	}
+	stopOnErrorFlag = cli.BoolFlag{
+		Name:  "stop-on-err",
+		Usage: "Stop upon the first failure - do not start uploading the remaining files (default: keep going)",
+	}
 	numPutWorkersFlag = cli.IntFlag{
 		Name:  numBlobWorkersFlag.Name,
 		Value: 10,
@@ -1079,6 +1222,11 @@ var (
 		Name:  "extract,x",
 		Usage: "Extract all files from archive(s)",
 	}
+	decompressFlag = cli.BoolFlag{
+		Name: "decompress",
+		Usage: "Decompress gzip-compressed object on the fly, based on its stored content-encoding (or content-type);\n" +
+			indent4 + "\tno-op (with a warning) if the object is not compressed",
+	}
 
 	inclSrcBucketNameFlag = cli.BoolFlag{
 		Name:  "include-src-bck",
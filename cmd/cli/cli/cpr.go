@@ -7,6 +7,7 @@ package cli
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
+	"github.com/vbauerster/mpb/v4/decor"
 )
 
 type cprCtx struct {
@@ -115,14 +117,32 @@ func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.T
 	return err
 }
 
+// with no (known) total - e.g., prefix-based list/range operation - shows the number
+// of objects processed so far (and the rate) instead of the bar's percentage
 func (cpr *cprCtx) multiobj(c *cli.Context, text string) (err error) {
 	var (
 		progress *mpb.Progress
-		bars     []*mpb.Bar
-		objsArg  = barArgs{barType: unitsArg, barText: text, total: cpr.totals.objs}
+		started  = time.Now()
 	)
-	progress, bars = simpleBar(objsArg)
-	cpr.barObjs = bars[0]
+	if cpr.totals.objs > 0 {
+		var bars []*mpb.Bar
+		objsArg := barArgs{barType: unitsArg, barText: text, total: cpr.totals.objs,
+			options: []mpb.BarOption{mpb.AppendDecorators(decor.Any(objRate(started), decor.WCSyncSpace))}}
+		progress, bars = simpleBar(objsArg)
+		cpr.barObjs = bars[0]
+	} else {
+		progress = mpb.New(mpb.WithWidth(barWidth))
+		cpr.barObjs = progress.AddBar(1, // (dynamic total - see updObjs)
+			mpb.PrependDecorators(
+				decor.Name(text, decor.WC{W: len(text) + 1, C: decor.DidentRight}),
+				decor.Any(func(s *decor.Statistics) string { return strconv.FormatInt(s.Current, 10) }, decor.WCSyncWidth),
+			),
+			mpb.AppendDecorators(
+				decor.Any(objRate(started), decor.WCSyncSpace),
+				decor.Elapsed(decor.ET_STYLE_GO, decor.WCSyncWidth),
+			),
+		)
+	}
 
 	cpr.do(c)
 	progress.Wait()
@@ -136,6 +156,17 @@ func (cpr *cprCtx) multiobj(c *cli.Context, text string) (err error) {
 	return
 }
 
+// objects per second, on average
+func objRate(started time.Time) func(*decor.Statistics) string {
+	return func(s *decor.Statistics) string {
+		elapsed := time.Since(started).Seconds()
+		if s.Current == 0 || elapsed < 1 {
+			return ""
+		}
+		return fmt.Sprintf("%.1f obj/s", float64(s.Current)/elapsed)
+	}
+}
+
 func (cpr *cprCtx) do(c *cli.Context) {
 	cpr.errCh = make(chan error, 1)
 	if flagIsSet(c, waitJobXactFinishedFlag) {
@@ -185,13 +216,19 @@ func (cpr *cprCtx) do(c *cli.Context) {
 		}
 		cpr.updObjs(objs)
 		cpr.updSize(size)
-		if cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size {
+		if cpr.totals.objs == 0 {
+			// total unknown: done when no longer running
+			if nrun == 0 && !cms.running {
+				cpr.completeObjs()
+				break
+			}
+		} else if cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size {
 			if nrun > 0 {
 				time.Sleep(cpr.sleep)
 			}
 			break // NOTE: not waiting for all targets to finish
 		}
-		if nrun == 0 {
+		if nrun == 0 && cpr.totals.objs > 0 {
 			if cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size {
 				break
 			}
@@ -234,6 +271,9 @@ func (cpr *cprCtx) updObjs(objs int64) {
 		return
 	}
 	if cpr.barObjs != nil {
+		if cpr.totals.objs == 0 {
+			cpr.barObjs.SetTotal(objs+1, false) // keep it going
+		}
 		cpr.barObjs.IncrInt64(objs - cpr.objs)
 	}
 	cpr.objs = objs
@@ -251,6 +291,17 @@ func (cpr *cprCtx) updSize(size int64) {
 	cpr.sinceUpd = 0
 }
 
+// (when the total is not known in advance)
+func (cpr *cprCtx) completeObjs() {
+	switch {
+	case cpr.barObjs == nil:
+	case cpr.objs == 0:
+		cpr.barObjs.Abort(false)
+	default:
+		cpr.barObjs.SetTotal(cpr.objs, true)
+	}
+}
+
 func (cpr *cprCtx) abortObjs() {
 	if cpr.barObjs != nil {
 		cpr.barObjs.Abort(true)
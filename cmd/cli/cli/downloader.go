@@ -7,13 +7,16 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/ext/dload"
@@ -47,6 +50,11 @@ type (
 		refreshTime time.Duration
 		timeout     time.Duration
 
+		// tolerate up to so many consecutive (transient) status-fetch failures
+		maxPollErrs  int
+		pollErrs     int
+		reconnecting atomic.Bool
+
 		states map[string]*fileDownloadingState
 
 		p        *mpb.Progress
@@ -64,8 +72,12 @@ type (
 
 const (
 	totalBarText          = "Files downloaded:"
+	reconnectingText      = " (reconnecting)"
 	unknownTotalIncrement = 2048
 	minTotalCnt           = 10
+
+	dfltPollRetries = 5
+	maxPollBackoff  = time.Minute
 )
 
 func (d downloadingResult) String() string {
@@ -101,17 +113,26 @@ func (d downloadingResult) String() string {
 	return sb.String()
 }
 
-func newDownloaderPB(baseParams api.BaseParams, id string, refreshTime, timeout time.Duration) *downloaderPB {
+func newDownloaderPB(baseParams api.BaseParams, id string, refreshTime, timeout time.Duration, maxPollErrs int) *downloaderPB {
 	return &downloaderPB{
 		id:          id,
 		apiBP:       baseParams,
 		refreshTime: refreshTime,
 		timeout:     timeout,
+		maxPollErrs: maxPollErrs,
 		states:      make(map[string]*fileDownloadingState),
 		p:           mpb.New(mpb.WithWidth(barWidth)),
 	}
 }
 
+// number of consecutive status-polling failures the progress bar tolerates
+func dloadPollRetries(c *cli.Context) int {
+	if flagIsSet(c, dloadPollRetriesFlag) {
+		return max(parseIntFlag(c, dloadPollRetriesFlag), 0)
+	}
+	return dfltPollRetries
+}
+
 func (b *downloaderPB) run() (downloadingResult, error) {
 	finishedEarly, err := b.start()
 	if err != nil {
@@ -138,9 +159,18 @@ func (b *downloaderPB) run() (downloadingResult, error) {
 
 		resp, err := api.DownloadStatus(b.apiBP, b.id, true)
 		if err != nil {
-			b.cleanBars()
-			return downloadingResult{}, V(err)
+			backoff, errN := b.pollFailed(err)
+			if errN != nil {
+				b.cleanBars()
+				return downloadingResult{}, errN
+			}
+			time.Sleep(backoff)
+			elapsed += backoff
+			continue
 		}
+		b.pollErrs = 0
+		b.reconnecting.Store(false)
+
 		if resp.Aborted {
 			b.aborted = true
 			break
@@ -152,6 +182,21 @@ func (b *downloaderPB) run() (downloadingResult, error) {
 	return b.result(), nil
 }
 
+// pollFailed distinguishes "can't reach the proxy" (transient) from all other errors
+// (e.g., job not found) and returns the time to back off prior to the next attempt
+func (b *downloaderPB) pollFailed(err error) (time.Duration, error) {
+	if _, unreachable := isUnreachableError(err); !unreachable && !isStartingUp(err) && !isTimeout(err) {
+		return 0, V(err)
+	}
+	b.pollErrs++
+	if b.pollErrs > b.maxPollErrs {
+		return 0, fmt.Errorf("failed to fetch %s status %d consecutive time%s: %v",
+			b.id, b.pollErrs, cos.Plural(b.pollErrs), V(err))
+	}
+	b.reconnecting.Store(true)
+	return min(b.refreshTime*time.Duration(b.pollErrs), maxPollBackoff), nil
+}
+
 func (b *downloaderPB) start() (bool /*finishedE early*/, error) {
 	resp, err := api.DownloadStatus(b.apiBP, b.id, true)
 	if err != nil {
@@ -178,6 +223,7 @@ func (b *downloaderPB) start() (bool /*finishedE early*/, error) {
 			),
 		)
 		options = appendDefaultDecorators(options)
+		options = append(options, mpb.AppendDecorators(decor.Any(b.reconnectingDecor)))
 		b.totalBar = b.p.AddBar(int64(cnt), options...)
 		b.totalBar.IncrBy(b.finishedFiles + b.errFiles)
 	}
@@ -186,6 +232,13 @@ func (b *downloaderPB) start() (bool /*finishedE early*/, error) {
 	return false, nil
 }
 
+func (b *downloaderPB) reconnectingDecor(*decor.Statistics) string {
+	if b.reconnecting.Load() {
+		return reconnectingText
+	}
+	return ""
+}
+
 func (b *downloaderPB) updateStatus(resp *dload.StatusResp) {
 	b.finishedFiles = resp.FinishedCnt
 	b.totalFiles = resp.Total
@@ -404,7 +457,7 @@ func downloadJobStatus(c *cli.Context, id string) error {
 		refreshRate := _refreshRate(c)
 		// Note: timeout=0 for monitoring existing jobs (server-side timeout still applies)
 		// This is different from pbDownload() which applies user-specified timeout for new downloads
-		downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate, 0).run()
+		downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate, 0, dloadPollRetries(c)).run()
 		if err != nil {
 			return err
 		}
@@ -425,30 +478,38 @@ func downloadJobStatus(c *cli.Context, id string) error {
 
 func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 	w := c.App.Writer
-	if d.Aborted {
+	if d.Aborted || d.Scheduled {
 		fmt.Fprintf(w, "Download %s\n", d.String())
 		return
 	}
 
 	if d.JobFinished() {
-		var skipped, errs string
-		if d.SkippedCnt > 0 {
-			skipped = fmt.Sprintf(", skipped: %d", d.SkippedCnt)
+		var (
+			present, skipped, errs string
+			downloaded             = d.DownloadedCnt()
+		)
+		if d.ExistingCnt > 0 {
+			present = fmt.Sprintf(", %d already present", d.ExistingCnt)
+		}
+		if n := d.SkippedCnt - d.ExistingCnt; n > 0 {
+			skipped = fmt.Sprintf(", skipped: %d", n)
 		}
 		if d.ErrorCnt > 0 {
 			errs = fmt.Sprintf(", error%s: %d", cos.Plural(d.ErrorCnt), d.ErrorCnt)
 		}
-		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s\n", d.FinishedCnt, cos.Plural(d.FinishedCnt), skipped, errs)
+		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s%s\n", downloaded, cos.Plural(downloaded), present, skipped, errs)
+		printDlBuckets(w, d)
 
 		if len(d.Errs) == 0 {
 			debug.Assert(d.ErrorCnt == 0)
 			return
 		}
+		if classes := dlErrClasses(d.Errs); classes != "" {
+			fmt.Fprintln(w, "Link errors by class:", classes)
+		}
 		if verbose {
 			fmt.Fprintln(w, "Errors:")
-			for _, e := range d.Errs {
-				fmt.Fprintf(w, "\t%s: %s\n", e.Name, e.Err)
-			}
+			printDlErrs(w, d.Errs)
 		} else {
 			const hint = "Use %s option to list all errors.\n"
 			fmt.Fprintf(w, hint, qflprn(verboseFlag))
@@ -472,8 +533,20 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 			pctDone := 100 * float64(doneCnt) / float64(totalCnt)
 			progressMsg = fmt.Sprintf("%s (%0.2f%%)", progressMsg, pctDone)
 		}
+		switch {
+		case d.ExpectedBytes > 0:
+			pct := 100 * float64(d.Bytes) / float64(d.ExpectedBytes)
+			progressMsg += fmt.Sprintf(", received %s of %s (%0.2f%%)",
+				cos.ToSizeIEC(d.Bytes, 2), cos.ToSizeIEC(d.ExpectedBytes, 2), min(pct, 100))
+		case d.Bytes > 0:
+			progressMsg += ", received " + cos.ToSizeIEC(d.Bytes, 2)
+		}
+		if d.Throughput > 0 {
+			progressMsg += ", " + cos.ToSizeIEC(d.Throughput, 2) + "/s"
+		}
 		fmt.Fprintln(w, progressMsg)
 	}
+	printDlBuckets(w, d)
 	if verbose {
 		if len(d.CurrentTasks) > 0 {
 			sort.Slice(d.CurrentTasks, func(i, j int) bool {
@@ -481,23 +554,71 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 			})
 			for _, task := range d.CurrentTasks {
 				fmt.Fprintf(w, "\t%s: ", task.Name)
-				if task.Total == 0 {
-					fmt.Fprintln(w, cos.ToSizeIEC(task.Downloaded, 2))
-				} else {
-					pctDownloaded := 100 * float64(task.Downloaded) / float64(task.Total)
+				if pctDownloaded, ok := task.Pct(); ok {
 					fmt.Fprintf(w, "%s/%s (%.2f%%)\n",
 						cos.ToSizeIEC(task.Downloaded, 2), cos.ToSizeIEC(task.Total, 2), pctDownloaded)
+				} else {
+					fmt.Fprintln(w, cos.ToSizeIEC(task.Downloaded, 2)) // (size unknown)
 				}
 			}
 		}
 		if d.ErrorCnt > 0 {
 			fmt.Fprintln(w, "Errors:")
-			for _, e := range d.Errs {
-				fmt.Fprintf(w, "\t%s: %s\n", e.Name, e.Err)
-			}
+			printDlErrs(w, d.Errs)
 		}
 	} else if d.ErrorCnt > 0 {
 		fmt.Fprintf(w, "Encountered %d error%s during download %s\n", d.ErrorCnt, cos.Plural(d.ErrorCnt), d.ID)
 		fmt.Fprintf(w, "For details, run 'ais show job %s -v'\n", d.ID)
 	}
 }
+
+// (including the cause and class, if known - e.g., "origin-error, dns")
+func printDlErrs(w io.Writer, errs []dload.TaskErrInfo) {
+	for _, e := range errs {
+		switch {
+		case e.Cause != "" && e.Class != "":
+			fmt.Fprintf(w, "\t%s: %s (%s, %s)\n", e.Name, e.Err, e.Cause, e.Class)
+		case e.Cause != "" || e.Class != "":
+			fmt.Fprintf(w, "\t%s: %s (%s)\n", e.Name, e.Err, e.Cause+e.Class)
+		default:
+			fmt.Fprintf(w, "\t%s: %s\n", e.Name, e.Err)
+		}
+	}
+}
+
+// e.g. "dns: 300, tls: 50" (most frequent first)
+func dlErrClasses(errs []dload.TaskErrInfo) string {
+	counts := make(map[string]int, 4)
+	for _, e := range errs {
+		if e.Class != "" {
+			counts[e.Class]++
+		}
+	}
+	if len(counts) == 0 {
+		return ""
+	}
+	classes := make([]string, 0, len(counts))
+	for class := range counts {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		ci, cj := counts[classes[i]], counts[classes[j]]
+		return ci > cj || (ci == cj && classes[i] < classes[j])
+	})
+	parts := make([]string, len(classes))
+	for i, class := range classes {
+		parts[i] = class + ": " + strconv.Itoa(counts[class])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// multi-bucket job: per-bucket progress
+func printDlBuckets(w io.Writer, d *dload.StatusResp) {
+	for _, b := range d.Buckets {
+		fmt.Fprintf(w, "\t%s: %d/%d", b.Bck.Cname(""), b.FinishedCnt, b.ScheduledCnt)
+		if b.ErrorCnt > 0 {
+			fmt.Fprintf(w, ", error%s: %d", cos.Plural(b.ErrorCnt), b.ErrorCnt)
+		}
+		fmt.Fprintln(w)
+	}
+}
@@ -0,0 +1,152 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// global `--dry-run=api`: instead of executing, print the wire-level requests
+// (as opposed to the per-command `--dry-run` that resolves and shows the affected objects)
+
+const dryRunAPI = "api"
+
+// max printed (JSON) body
+const dryRunMaxBody = 64 * cos.KiB
+
+type apiPrinter struct {
+	rt http.RoundTripper // to send read-only lookups
+	w  io.Writer
+	mu sync.Mutex // (concurrent requests, e.g. multi-object PUT)
+}
+
+// NOTE: the returned client shares the transport (connections) with `client`
+func newAPIPrinter(client *http.Client, w io.Writer) *http.Client {
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &http.Client{Transport: &apiPrinter{rt: rt, w: w}, Timeout: client.Timeout}
+}
+
+// read-only lookups (bucket and object HEADs, cluster map, etc.) are still sent
+// so that the command can proceed; everything else (including object reads)
+// is printed and "succeeds" with 204 No Content
+func (*apiPrinter) lookup(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodHead:
+		return true
+	case http.MethodGet:
+		return !strings.HasPrefix(req.URL.Path, apc.URLPathObjects.S)
+	}
+	return false
+}
+
+func (ap *apiPrinter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if ap.lookup(req) {
+		ap.print(req, "# read-only (sent)")
+		return ap.rt.RoundTrip(req)
+	}
+	ap.print(req, "")
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	resp := &http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Body:       http.NoBody,
+		Request:    req,
+	}
+	return resp, nil
+}
+
+func (ap *apiPrinter) print(req *http.Request, comment string) {
+	var sb strings.Builder
+	if comment != "" {
+		sb.WriteString(comment)
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(req.Method)
+	sb.WriteByte(' ')
+	sb.WriteString(req.URL.RequestURI())
+	sb.WriteByte('\n')
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		switch name {
+		case apc.HdrAuthorization, cos.HdrUserAgent:
+		default:
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		sb.WriteString(name + ": " + strings.Join(req.Header.Values(name), ", ") + "\n")
+	}
+	if req.ContentLength > 0 {
+		fmt.Fprintf(&sb, "%s: %d\n", cos.HdrContentLength, req.ContentLength)
+	}
+	if !printableBody(req) {
+		if req.Body != nil && req.Body != http.NoBody {
+			fmt.Fprintf(&sb, "\n<%s>\n", _bodySize(req.ContentLength))
+		}
+	} else if b, err := readBody(req); err != nil {
+		fmt.Fprintf(&sb, "\n<failed to read body: %v>\n", err)
+	} else if len(b) > 0 {
+		sb.WriteByte('\n')
+		if len(b) > dryRunMaxBody {
+			sb.Write(b[:dryRunMaxBody])
+			fmt.Fprintf(&sb, "... <%d bytes total>", len(b))
+		} else {
+			sb.Write(b)
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+
+	ap.mu.Lock()
+	io.WriteString(ap.w, sb.String())
+	ap.mu.Unlock()
+}
+
+// print (JSON) control messages but not the objects' content
+func printableBody(req *http.Request) bool {
+	return req.Body != nil && req.Body != http.NoBody && strings.HasPrefix(req.Header.Get(cos.HdrContentType), cos.ContentJSON)
+}
+
+// NOTE: when not rewindable, consumes and replaces the original body
+func readBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(strings.NewReader(string(b)))
+	return b, err
+}
+
+func _bodySize(size int64) string {
+	if size < 0 {
+		return "body of unknown size"
+	}
+	return cos.ToSizeIEC(size, 0) + " body"
+}
@@ -8,6 +8,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io/fs"
+	"net"
 	"net/http"
 	"regexp"
 	"strings"
@@ -28,6 +30,16 @@ const (
 	errFmtExclusive  = "flags %s and %s are mutually exclusive"
 )
 
+// process exit codes, to facilitate scripting (documented in docs/cli.md)
+const (
+	exitGeneric     = 1
+	exitNotFound    = 2 // object, bucket, or any other named entity does not exist
+	exitUnreachable = 3 // cannot connect to (or get a response from) the cluster
+	exitAuth        = 4 // unauthorized or forbidden
+	exitInvalid     = 5 // incorrect usage or invalid input (command line, request)
+	exitNotModified = 6 // conditional GET: the object has not changed (nothing written)
+)
+
 type (
 	errUsage struct {
 		helpData      any
@@ -45,6 +57,17 @@ type (
 		name   string
 		suffix string
 	}
+	// invalid command-line input that is not worth a full `errUsage`
+	errInvalid struct {
+		msg string
+	}
+	// conditional GET (see `ifNoneMatchFlag`) - not an error, and prints nothing
+	errNotModified struct{}
+	// formatted error that retains its exit code (see `runOnce`)
+	errExit struct {
+		err  error
+		code int
+	}
 )
 
 //////////////
@@ -102,6 +125,79 @@ func isErrDoesNotExist(err error) bool {
 	return ok
 }
 
+////////////////
+// errInvalid //
+////////////////
+
+func errExclusive(flag1, flag2 string) error {
+	return &errInvalid{msg: fmt.Sprintf(errFmtExclusive, flag1, flag2)}
+}
+
+func (e *errInvalid) Error() string { return e.msg }
+
+func (*errNotModified) Error() string { return "" }
+
+/////////////
+// errExit //
+/////////////
+
+func (e *errExit) Error() string { return e.err.Error() }
+func (e *errExit) Unwrap() error { return e.err }
+
+// ExitCode returns the process exit code for a given `Init` or `Run` error
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if e, ok := err.(*errExit); ok {
+		return e.code
+	}
+	return exitCode(err)
+}
+
+// classify (unformatted) error
+func exitCode(err error) int {
+	switch e := err.(type) {
+	case *errUsage, *errInvalid:
+		return exitInvalid
+	case *errDoesNotExist:
+		return exitNotFound
+	case *errNotModified:
+		return exitNotModified
+	case *errAdditionalInfo:
+		return exitCode(e.baseErr)
+	}
+
+	var herr *cmn.ErrHTTP
+	if errors.As(err, &herr) {
+		switch herr.Status {
+		case http.StatusNotFound:
+			return exitNotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return exitAuth
+		case http.StatusBadRequest, http.StatusMethodNotAllowed, http.StatusRequestedRangeNotSatisfiable,
+			http.StatusUnprocessableEntity:
+			return exitInvalid
+		}
+		if cos.IsUnreachable(herr, herr.Status) || herr.Status == http.StatusGatewayTimeout {
+			return exitUnreachable
+		}
+		return exitGeneric
+	}
+
+	var nerr net.Error
+	switch {
+	case cos.IsNotExist(err, 0), errors.Is(err, fs.ErrNotExist):
+		return exitNotFound
+	case errors.As(err, &nerr), cos.IsRetriableConnErr(err), isCertificateVerificationError(err):
+		return exitUnreachable
+	}
+	if _, unreachable := isUnreachableError(err); unreachable {
+		return exitUnreachable
+	}
+	return exitGeneric
+}
+
 //
 // misc. utils, helpers
 //
@@ -361,16 +457,16 @@ func formatErr(err error) error {
 	}
 	if _, unreachable := isUnreachableError(err); unreachable {
 		errmsg := fmt.Sprintf("AIStore cannot be reached at %s\n", clusterURL)
-		errmsg += fmt.Sprintf("Make sure that environment '%s' has the address of any AIS gateway (proxy).\n"+
+		errmsg += fmt.Sprintf("Make sure that environment '%s' or %s specifies the address of any AIS gateway (proxy).\n"+
 			"For defaults, see CLI config at %s or run `ais show config cli`.",
-			env.AisEndpoint, config.Path())
+			env.AisEndpoint, qflprn(endpointFlag), config.Path())
 		return redErr(errors.New(errmsg))
 	}
 	switch err := err.(type) {
 	case *cmn.ErrHTTP:
 		herr := err
 		return redErr(herr)
-	case *errUsage:
+	case *errUsage, *errNotModified:
 		return err
 	case *errAdditionalInfo:
 		err.baseErr = formatErr(err.baseErr)
@@ -150,7 +150,7 @@ func parseDurationFlag(c *cli.Context, flag cli.Flag) time.Duration {
 func parseUnitsFlag(c *cli.Context, flag cli.StringFlag) (units string, err error) {
 	units = parseStrFlag(c, flag) // enum { unitsSI, ... }
 	if err = teb.ValidateUnits(units); err != nil {
-		err = fmt.Errorf("%s=%s is invalid: %v", flprn(flag), units, err)
+		err = &errInvalid{msg: fmt.Sprintf("%s=%s is invalid: %v", flprn(flag), units, err)}
 	}
 	return
 }
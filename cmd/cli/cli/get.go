@@ -6,6 +6,7 @@
 package cli
 
 import (
+	"compress/gzip"
 	"errors"
 	"fmt"
 	"io"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -35,6 +37,20 @@ const (
 
 const extractVia = "--extract(*)"
 
+// (see `decompressFlag`)
+const (
+	encodingGzip  = "gzip"
+	hdrContentEnc = "Content-Encoding"
+)
+
+// decompresses (gunzips) GET response on the fly
+type decompressor struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+	err  error
+	n    int64 // decompressed size
+}
+
 type qparamArch struct {
 	archpath string // apc.QparamArchpath
 	archmime string // apc.QparamArchmime
@@ -53,9 +69,78 @@ func catHandler(c *cli.Context) error {
 		return err
 	}
 	a := qparamArch{archpath: parseStrFlag(c, archpathGetFlag)}
+	if flagIsSet(c, offsetFlag) || flagIsSet(c, lengthFlag) || flagIsSet(c, lastBytesFlag) {
+		if a.archpath != "" {
+			return errRangeReadArch(qflprn(archpathGetFlag))
+		}
+		return catRange(c, bck, objName)
+	}
 	return getObject(c, bck, objName, fileStdIO, a, true /*quiet*/, false /*extract*/)
 }
 
+// catRange writes the requested byte range (and nothing else) to STDOUT; supported variations:
+// - offset and length
+// - offset only (read through the end of the object)
+// - length only (read from the beginning)
+// - last N bytes (same as HTTP suffix range 'bytes=-N')
+func catRange(c *cli.Context, bck cmn.Bck, objName string) error {
+	var (
+		offset, length, last int64
+		err                  error
+	)
+	if flagIsSet(c, lastBytesFlag) && (flagIsSet(c, offsetFlag) || flagIsSet(c, lengthFlag)) {
+		return fmt.Errorf("%s cannot be used together with %s or %s", qflprn(lastBytesFlag), qflprn(offsetFlag), qflprn(lengthFlag))
+	}
+	if offset, err = parseSizeFlag(c, offsetFlag); err != nil {
+		return err
+	}
+	if length, err = parseSizeFlag(c, lengthFlag); err != nil {
+		return err
+	}
+	if last, err = parseSizeFlag(c, lastBytesFlag); err != nil {
+		return err
+	}
+	if offset < 0 || length < 0 || last < 0 {
+		return errors.New("range offset and length must be non-negative")
+	}
+	if flagIsSet(c, encodeObjnameFlag) {
+		objName = url.PathEscape(objName)
+	}
+
+	// validate against the object size
+	props, err := api.HeadObject(apiBP, bck, objName, api.HeadArgs{FltPresence: apc.FltExists, Silent: true})
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+		}
+		return V(err)
+	}
+	size := props.Size
+	switch {
+	case flagIsSet(c, lastBytesFlag):
+		if last == 0 || last > size {
+			return fmt.Errorf("invalid %s=%d: %s size is %d", qflprn(lastBytesFlag), last, bck.Cname(objName), size)
+		}
+		offset, length = size-last, last
+	case !flagIsSet(c, lengthFlag):
+		length = size - offset
+	}
+	if offset >= size || length == 0 || offset+length > size {
+		return fmt.Errorf("range (offset %d, length %d) is out of bounds: %s size is %d", offset, length, bck.Cname(objName), size)
+	}
+
+	getArgs := api.GetArgs{
+		Writer: os.Stdout,
+		Header: http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(offset, length)}},
+	}
+	if flagIsSet(c, cksumFlag) {
+		_, err = api.GetObjectWithValidation(apiBP, bck, objName, &getArgs)
+	} else {
+		_, err = api.GetObject(apiBP, bck, objName, &getArgs)
+	}
+	return V(err)
+}
+
 func getHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -65,10 +150,10 @@ func getHandler(c *cli.Context) error {
 	}
 	if flagIsSet(c, latestVerFlag) {
 		if flagIsSet(c, headObjPresentFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(latestVerFlag), qflprn(headObjPresentFlag))
+			return errExclusive(qflprn(latestVerFlag), qflprn(headObjPresentFlag))
 		}
 		if flagIsSet(c, getObjCachedFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(latestVerFlag), qflprn(getObjCachedFlag))
+			return errExclusive(qflprn(latestVerFlag), qflprn(getObjCachedFlag))
 		}
 	}
 
@@ -89,12 +174,22 @@ func getHandler(c *cli.Context) error {
 			qflprn(latestVerFlag), bck.String())
 	}
 
+	if flagIsSet(c, getParallelFlag) {
+		if n := parseIntFlag(c, getParallelFlag); n < 2 || n > maxParallelGet {
+			return fmt.Errorf("invalid %s=%d: expecting (2..%d) range", flprn(getParallelFlag), n, maxParallelGet)
+		}
+		for _, f := range []cli.Flag{lengthFlag, blobDownloadFlag, decompressFlag, extractFlag, archpathGetFlag} {
+			if flagIsSet(c, f) {
+				return errExclusive(qflprn(getParallelFlag), qflprn(f))
+			}
+		}
+	}
 	if flagIsSet(c, blobDownloadFlag) {
 		if flagIsSet(c, lengthFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(lengthFlag), qflprn(blobDownloadFlag))
+			return errExclusive(qflprn(lengthFlag), qflprn(blobDownloadFlag))
 		}
 		if flagIsSet(c, getObjCachedFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(getObjCachedFlag), qflprn(blobDownloadFlag))
+			return errExclusive(qflprn(getObjCachedFlag), qflprn(blobDownloadFlag))
 		}
 		if flagIsSet(c, archpathGetFlag) {
 			return errors.New("cannot use blob downloader to read archived files - " + NIY)
@@ -108,6 +203,17 @@ func getHandler(c *cli.Context) error {
 		}
 	}
 
+	for _, cf := range []cli.Flag{ifMatchFlag, ifNoneMatchFlag} {
+		if !flagIsSet(c, cf) {
+			continue
+		}
+		for _, f := range []cli.Flag{getObjPrefixFlag, blobDownloadFlag, getParallelFlag} {
+			if flagIsSet(c, f) {
+				return errExclusive(qflprn(cf), qflprn(f))
+			}
+		}
+	}
+
 	// destination (empty "" implies using source `basename`)
 	outFile := c.Args().Get(1)
 
@@ -145,7 +251,7 @@ func getHandler(c *cli.Context) error {
 	// validate '--extract' and '--archpath' vs other command line
 	if extract {
 		if flagIsSet(c, headObjPresentFlag) {
-			return fmt.Errorf(errFmtExclusive, extractVia, qflprn(headObjPresentFlag))
+			return errExclusive(extractVia, qflprn(headObjPresentFlag))
 		}
 		if flagIsSet(c, lengthFlag) {
 			return errRangeReadArch(extractVia)
@@ -274,7 +380,7 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bo
 		verb, discard, l, cos.Plural(l), bck.Cname(""), out, teb.FmtSize(totalSize, units, 2))
 
 	if flagIsSet(c, yesFlag) && (l > 1 || quiet) {
-		fmt.Fprintln(c.App.Writer, cptn)
+		actionDone(c, cptn)
 	} else if !confirm(c, cptn) {
 		return nil
 	}
@@ -395,6 +501,7 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 	if outFile == fileStdIO && extract {
 		return errors.New("cannot extract archived files to standard output - " + NIY)
 	}
+	quiet = quiet || isQuiet(c)
 	if discardOutput(outFile) && extract {
 		return errors.New("cannot extract and discard archived files - " + NIY)
 	}
@@ -414,6 +521,21 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 		return err
 	}
 
+	var encoding string
+	if flagIsSet(c, decompressFlag) {
+		if extract || a.enabled() {
+			return fmt.Errorf("%s cannot be used to read archived files (%s, %s)", qflprn(decompressFlag),
+				qflprn(extractFlag), qflprn(archpathGetFlag))
+		}
+		encoding, err = storedEncoding(bck, objName)
+		if err != nil {
+			return err
+		}
+		if encoding == "" {
+			actionWarn(c, fmt.Sprintf("%s is not compressed - ignoring %s", bck.Cname(objName), qflprn(decompressFlag)))
+		}
+	}
+
 	var offset, length int64
 	if offset, err = parseSizeFlag(c, offsetFlag, units); err != nil {
 		return err
@@ -421,13 +543,19 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 	if length, err = parseSizeFlag(c, lengthFlag, units); err != nil {
 		return err
 	}
+	if encoding != "" && length > 0 {
+		return errExclusive(qflprn(decompressFlag), qflprn(lengthFlag))
+	}
 
 	// where to
 	if outFile == "" {
 		// archive
-		if a.archpath != "" {
+		switch {
+		case a.archpath != "":
 			outFile = filepath.Base(a.archpath)
-		} else {
+		case encoding != "":
+			outFile = trimGzipExt(filepath.Base(objName))
+		default:
 			outFile = filepath.Base(objName)
 		}
 	} else if outFile != fileStdIO && !discardOutput(outFile) {
@@ -487,6 +615,38 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 			qflprn(chunkSizeFlag), qflprn(numBlobWorkersFlag), qflprn(blobDownloadFlag))
 	}
 
+	// conditional GET
+	if flagIsSet(c, ifMatchFlag) || flagIsSet(c, ifNoneMatchFlag) {
+		if hdr == nil {
+			hdr = make(http.Header, 2)
+		}
+		if flagIsSet(c, ifMatchFlag) {
+			hdr.Set(cos.HdrIfMatch, quoteETag(parseStrFlag(c, ifMatchFlag)))
+		}
+		if flagIsSet(c, ifNoneMatchFlag) {
+			hdr.Set(cos.HdrIfNoneMatch, quoteETag(parseStrFlag(c, ifNoneMatchFlag)))
+		}
+	}
+
+	// concurrent range reads into a local file (falling back to a single stream when not applicable)
+	if flagIsSet(c, getParallelFlag) && outFile != fileStdIO && !discardOutput(outFile) {
+		if now == 0 && !quiet {
+			now = mono.NanoTime()
+		}
+		objLen, n, errP := getParallel(c, bck, objName, outFile)
+		if errP != nil {
+			return errP
+		}
+		if n > 0 {
+			if !quiet {
+				sz := teb.FmtSize(objLen, units, 2)
+				fmt.Fprintf(c.App.Writer, "GET %s from %s as %s (%s, %d range reads) in %s\n", objName, bck.Cname(""), outFile,
+					sz, n, teb.FormatDuration(mono.Since(now)))
+			}
+			return nil
+		}
+	}
+
 	var getArgs api.GetArgs
 	switch {
 	case outFile == fileStdIO:
@@ -508,6 +668,12 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 		getArgs = api.GetArgs{Writer: file, Header: hdr}
 	}
 
+	var dc *decompressor
+	if encoding != "" {
+		dc = newDecompressor(getArgs.Writer)
+		getArgs.Writer = dc.pw
+	}
+
 	// finally: http query and API call
 	getArgs.Query = a.getQuery(c, &bck)
 
@@ -522,17 +688,25 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 	} else {
 		oah, err = api.GetObject(apiBP, bck, objName, &getArgs)
 	}
+	objLen := oah.Size()
+	if dc != nil {
+		var errD error
+		if objLen, errD = dc.finish(err); err == nil && errD != nil {
+			err = fmt.Errorf("failed to decompress %s: %v", bck.Cname(objName), errD)
+		}
+	}
 	if err != nil {
 		if cmn.IsStatusNotFound(err) && !a.enabled() {
 			err = &errDoesNotExist{what: "object", name: bck.Cname(objName)}
 		}
 		return err
 	}
+	if oah.NotModified() {
+		err = &errNotModified{} // (no output; the file, if any, gets removed)
+		return err
+	}
 
-	var (
-		mime   string
-		objLen = oah.Size()
-	)
+	var mime string
 	if extract {
 		mime, err = doExtract(objName, outFile, objLen)
 		if err != nil {
@@ -574,7 +748,11 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 		elapsed = " in " + teb.FormatDuration(mono.Since(now))
 	}
 	if objLen > 0 {
-		sz = " (" + teb.FmtSize(objLen, units, 2) + ")"
+		sz = teb.FmtSize(objLen, units, 2)
+		if dc != nil {
+			sz += " decompressed"
+		}
+		sz = " (" + sz + ")"
 	}
 	switch {
 	case flagIsSet(c, lengthFlag):
@@ -589,6 +767,14 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 	return nil
 }
 
+// (entity tags are quoted strings - see https://www.rfc-editor.org/rfc/rfc9110#name-etag)
+func quoteETag(etag string) string {
+	if etag == "*" || strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, `W/"`) {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
 //
 // qparamArch
 //
@@ -612,7 +798,7 @@ func (a *qparamArch) init(c *cli.Context) error {
 		a.archmode = mmode
 	}
 	if a.archpath != "" && a.archregx != "" {
-		return fmt.Errorf(errFmtExclusive, qflprn(archpathGetFlag), qflprn(archregxFlag))
+		return errExclusive(qflprn(archpathGetFlag), qflprn(archregxFlag))
 	}
 	return nil
 }
@@ -622,7 +808,7 @@ func (a *qparamArch) validate(c *cli.Context) error {
 		return nil
 	}
 	if flagIsSet(c, getObjPrefixFlag) {
-		return fmt.Errorf(errFmtExclusive, qflprn(getObjPrefixFlag), qflprn(archpathGetFlag))
+		return errExclusive(qflprn(getObjPrefixFlag), qflprn(archpathGetFlag))
 	}
 	if flagIsSet(c, headObjPresentFlag) {
 		return fmt.Errorf("cannot check presence (%s) of archived file(s) (%s) - "+NIY,
@@ -739,3 +925,161 @@ func (ex *extractor) _write(filename string, size int64, wfh *os.File, reader io
 func discardOutput(outf string) bool {
 	return outf == "/dev/null" || outf == "dev/null" || outf == "dev/nil"
 }
+
+//
+// decompress
+//
+
+// returns "gzip" if the object is stored gzip-compressed, empty string otherwise
+func storedEncoding(bck cmn.Bck, objName string) (string, error) {
+	props, err := api.HeadObject(apiBP, bck, objName, api.HeadArgs{FltPresence: apc.FltExists, Silent: true})
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+		}
+		return "", err
+	}
+	for k, v := range props.GetCustomMD() {
+		v = strings.ToLower(strings.TrimSpace(v))
+		switch {
+		case strings.EqualFold(k, hdrContentEnc):
+			if v == encodingGzip || v == "x-gzip" {
+				return encodingGzip, nil
+			}
+		case strings.EqualFold(k, cos.HdrContentType):
+			if v == "application/gzip" || v == "application/x-gzip" {
+				return encodingGzip, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func trimGzipExt(name string) string {
+	for _, ext := range []string{".gz", ".gzip"} {
+		if s := strings.TrimSuffix(name, ext); s != name && s != "" {
+			return s
+		}
+	}
+	return name
+}
+
+func newDecompressor(w io.Writer) *decompressor {
+	pr, pw := io.Pipe()
+	dc := &decompressor{pw: pw, done: make(chan struct{})}
+	go func() {
+		zr, err := gzip.NewReader(pr)
+		if err == nil {
+			dc.n, err = io.Copy(w, zr)
+			zr.Close()
+		}
+		dc.err = err
+		pr.CloseWithError(err) // unblock the writer, if need be
+		close(dc.done)
+	}()
+	return dc
+}
+
+// to be called once the GET completes (or fails)
+func (dc *decompressor) finish(errGet error) (int64, error) {
+	dc.pw.CloseWithError(errGet) // nil => io.EOF
+	<-dc.done
+	return dc.n, dc.err
+}
+
+//
+// parallel GET: a single (large) object via concurrent range reads (see `getParallelFlag`)
+//
+
+const maxParallelGet = 64
+
+var errRangeNotSupported = errors.New("range read not supported")
+
+// returns the number of range reads, or zero when not applicable (to fall back to a single stream)
+func getParallel(c *cli.Context, bck cmn.Bck, objName, outFile string) (size int64, n int, err error) {
+	minSize, err := parseSizeFlag(c, parallelMinSizeFlag)
+	if err != nil {
+		return 0, 0, err
+	}
+	if flagIsSet(c, encodeObjnameFlag) {
+		objName = url.PathEscape(objName)
+	}
+	// NOTE: failing to HEAD is not an error at this point - the subsequent (single-stream) GET will tell
+	props, errH := api.HeadObject(apiBP, bck, objName, api.HeadArgs{FltPresence: apc.FltExists, Silent: true})
+	if errH != nil {
+		return 0, 0, nil
+	}
+	size, n = props.Size, parseIntFlag(c, getParallelFlag)
+	if size < minSize || size < int64(n) {
+		return 0, 0, nil
+	}
+
+	file, err := os.Create(outFile)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err = file.Truncate(size); err == nil {
+		err = _getRanges(bck, objName, file, size, n)
+	}
+	if err == nil && flagIsSet(c, cksumFlag) {
+		err = _validateFile(file, props.Cksum, bck.Cname(objName))
+	}
+	errC := file.Close()
+	if err == nil {
+		err = errC
+	}
+	if err != nil {
+		os.Remove(outFile)
+		if errors.Is(err, errRangeNotSupported) {
+			actionWarn(c, fmt.Sprintf("%s: %v - falling back to a single stream", bck.Cname(objName), err))
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	return size, n, nil
+}
+
+func _getRanges(bck cmn.Bck, objName string, file *os.File, size int64, n int) error {
+	var (
+		group errgroup.Group
+		chunk = (size + int64(n) - 1) / int64(n)
+	)
+	for off := int64(0); off < size; off += chunk {
+		length := min(chunk, size-off)
+		group.Go(func() error {
+			args := api.GetArgs{
+				Writer: io.NewOffsetWriter(file, off),
+				Header: http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(off, length)}},
+			}
+			oah, err := api.GetObject(apiBP, bck, objName, &args)
+			if err != nil {
+				if herr := cmn.Err2HTTPErr(err); herr != nil && herr.Status == http.StatusRequestedRangeNotSatisfiable {
+					return errRangeNotSupported
+				}
+				return err
+			}
+			if oah.Size() != length {
+				return fmt.Errorf("%w: expecting %d bytes at offset %d, got %d", errRangeNotSupported, length, off, oah.Size())
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+func _validateFile(file *os.File, cksum *cos.Cksum, cname string) error {
+	if cksum == nil || cksum.IsEmpty() {
+		return fmt.Errorf("cannot validate %s: checksum not available", cname)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, computed, err := cos.CopyAndChecksum(io.Discard, file, nil, cksum.Ty())
+	if err != nil {
+		return err
+	}
+	if !computed.Equal(cksum) {
+		return cos.NewErrDataCksum(&computed.Cksum, cksum, cname)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/authn"
@@ -17,15 +18,26 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/tools/docker"
+
+	"github.com/urfave/cli"
+	"golang.org/x/term"
 )
 
 var loggedUserToken string
 
+// colored output: disabled via `no_color` in CLI config, global `--no-color`, or NO_COLOR environment
+// (see https://no-color.org), and also when the output is not a terminal or is JSON or quiet
+var noColor bool
+
+const envNoColor = "NO_COLOR"
+
 func Init(args []string) (err error) {
 	cfg, err = config.Load(args, cmdReset)
 	if err != nil {
 		return err
 	}
+	noColor = isNoColor(args)
+
 	// kubernetes
 	k8sDetected = detectK8s()
 
@@ -40,7 +52,8 @@ func Init(args []string) (err error) {
 	loggedUserToken, _ = authn.LoadToken("") // No error handling as token might not be needed
 
 	// http clients: the main one and the auth, if enabled
-	clusterURL = _clusterURL(cfg)
+	endpoint := endpointArg(args)
+	clusterURL = _clusterURL(cfg, endpoint)
 
 	var (
 		cargs = cmn.TransportArgs{
@@ -71,6 +84,14 @@ func Init(args []string) (err error) {
 		apiBP.Client = clientH
 	}
 
+	// global `--dry-run=api`
+	if mode := globalArg(args, dryRunAPIFlag.Name); mode != "" && !isHelpOrCompletion(args) {
+		if mode != dryRunAPI {
+			return fmt.Errorf("invalid %s=%q (expecting %q)", flprn(dryRunAPIFlag), mode, dryRunAPI)
+		}
+		apiBP.Client = newAPIPrinter(apiBP.Client, os.Stdout)
+	}
+
 	if authnURL := cliAuthnURL(cfg); authnURL != "" {
 		authParams = api.BaseParams{
 			URL:   authnURL,
@@ -90,15 +111,112 @@ func Init(args []string) (err error) {
 			authParams.Client = clientH
 		}
 	}
+
+	// ad hoc endpoint: fail fast (and clearly) if unreachable
+	if endpoint != "" && !isHelpOrCompletion(args) {
+		return checkReachable()
+	}
 	return nil
 }
 
+func isNoColor(args []string) bool {
+	if cfg.NoColor || os.Getenv(envNoColor) != "" || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return true
+	}
+	if globalBool(args, noColorFlag.Name) || globalBool(args, quietFlag.Name) {
+		return true
+	}
+	// command-specific `--json` (anywhere in the command line)
+	jflag := flprn(jsonFlag)
+	for _, arg := range args[1:] {
+		if arg == jflag || arg == jflag+"=true" || arg == "-j" {
+			return true
+		}
+	}
+	return false
+}
+
+// global `--endpoint` (must precede the command), e.g.: `ais --endpoint http://10.0.0.1:8080 ls`
+// NOTE: is parsed here, prior to `Run`, to resolve `clusterURL`
+func endpointArg(args []string) string { return globalArg(args, endpointFlag.Name) }
+
+// the value of the named global (string) flag
+func globalArg(args []string, flagName string) (val string) {
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			break // command
+		}
+		name, v, hasVal := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != endpointFlag.Name && name != dryRunAPIFlag.Name {
+			continue
+		}
+		if !hasVal {
+			if i+1 >= len(args) {
+				break
+			}
+			i++
+			v = args[i]
+		}
+		if name == flagName {
+			val = v
+		}
+	}
+	return val
+}
+
+// whether the named global (boolean) flag is set
+func globalBool(args []string, flagName string) bool {
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			break // command
+		}
+		name, v, hasVal := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		switch name {
+		case flagName:
+			return !hasVal || v == "true"
+		case endpointFlag.Name, dryRunAPIFlag.Name:
+			if !hasVal {
+				i++ // skip the value
+			}
+		}
+	}
+	return false
+}
+
+func isHelpOrCompletion(args []string) bool {
+	for _, arg := range args[1:] {
+		switch strings.TrimLeft(arg, "-") {
+		case "h", "help", cli.BashCompletionFlag.GetName():
+			return true
+		}
+	}
+	return false
+}
+
+func checkReachable() error {
+	err := api.Health(apiBP)
+	if err == nil {
+		return nil
+	}
+	if msg, unreachable := isUnreachableError(err); unreachable {
+		return fmt.Errorf("cannot reach cluster at %s: %s", clusterURL, msg)
+	}
+	return nil // reachable (e.g., still starting up) - let the command itself handle it
+}
+
 // resolving order:
-// 1. cfg.Cluster.URL; if empty:
-// 2. Proxy docker container IP address; if not successful:
-// 3. Docker default; if not present:
-// 4. Default as cfg.Cluster.DefaultAISHost
-func _clusterURL(cfg *config.Config) string {
+// 1. `--endpoint`; if not specified:
+// 2. environment (env.AisEndpoint); if empty:
+// 3. cfg.Cluster.URL; if empty:
+// 4. Proxy docker container IP address; if not successful:
+// 5. Docker default; if not present:
+// 6. Default as cfg.Cluster.DefaultAISHost
+func _clusterURL(cfg *config.Config, endpoint string) string {
+	if endpoint != "" {
+		return endpoint
+	}
 	if envURL := os.Getenv(env.AisEndpoint); envURL != "" {
 		return envURL
 	}
@@ -84,6 +84,7 @@ var (
 		jobStopSub,
 		jobWaitSub,
 		jobRemoveSub,
+		jobRetrySub,
 		makeAlias(showCmdJob, "", true, commandShow), // alias for `ais show`
 	}
 )
@@ -110,7 +111,20 @@ var (
 			waitJobXactFinishedFlag,
 			limitBytesPerHourFlag,
 			syncFlag,
+			dloadBackfillFlag,
+			dloadExpectedCountFlag,
+			dloadMirrorsFlag,
+			dloadStartAfterFlag,
+			dloadVerifyExistingFlag,
+			dloadExtractFlag,
+			dloadExtractPrefixFlag,
+			dloadDecompressFlag,
+			dloadPriorityFlag,
+			dloadMetadataFlag,
+			dloadPollRetriesFlag,
 			unitsFlag,
+			dryRunFlag,
+			yesFlag,
 			// huggingface flags
 			hfModelFlag,
 			hfDatasetFlag,
@@ -249,6 +263,7 @@ var (
 		refreshFlag,
 		progressFlag,
 		waitJobXactFinishedFlag,
+		dloadPollRetriesFlag, // download only
 	}
 	jobWaitSub = cli.Command{
 		Name:         commandWait,
@@ -290,6 +305,31 @@ var (
 	}
 )
 
+// ais job retry
+var (
+	retryCmdsFlags = []cli.Flag{
+		progressFlag,
+		refreshFlag,
+		waitFlag,
+		dloadTimeoutFlag,
+	}
+	jobRetrySub = cli.Command{
+		Name:  commandRetry,
+		Usage: "retry failed objects of a finished job",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdDownload,
+				Usage: "resubmit the objects that failed to download as a new download job, reusing the original request\n" +
+					indent1 + "(destination bucket, headers, limits, checksums, etc.); objects that exist by now are skipped",
+				ArgsUsage:    jobIDArgument,
+				Flags:        sortFlags(retryCmdsFlags),
+				Action:       retryDownloadHandler,
+				BashComplete: downloadIDFinishedCompletions,
+			},
+		},
+	}
+)
+
 func appendJobSub(jobcmd *cli.Command) {
 	debug.Assert(jobcmd.Subcommands[0].Name == commandStart)
 
@@ -433,6 +473,25 @@ func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
 	return waitJob(c, xargs.Kind, xid, xargs.Bck)
 }
 
+func parseDlMetadata(c *cli.Context) (cos.StrKVs, error) {
+	if !flagIsSet(c, dloadMetadataFlag) {
+		return nil, nil
+	}
+	s := parseStrFlag(c, dloadMetadataFlag)
+	if isJSON(s) {
+		md := cos.StrKVs{}
+		if err := jsoniter.Unmarshal([]byte(s), &md); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", qflprn(dloadMetadataFlag), err)
+		}
+		return md, nil
+	}
+	md, err := makePairs(splitCsv(s))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s (expecting key1=value1,key2=value2,...): %v", qflprn(dloadMetadataFlag), err)
+	}
+	return md, nil
+}
+
 // downloadRequest holds parsed and validated download arguments
 type downloadRequest struct {
 	source          dlSource
@@ -504,12 +563,24 @@ func parseDownloadRequest(c *cli.Context) (*downloadRequest, error) {
 		return nil, err
 	}
 
+	metadata, err := parseDlMetadata(c)
+	if err != nil {
+		return nil, err
+	}
+
 	basePayload := dload.Base{
-		Bck:              bck,
-		Timeout:          timeout,
-		Description:      description,
-		ProgressInterval: progressInterval,
-		Headers:          source.headers,
+		Bck:                bck,
+		Timeout:            timeout,
+		Description:        description,
+		ProgressInterval:   progressInterval,
+		Headers:            source.headers,
+		VerifyExisting:     flagIsSet(c, dloadVerifyExistingFlag),
+		Extract:            flagIsSet(c, dloadExtractFlag),
+		ExtractPrefix:      parseStrFlag(c, dloadExtractPrefixFlag),
+		StartAfter:         parseStrFlag(c, dloadStartAfterFlag),
+		Metadata:           metadata,
+		DecompressEncoding: flagIsSet(c, dloadDecompressFlag),
+		Priority:           parseIntFlag(c, dloadPriorityFlag),
 		Limits: dload.Limits{
 			Connections:  parseIntFlag(c, limitConnectionsFlag),
 			BytesPerHour: int(limitBPH),
@@ -667,11 +738,16 @@ func prepareFileBasedDownload(req *downloadRequest) (dload.MultiBody, error) {
 func prepareDownloadPayload(c *cli.Context, dlType dload.Type, req *downloadRequest) (any, error) {
 	switch dlType {
 	case dload.TypeSingle:
+		var mirrors []string
+		if flagIsSet(c, dloadMirrorsFlag) {
+			mirrors = splitCsv(parseStrFlag(c, dloadMirrorsFlag))
+		}
 		return dload.SingleBody{
 			Base: req.basePayload,
 			SingleObj: dload.SingleObj{
 				Link:    req.source.link,
 				ObjName: req.pathSuffix, // in this case pathSuffix is a full name of the object
+				Mirrors: mirrors,
 			},
 		}, nil
 
@@ -683,16 +759,21 @@ func prepareDownloadPayload(c *cli.Context, dlType dload.Type, req *downloadRequ
 
 	case dload.TypeRange:
 		return dload.RangeBody{
-			Base:     req.basePayload,
-			Subdir:   req.pathSuffix, // in this case pathSuffix is a subdirectory in which the objects are to be saved
-			Template: req.source.link,
+			Base:          req.basePayload,
+			Subdir:        req.pathSuffix, // in this case pathSuffix is a subdirectory in which the objects are to be saved
+			Template:      req.source.link,
+			ExpectedCount: int64(parseIntFlag(c, dloadExpectedCountFlag)),
 		}, nil
 
 	case dload.TypeBackend:
+		if flagIsSet(c, syncFlag) && flagIsSet(c, dloadBackfillFlag) {
+			return nil, errExclusive(qflprn(syncFlag), qflprn(dloadBackfillFlag))
+		}
 		return dload.BackendBody{
-			Base:   req.basePayload,
-			Sync:   flagIsSet(c, syncFlag),
-			Prefix: req.source.backend.prefix,
+			Base:     req.basePayload,
+			Sync:     flagIsSet(c, syncFlag),
+			Backfill: flagIsSet(c, dloadBackfillFlag),
+			Prefix:   req.source.backend.prefix,
 		}, nil
 
 	default:
@@ -701,6 +782,43 @@ func prepareDownloadPayload(c *cli.Context, dlType dload.Type, req *downloadRequ
 	}
 }
 
+// max number of names to list when estimating bucket-wide download (see confirmBackendDownload)
+const maxDloadEstimate = 10_000
+
+// confirmBackendDownload lists the source bucket (using the same prefix as the targets will)
+// to estimate the number of objects to download - stopping at `maxDloadEstimate`;
+// with '--dry-run' prints the estimate and returns false
+func confirmBackendDownload(c *cli.Context, req *downloadRequest) (bool, error) {
+	var (
+		bck    = req.source.backend.bck
+		prefix = req.source.backend.prefix
+		msg    = &apc.LsoMsg{Prefix: prefix, Props: apc.GetPropsName}
+	)
+	msg.SetFlag(apc.LsNameOnly)
+	lst, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{Limit: maxDloadEstimate + 1})
+	if err != nil {
+		return false, V(err)
+	}
+	var (
+		cnt    = len(lst.Entries)
+		approx = "~"
+	)
+	switch {
+	case cnt > maxDloadEstimate:
+		cnt, approx = maxDloadEstimate, "more than "
+	case flagIsSet(c, dloadBackfillFlag):
+		approx = "at most " // (missing ones only)
+	}
+	estimate := fmt.Sprintf("this will download %s%d object%s from %s to %s",
+		approx, cnt, cos.Plural(cnt), bck.Cname(prefix), req.basePayload.Bck.Cname(""))
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+		fmt.Fprintln(c.App.Writer, estimate)
+		return false, nil
+	}
+	return confirm(c, "Proceed?", estimate), nil
+}
+
 // validateDownloadType performs validation for multi-downloads
 func validateMultiDownloadType(dlType dload.Type, req *downloadRequest) error {
 	if dlType != dload.TypeMulti {
@@ -735,6 +853,15 @@ func startDownloadHandler(c *cli.Context) error {
 		return fmt.Errorf("multi-download type validation failed: %v", err)
 	}
 
+	// bucket-wide download: estimate and confirm
+	// (no prompting when given '--yes' or when stdin is not a terminal, e.g. in scripts)
+	if dlType == dload.TypeBackend && (flagIsSet(c, dryRunFlag) || (!flagIsSet(c, yesFlag) && isTerm(os.Stdin))) {
+		proceed, err := confirmBackendDownload(c, req)
+		if err != nil || !proceed {
+			return err
+		}
+	}
+
 	payload, err := prepareDownloadPayload(c, dlType, req)
 	if err != nil {
 		return err
@@ -745,7 +872,11 @@ func startDownloadHandler(c *cli.Context) error {
 		return err
 	}
 
-	fmt.Fprintf(c.App.Writer, "Started download job %s\n", id)
+	if flagIsSet(c, dloadStartAfterFlag) {
+		fmt.Fprintf(c.App.Writer, "Scheduled download job %s (to start after %s)\n", id, parseStrFlag(c, dloadStartAfterFlag))
+	} else {
+		fmt.Fprintf(c.App.Writer, "Started download job %s\n", id)
+	}
 
 	if flagIsSet(c, progressFlag) {
 		return pbDownload(c, id)
@@ -776,7 +907,7 @@ func downloadRefreshRate(c *cli.Context) time.Duration {
 func pbDownload(c *cli.Context, id string) (err error) {
 	refreshRate := downloadRefreshRate(c)
 	// Note: timeout=0 for progress monitoring (server-side download-timeout still applies)
-	downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate, 0).run()
+	downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate, 0, dloadPollRetries(c)).run()
 	if err != nil {
 		return err
 	}
@@ -798,13 +929,22 @@ func wtDownload(c *cli.Context, id string) error {
 		warn := fmt.Sprintf("%d of %d download jobs failed. %s", resp.ErrorCnt, resp.ScheduledCnt, msg)
 		actionWarn(c, warn)
 	} else {
-		actionDownloaded(c, resp.FinishedCnt)
+		actionDownloaded(c, &resp.Job)
 	}
 	return nil
 }
 
-func actionDownloaded(c *cli.Context, cnt int) {
-	var msg string
+func actionDownloaded(c *cli.Context, j *dload.Job) {
+	var (
+		msg string
+		cnt = j.FinishedCnt
+	)
+	if j.ExistingCnt > 0 {
+		n := j.DownloadedCnt()
+		msg = fmt.Sprintf("%d file%s downloaded, %d already present", n, cos.Plural(n), j.ExistingCnt)
+		actionDone(c, msg)
+		return
+	}
 	if cnt == 1 {
 		msg = "File successfully downloaded"
 	} else {
@@ -837,7 +977,7 @@ func bgDownload(c *cli.Context, id string) (err error) {
 		warn := fmt.Sprintf("%d of %d download jobs failed. %s", resp.ErrorCnt, resp.ScheduledCnt, msg)
 		actionWarn(c, warn)
 	case resp.FinishedTime.UnixNano() != 0:
-		actionDownloaded(c, resp.FinishedCnt)
+		actionDownloaded(c, &resp.Job)
 	default:
 		msg := toMonitorMsg(c, id, flprn(progressFlag)+"'")
 		actionDone(c, msg)
@@ -1245,7 +1385,7 @@ func waitDownloadHandler(c *cli.Context, id string) error {
 	refreshRate := downloadRefreshRate(c)
 	timeout := parseDurationFlag(c, dloadTimeoutFlag)
 	if flagIsSet(c, progressFlag) {
-		downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate, timeout).run()
+		downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate, timeout, dloadPollRetries(c)).run()
 		if err != nil {
 			return err
 		}
@@ -1361,6 +1501,82 @@ func removeDownloadHandler(c *cli.Context) error {
 	return nil
 }
 
+func retryDownloadHandler(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return missingArgumentsError(c, jobIDArgument)
+	}
+	id := c.Args().Get(0)
+	resp, err := api.DownloadStatus(apiBP, id, false /*only active*/)
+	if err != nil {
+		return V(err)
+	}
+	if !resp.JobFinished() {
+		return fmt.Errorf("download job %q is still running", id)
+	}
+	if resp.Request == nil {
+		return fmt.Errorf("download job %q: the original request is not available", id)
+	}
+
+	var (
+		objs = make(cos.StrKVs, len(resp.Errs))
+		skip int
+	)
+	for _, e := range resp.Errs {
+		if e.Link == "" {
+			skip++ // remote-bucket object or archive member
+			continue
+		}
+		objs[e.Name] = e.Link
+	}
+	if skip > 0 {
+		actionWarn(c, fmt.Sprintf("%d failed object%s cannot be retried individually (remote-bucket objects and archive members)",
+			skip, cos.Plural(skip)))
+	}
+	if len(objs) == 0 {
+		fmt.Fprintf(c.App.Writer, "Download job %q: nothing to retry\n", id)
+		return nil
+	}
+
+	// same request minus timing (relative to the original submission) and forced overwrites
+	body := dload.MultiBody{Base: *resp.Request, ObjectsPayload: objs}
+	body.Description = "retry " + id
+	if resp.Description != "" {
+		body.Description += " (" + resp.Description + ")"
+	}
+	body.Deadline, body.StartAfter, body.ForceOverwrite = "", "", nil
+
+	// credentials are not reported back (redacted) and so cannot be resubmitted
+	var dropped []string
+	if len(body.Headers) > 0 {
+		body.Headers = body.Headers.Clone()
+		for name := range body.Headers {
+			if dload.SensitiveHeader(name) {
+				delete(body.Headers, name)
+				dropped = append(dropped, name)
+			}
+		}
+	}
+	if len(dropped) > 0 {
+		sort.Strings(dropped)
+		actionWarn(c, fmt.Sprintf("not resubmitting redacted header%s %s", cos.Plural(len(dropped)), strings.Join(dropped, ", ")))
+	}
+
+	newID, err := api.DownloadWithParam(apiBP, dload.TypeMulti, body)
+	if err != nil {
+		return V(err)
+	}
+	fmt.Fprintf(c.App.Writer, "Requeued %d failed object%s of download job %s as job %s\n", len(objs), cos.Plural(len(objs)), id, newID)
+
+	switch {
+	case flagIsSet(c, progressFlag):
+		return pbDownload(c, newID)
+	case flagIsSet(c, waitFlag):
+		return wtDownload(c, newID)
+	default:
+		return bgDownload(c, newID)
+	}
+}
+
 func removeDownloadRegex(c *cli.Context, regex string) error {
 	dlList, err := api.DownloadGetList(apiBP, regex, false /*onlyActive*/)
 	if err != nil {
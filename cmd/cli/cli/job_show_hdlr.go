@@ -61,6 +61,8 @@ var showJobFlags = append(
 	// download and dsort only
 	progressFlag,
 	dsortLogFlag,
+	// download only
+	dloadPollRetriesFlag,
 )
 
 var showCmdJob = cli.Command{
@@ -428,7 +430,7 @@ func xlistByKindID(c *cli.Context, xargs *xact.ArgsMsg, caption bool, xs xact.Mu
 		jwfmin = [3]int{math.MaxInt, math.MaxInt, math.MaxInt}
 		jwfmax = [3]int{}
 
-		fromToBck, haveBck bool
+		fromToBck, haveBck, running bool
 	)
 	for tid, snaps := range filteredXs {
 		if len(snaps) == 0 {
@@ -470,6 +472,7 @@ func xlistByKindID(c *cli.Context, xargs *xact.ArgsMsg, caption bool, xs xact.Mu
 		}
 
 		dts = append(dts, nodeSnaps{DaemonID: tid, XactSnaps: snaps}) // <--- this gets ultimately displayed via static template
+		running = running || snaps[0].Running()
 
 		// totals
 		for _, xsnap := range snaps {
@@ -499,6 +502,11 @@ func xlistByKindID(c *cli.Context, xargs *xact.ArgsMsg, caption bool, xs xact.Mu
 			}
 		}
 		jobCptn(c, xname, xargs.ID, ctlmsg, xargs.OnlyRunning, xargs.DaemonID != "")
+		if running && len(filteredXs) > 1 {
+			if pct := xactPct(xargs); pct != "" {
+				actionCptn(c, "Progress:", pct)
+			}
+		}
 	}
 
 	// multiple target nodes: append totals as a single special `nodeSnap`
@@ -295,7 +295,7 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 				qflprn(diffFlag), bck.String())
 		}
 		if flagIsSet(c, listNotCachedFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(diffFlag), qflprn(listNotCachedFlag))
+			return errExclusive(qflprn(diffFlag), qflprn(listNotCachedFlag))
 		}
 		msg.SetFlag(apc.LsDiff)
 	}
@@ -306,7 +306,7 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 			briefPause(1)
 		}
 		if flagIsSet(c, listNotCachedFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(listCachedFlag), qflprn(listNotCachedFlag))
+			return errExclusive(qflprn(listCachedFlag), qflprn(listNotCachedFlag))
 		}
 		msg.SetFlag(apc.LsCached)
 		// addCachedCol: correction #1
@@ -364,7 +364,7 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	switch {
 	case flagIsSet(c, nameOnlyFlag):
 		if flagIsSet(c, diffFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(diffFlag), qflprn(nameOnlyFlag))
+			return errExclusive(qflprn(diffFlag), qflprn(nameOnlyFlag))
 		}
 		if len(props) > 2 {
 			warn := fmt.Sprintf("flag %s is incompatible with the value of %s", qflprn(nameOnlyFlag), qflprn(objPropsFlag))
@@ -633,7 +633,7 @@ func printLso(c *cli.Context, entries cmn.LsoEntries, lstFilter *lstFilter, prop
 	//   - https://github.com/NVIDIA/aistore/blob/main/docs/howto_virt_dirs.md
 
 	tmpl := teb.LsoTemplate(propsList, hideHeader, addCachedCol, addStatusCol)
-	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, false /*incl. calendar date*/)}
+	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, false /*incl. calendar date*/), Fit: true}
 	if err := teb.Print(matched, tmpl, opts); err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -22,10 +23,17 @@ import (
 	"github.com/urfave/cli"
 )
 
-const dryRunExamplesCnt = 10
+const (
+	dryRunExamplesCnt = 10
+
+	// rm and evict: when the number of selected objects exceeds this threshold
+	// ask for confirmation (or, to proceed non-interactively, require '--yes')
+	lrConfirmThreshold = 1000
+)
 
 type lrCtx struct {
 	listObjs, tmplObjs string
+	regex              string // rm and evict only (see verbObjRegexFlag)
 	bck                cmn.Bck
 }
 
@@ -126,7 +134,7 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 
 	// done
 	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
-		if flagIsSet(c, nonverboseFlag) {
+		if flagIsSet(c, nonverboseFlag) || isQuiet(c) {
 			fmt.Fprintln(c.App.Writer, xid)
 		} else {
 			actionDone(c, tcbtcoCptn(text, bckFrom, bckTo)+". "+toMonitorMsg(c, xid, ""))
@@ -137,7 +145,10 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	// or wait
 	var timeout time.Duration
 
-	fmt.Fprint(c.App.Writer, tcbtcoCptn(text, bckFrom, bckTo)+" ...")
+	quiet := isQuiet(c)
+	if !quiet {
+		fmt.Fprint(c.App.Writer, tcbtcoCptn(text, bckFrom, bckTo)+" ...")
+	}
 
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
@@ -145,7 +156,7 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	xargs := xact.ArgsMsg{ID: xid, Kind: xkind, Timeout: timeout}
 	if err = waitXact(&xargs); err != nil {
 		fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, text, bckFrom.String(), bckTo.String())
-	} else {
+	} else if !quiet {
 		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
 	}
 	return err
@@ -208,12 +219,20 @@ func _evictOne(c *cli.Context, shift int) error {
 		return err
 	}
 
-	// Convert objName to list when there's no list or template (same pattern as prefetch)
-	if oltp.list == "" && oltp.tmpl == "" {
-		oltp.list = oltp.objName
+	regex := parseStrFlag(c, verbObjRegexFlag)
+	switch {
+	case regex != "" && oltp.list != "":
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(verbObjRegexFlag), qflprn(listFlag))
+	case oltp.list == "" && oltp.tmpl == "":
+		if regex != "" {
+			oltp.tmpl = oltp.objName // (with regex, always a prefix)
+		} else {
+			// Convert objName to list when there's no list or template (same pattern as prefetch)
+			oltp.list = oltp.objName
+		}
 	}
 
-	lrCtx := &lrCtx{oltp.list, oltp.tmpl, bck}
+	lrCtx := &lrCtx{listObjs: oltp.list, tmplObjs: oltp.tmpl, regex: regex, bck: bck}
 	return lrCtx.do(c)
 }
 
@@ -234,7 +253,7 @@ func evictBucket(c *cli.Context, bck cmn.Bck) error {
 	if keepMD {
 		msg += " (metadata preserved)"
 	}
-	fmt.Fprintln(c.App.Writer, msg)
+	actionDone(c, msg)
 	return nil
 }
 
@@ -315,9 +334,25 @@ func _rmOne(c *cli.Context, shift int) error {
 		return err
 	}
 
+	regex := parseStrFlag(c, verbObjRegexFlag)
+	if regex != "" {
+		if oltp.list != "" {
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(verbObjRegexFlag), qflprn(listFlag))
+		}
+		if oltp.tmpl == "" {
+			oltp.tmpl = oltp.objName // (with regex, always a prefix)
+		}
+		lrCtx := &lrCtx{tmplObjs: oltp.tmpl, regex: regex, bck: bck}
+		return lrCtx.do(c)
+	}
+	if flagIsSet(c, dryRunFlag) && oltp.list == "" && oltp.tmpl == "" {
+		return incorrectUsageMsg(c, "option %s requires one of: %s, %s, %s, or %s", qflprn(dryRunFlag),
+			qflprn(listFlag), qflprn(templateFlag), qflprn(verbObjPrefixFlag), qflprn(verbObjRegexFlag))
+	}
+
 	switch {
 	case oltp.list != "" || oltp.tmpl != "": // 1. multi-obj
-		lrCtx := &lrCtx{oltp.list, oltp.tmpl, bck}
+		lrCtx := &lrCtx{listObjs: oltp.list, tmplObjs: oltp.tmpl, bck: bck}
 		return lrCtx.do(c)
 	case oltp.objName == "": // 2. all objects
 		if flagIsSet(c, rmrfFlag) {
@@ -340,7 +375,7 @@ func _rmOne(c *cli.Context, shift int) error {
 			return cos.NewErrNotFound(nil, bck.Cname(oltp.objName))
 		}
 		if err == nil {
-			if !flagIsSet(c, nonverboseFlag) {
+			if !flagIsSet(c, nonverboseFlag) && !isQuiet(c) {
 				fmt.Fprintf(c.App.Writer, "deleted %q from %s\n", oltp.objName, bck.Cname(""))
 			}
 			return nil
@@ -407,7 +442,7 @@ func _prefetchOne(c *cli.Context, shift int) error {
 	if oltp.list == "" && oltp.tmpl == "" {
 		oltp.list = oltp.objName // ("prefetch" is not one of those primitive verbs)
 	}
-	lrCtx := &lrCtx{oltp.list, oltp.tmpl, bck}
+	lrCtx := &lrCtx{listObjs: oltp.list, tmplObjs: oltp.tmpl, bck: bck}
 	return lrCtx.do(c)
 }
 
@@ -437,24 +472,43 @@ func (lr *lrCtx) do(c *cli.Context) error {
 		}
 	}
 
-	// 2. [DRY-RUN]
+	// 2. rm and evict: count (and, with regex, select) objects prior to execution
+	if verb := lrVerb(c); verb == commandRemove || verb == commandEvict {
+		if lr.regex != "" {
+			names, err := lr.selectRegex(c, verb, &pt)
+			if err != nil {
+				return err
+			}
+			if len(names) == 0 {
+				actionDone(c, fmt.Sprintf("No objects in %s matching %s=%q", lr.bck.Cname(lr.tmplObjs),
+					qflprn(verbObjRegexFlag), lr.regex))
+				return nil
+			}
+			fileList, lr.tmplObjs = names, "" // send the list, not the prefix
+		}
+		if proceed, err := lr.preview(c, verb, fileList, &pt, emptyTemplate); err != nil || !proceed {
+			return err
+		}
+	}
+
+	// 3. [DRY-RUN]
 	if flagIsSet(c, dryRunFlag) {
 		lr.dry(c, fileList, &pt)
 		return nil
 	}
 
-	// 3. do
+	// 4. do
 	xid, kind, action, errV := lr._do(c, fileList)
 	if errV != nil {
 		return V(errV)
 	}
 
-	// 4. format
+	// 5. format
 	var (
 		xname, text string
 		num         int64
 	)
-	if lr.listObjs != "" {
+	if fileList != nil {
 		num = int64(len(fileList))
 		s := fmt.Sprintf("%v", fileList)
 		if num > 4 {
@@ -467,18 +521,18 @@ func (lr *lrCtx) do(c *cli.Context) error {
 			num = pt.Count()
 		}
 		_, xname = xact.GetKindName(kind)
-		if emptyTemplate {
+		if emptyTemplate && lr.regex == "" {
 			text = fmt.Sprintf("%s: %s entire bucket %s", xact.Cname(xname, xid), action, lr.bck.Cname(""))
 		} else {
 			text = fmt.Sprintf("%s: %s %q from %s", xact.Cname(xname, xid), action, lr.tmplObjs, lr.bck.Cname(""))
 		}
 	}
 
-	// 5. progress
+	// 6. progress: '--progress' or, when waiting for prefetch or evict, live progress by default
+	// (objects processed out of `num`, if known)
 	showProgress := flagIsSet(c, progressFlag)
-	if showProgress && num == 0 {
-		_warnProgress(c)
-		showProgress = false
+	if !showProgress && (flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag)) {
+		showProgress = (kind == apc.ActPrefetchObjects || kind == apc.ActEvictObjects) && !isQuiet(c) && isTerm(c.App.Writer)
 	}
 	if showProgress {
 		var cpr = cprCtx{
@@ -491,12 +545,12 @@ func (lr *lrCtx) do(c *cli.Context) error {
 		return cpr.multiobj(c, text)
 	}
 
-	// 6. otherwise, wait or exit
+	// 7. otherwise, wait or exit
 	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
 		if xid != "" {
 			text += ". " + toMonitorMsg(c, xid, "")
 		}
-		fmt.Fprintln(c.App.Writer, text)
+		actionDone(c, text)
 		return nil
 	}
 
@@ -505,20 +559,110 @@ func (lr *lrCtx) do(c *cli.Context) error {
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
-	fmt.Fprintln(c.App.Writer, text+" ...")
+	quiet := isQuiet(c)
+	if !quiet {
+		fmt.Fprintln(c.App.Writer, text+" ...")
+	}
 	xargs := xact.ArgsMsg{ID: xid, Kind: xname, Timeout: timeout}
 	if err := waitXact(&xargs); err != nil {
 		return err
 	}
-	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	if !quiet {
+		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	}
 	return nil
 }
 
+// list (prefix) and select objects with names matching the regex
+func (lr *lrCtx) selectRegex(c *cli.Context, verb string, pt *cos.ParsedTemplate) ([]string, error) {
+	re, err := regexp.Compile(lr.regex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s=%q: %v", qflprn(verbObjRegexFlag), lr.regex, err)
+	}
+	if len(pt.Ranges) > 0 {
+		return nil, incorrectUsageMsg(c, "option %s cannot be used with range templates (%q)",
+			qflprn(verbObjRegexFlag), lr.tmplObjs)
+	}
+	lst, err := lr.ls(c, verb)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(lst.Entries))
+	for _, en := range lst.Entries {
+		if !en.IsAnyFlagSet(apc.EntryIsDir) && re.MatchString(en.Name) {
+			names = append(names, en.Name)
+		}
+	}
+	return names, nil
+}
+
+// names only (prefix)
+func (lr *lrCtx) ls(c *cli.Context, verb string) (*cmn.LsoRes, error) {
+	msg := &apc.LsoMsg{Prefix: lr.tmplObjs}
+	msg.SetFlag(apc.LsNameOnly)
+	if verb == commandEvict {
+		msg.SetFlag(apc.LsCached) // (only in-cluster objects can be evicted)
+	}
+	if flagIsSet(c, nonRecursFlag) {
+		msg.SetFlag(apc.LsNoRecursion)
+	}
+	lst, err := api.ListObjects(apiBP, lr.bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil, V(err)
+	}
+	return lst, nil
+}
+
+// show the number of selected objects; when the number exceeds lrConfirmThreshold,
+// require confirmation unless '--yes' (or '--dry-run')
+func (lr *lrCtx) preview(c *cli.Context, verb string, fileList []string, pt *cos.ParsedTemplate,
+	emptyTemplate bool) (bool, error) {
+	var (
+		cnt  int64
+		what string
+	)
+	switch {
+	case lr.regex != "":
+		cnt = int64(len(fileList))
+		what = fmt.Sprintf("matching %s=%q", qflprn(verbObjRegexFlag), lr.regex)
+	case fileList != nil:
+		return true, nil // explicitly listed
+	case emptyTemplate:
+		return true, nil // entire bucket (see evictBucket and rmRfAllObjects)
+	case len(pt.Ranges) > 0:
+		cnt = pt.Count()
+		what = fmt.Sprintf("matching template %q", lr.tmplObjs)
+	default:
+		if flagIsSet(c, yesFlag) && !flagIsSet(c, dryRunFlag) {
+			return true, nil // not listing (prefix) only to count
+		}
+		lst, err := lr.ls(c, verb)
+		if err != nil {
+			return false, err
+		}
+		cnt = int64(len(lst.Entries))
+		what = fmt.Sprintf("with prefix %q", lr.tmplObjs)
+	}
+
+	text := fmt.Sprintf("%s: %d object%s in %s %s", verb, cnt, cos.Plural(int(cnt)), lr.bck.Cname(""), what)
+	actionDone(c, text)
+	if cnt <= lrConfirmThreshold || flagIsSet(c, yesFlag) || flagIsSet(c, dryRunFlag) {
+		return true, nil
+	}
+	warn := fmt.Sprintf("the number of selected objects exceeds %d (use %s to skip this confirmation)",
+		lrConfirmThreshold, qflprn(yesFlag))
+	return confirm(c, "Proceed?", warn), nil
+}
+
 // [DRY-RUN]
 func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate) {
 	if len(fileList) > 0 {
+		maxLines := dryRunExamplesCnt
+		if lr.regex != "" && flagIsSet(c, verboseFlag) {
+			maxLines = -1 // all matching names
+		}
 		limitedLineWriter(c.App.Writer,
-			dryRunExamplesCnt, strings.ToUpper(c.Command.Name)+" "+lr.bck.Cname("")+"/%s\n", fileList)
+			maxLines, strings.ToUpper(c.Command.Name)+" "+lr.bck.Cname("")+"/%s\n", fileList)
 		return
 	}
 	objs := pt.ToSlice(dryRunExamplesCnt)
@@ -529,12 +673,15 @@ func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate)
 	}
 }
 
-func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action string, err error) {
-	verb := c.Command.Name
+func lrVerb(c *cli.Context) string {
 	if isAlias(c) {
-		verb = lastAliasedWord(c)
+		return lastAliasedWord(c)
 	}
+	return c.Command.Name
+}
 
+func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action string, err error) {
+	verb := lrVerb(c)
 	switch verb {
 	case commandRemove:
 		msg := &apc.EvdMsg{
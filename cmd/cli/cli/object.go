@@ -6,7 +6,10 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -116,6 +119,53 @@ func verbList(c *cli.Context, wop wop, fnames []string, bck cmn.Bck, appendPref
 	return verbFobjs(c, wop, allFobjs, bck, ndir, recurs)
 }
 
+// read (local-path, object-name) pairs and upload them
+func verbFilesCSV(c *cli.Context, wop wop, csvPath string, bck cmn.Bck, appendPref string) error {
+	fh, err := os.Open(csvPath)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	var (
+		fobjs = make([]fobj, 0, 64)
+		r     = csv.NewReader(fh)
+	)
+	r.Comment = '#'
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", csvPath, err)
+		}
+		var (
+			fname   = strings.TrimSpace(rec[0])
+			objName = strings.TrimSpace(rec[1])
+		)
+		if fname == "" || objName == "" {
+			line, _ := r.FieldPos(0)
+			return fmt.Errorf("%s, line %d: expecting 'local-path,object-name', got %q", csvPath, line, strings.Join(rec, ","))
+		}
+		path, err := absPath(fname)
+		if err != nil {
+			return err
+		}
+		finfo, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if finfo.IsDir() {
+			return fmt.Errorf("%s: %q is a directory (expecting regular files only)", csvPath, fname)
+		}
+		fobjs = append(fobjs, fobj{path: path, dstName: appendPref + objName, size: finfo.Size()})
+	}
+	return verbFobjs(c, wop, fobjs, bck, 0 /*ndir*/, false /*recurs*/)
+}
+
 func verbRange(c *cli.Context, wop wop, pt *cos.ParsedTemplate, bck cmn.Bck, trimPref, appendPref string, incl bool) (err error) {
 	var (
 		ndir     int
@@ -221,7 +271,7 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		actionWarn(c, errU.Error())
 		units = ""
 	}
-	fmt.Fprintf(c.App.Writer, "\nCreated %s (size %s)\n", name, teb.FmtSize(totalSize, units, 2))
+	actionDone(c, fmt.Sprintf("\nCreated %s (size %s)", name, teb.FmtSize(totalSize, units, 2)))
 	return nil
 }
 
@@ -400,7 +450,7 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 	}
 	l := len(lst.Entries)
 	if l == 0 {
-		fmt.Fprintln(c.App.Writer, bck.Cname(""), "is empty, nothing to do.")
+		actionDone(c, bck.Cname("")+" is empty, nothing to do.")
 		return nil
 	}
 
@@ -412,6 +462,7 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 		period   int64 = 1000
 		wg             = cos.NewLimitedWaitGroup(sys.NumCPU(), l)
 		vrbs           = flagIsSet(c, verboseFlag)
+		quiet          = isQuiet(c)
 	)
 	if bck.IsCloud() {
 		period = 100
@@ -429,7 +480,7 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 				n := ratomic.AddInt64(&cnt64, 1)
 				if vrbs {
 					fmt.Fprintf(c.App.Writer, "deleted %s\n", bck.Cname(objName))
-				} else if n > 1 && n%period == 0 {
+				} else if n > 1 && n%period == 0 && !quiet {
 					fmt.Fprintf(c.App.Writer, "\r%s", cos.FormatBigI64(n))
 					ratomic.AddInt64(&progress, 1)
 				}
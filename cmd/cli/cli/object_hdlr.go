@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api"
@@ -80,6 +81,8 @@ var (
 		commandRemove: append(
 			listRangeProgressWaitFlags,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
+			verbObjRegexFlag,
+			dryRunFlag,
 			rmrfFlag,
 			verboseFlag, // rm -rf
 			nonverboseFlag,
@@ -88,10 +91,15 @@ var (
 			dontHeadRemoteFlag,
 		),
 		commandRename: {},
+		commandLocate: {
+			jsonFlag,
+		},
 		commandGet: {
 			offsetFlag,
 			lengthFlag,
 			cksumFlag,
+			ifMatchFlag,
+			ifNoneMatchFlag,
 			yesFlag,
 			headObjPresentFlag,
 			latestVerFlag,
@@ -101,6 +109,9 @@ var (
 			blobDownloadFlag,
 			chunkSizeFlag,
 			numBlobWorkersFlag,
+			// concurrent range reads, client side
+			getParallelFlag,
+			parallelMinSizeFlag,
 			// archive
 			archpathGetFlag,
 			archmimeFlag,
@@ -108,6 +119,7 @@ var (
 			archmodeFlag,
 			// archive, client side
 			extractFlag,
+			decompressFlag,
 			// bucket inventory
 			useInventoryFlag,
 			invNameFlag,
@@ -129,6 +141,8 @@ var (
 			listRangeProgressWaitFlags,
 			chunkSizeFlag,
 			numPutWorkersFlag,
+			putFilesFlag,
+			stopOnErrorFlag,
 			dryRunFlag,
 			recursFlag,
 			putSrcDirNameFlag,
@@ -165,6 +179,7 @@ var (
 		commandCat: {
 			offsetFlag,
 			lengthFlag,
+			lastBytesFlag,
 			archpathGetFlag,
 			cksumFlag,
 			forceFlag,
@@ -263,6 +278,16 @@ var (
 				Action:       catHandler,
 				BashComplete: bucketCompletions(bcmplop{separator: true}),
 			},
+			{
+				Name: commandLocate,
+				Usage: "Show cluster placement of a given object: all targets sorted by their respective HRW scores,\n" +
+					indent1 + "the HRW-selected owner, and the targets that actually store the object\n" +
+					indent1 + "(when the two differ, rebalance is likely pending or in progress)",
+				ArgsUsage:    objectArgument,
+				Flags:        sortFlags(objectCmdsFlags[commandLocate]),
+				Action:       locateObjHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 		},
 	}
 )
@@ -310,7 +335,7 @@ func mvObjectHandler(c *cli.Context) (err error) {
 		return err
 	}
 
-	fmt.Fprintf(c.App.Writer, "%q moved to %q\n", oldObj, newObj)
+	actionDone(c, fmt.Sprintf("%q moved to %q", oldObj, newObj))
 	return nil
 }
 
@@ -345,6 +370,9 @@ func putHandler(c *cli.Context) error {
 	// 2. multi-file list & range
 	incl := flagIsSet(c, putSrcDirNameFlag)
 	switch {
+	case a.src.files != "":
+		// (local-path, object-name) pairs from '--files'
+		return verbFilesCSV(c, &a, a.src.files, a.dst.bck, a.dst.oname /*virt subdir*/)
 	case len(a.src.fdnames) > 0:
 		if len(a.src.fdnames) > 1 {
 			if ok := warnMultiSrcDstPrefix(c, &a, fmt.Sprintf("from [%s ...]", a.src.fdnames[0])); !ok {
@@ -512,3 +540,62 @@ func setCustomPropsHandler(c *cli.Context) (err error) {
 	}
 	return setCustomProps(c, bck, objName)
 }
+
+func locateObjHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	uri := c.Args().Get(0)
+	bck, objName, err := parseBckObjURI(c, uri, false)
+	if err != nil {
+		return err
+	}
+	loc, err := api.LocateObject(apiBP, bck, objName)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(loc, "", teb.Jopts(true))
+	}
+
+	type row struct {
+		ID, Score, Owner, Present, Location string
+	}
+	var (
+		rows  = make([]row, 0, len(loc.Targets))
+		owner string
+		found []string
+	)
+	for i := range loc.Targets {
+		t := &loc.Targets[i]
+		r := row{ID: t.ID, Score: strconv.FormatUint(t.Score, 10), Owner: "-", Present: "no", Location: "-"}
+		if t.Maint {
+			r.ID += " (maintenance)"
+		}
+		if t.Owner {
+			r.Owner = "yes"
+			owner = t.ID
+		}
+		if t.Present {
+			r.Present = "yes"
+			found = append(found, t.ID)
+			if t.Location != "" {
+				r.Location = t.Location
+			}
+		}
+		rows = append(rows, r)
+	}
+	if err := teb.Print(rows, teb.ObjLocateTmpl); err != nil {
+		return err
+	}
+
+	cname := bck.Cname(objName)
+	switch {
+	case len(found) == 0:
+		actionWarn(c, cname+" is not present in the cluster (HRW owner: "+owner+")")
+	case !cos.StringInSlice(owner, found):
+		actionWarn(c, cname+" is stored on "+strings.Join(found, ", ")+" rather than its HRW owner "+owner+
+			" - rebalance pending or in progress?")
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles batch object stat (`ais show object BUCKET --list|--template|--prefix`).
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+)
+
+// sort keys (see `objStatSortFlag`)
+const (
+	statSortName  = "name"
+	statSortSize  = "size"
+	statSortAtime = "atime"
+	statSortCksum = "checksum"
+)
+
+type (
+	// JSON output
+	objStat struct {
+		Name  string `json:"name"`
+		Cksum string `json:"checksum,omitempty"`
+		Err   string `json:"error,omitempty"`
+		Size  int64  `json:"size,string"`
+		Atime int64  `json:"atime,string,omitempty"`
+		Found bool   `json:"found"`
+	}
+	// table output (see teb.ObjStatTmpl)
+	objStatRow struct {
+		Name   string
+		Size   string
+		Atime  string
+		Cksum  string
+		Status string
+	}
+)
+
+func isBatchStat(c *cli.Context) bool {
+	return flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) || flagIsSet(c, verbObjPrefixFlag)
+}
+
+func batchStatHandler(c *cli.Context, bck cmn.Bck, objName string) error {
+	if objName != "" {
+		return incorrectUsageMsg(c, "expecting bucket name (not %q) with %s, %s, or %s", bck.Cname(objName),
+			qflprn(listFlag), qflprn(templateFlag), qflprn(verbObjPrefixFlag))
+	}
+	var (
+		sortBy = parseStrFlag(c, objStatSortFlag)
+		desc   = strings.HasPrefix(sortBy, "-")
+	)
+	sortBy = strings.TrimPrefix(sortBy, "-")
+	switch sortBy {
+	case "", statSortName, statSortSize, statSortAtime, statSortCksum:
+	default:
+		return fmt.Errorf("invalid %s=%q (expecting one of: %s, %s, %s, %s)", flprn(objStatSortFlag), sortBy,
+			statSortName, statSortSize, statSortAtime, statSortCksum)
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+
+	names, err := batchStatNames(c, bck)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Fprintf(c.App.Writer, "No objects in %s matching the specified names\n", bck.Cname(""))
+		return nil
+	}
+
+	// HEAD concurrently
+	var (
+		stats      = make([]objStat, len(names))
+		conc       = max(parseIntFlag(c, objStatConcFlag), 1)
+		sema       = make(chan struct{}, conc)
+		group, ctx = errgroup.WithContext(context.Background())
+		hargs      = api.HeadArgs{FltPresence: apc.FltPresentCluster, Silent: true}
+	)
+	if flagIsSet(c, objNotCachedPropsFlag) {
+		hargs.FltPresence = apc.FltExists
+	}
+loop:
+	for i, name := range names {
+		select {
+		case sema <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		group.Go(func() error {
+			defer func() { <-sema }()
+			st := &stats[i]
+			st.Name = name
+			props, err := api.HeadObject(apiBP, bck, name, hargs)
+			switch {
+			case err == nil:
+				st.Found = true
+				st.Size, st.Atime = props.Size, props.Atime
+				if ck := props.Cksum; ck != nil && ck.Type() != cos.ChecksumNone {
+					st.Cksum = ck.Type() + "[" + ck.Value() + "]"
+				}
+			case cmn.IsStatusNotFound(err):
+				st.Err = "not found"
+			default:
+				// (reported as a row rather than failing the entire batch)
+				st.Err = V(err).Error()
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	sortObjStats(stats, sortBy, desc)
+
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(stats, "", teb.Jopts(true))
+	}
+	rows := make([]objStatRow, len(stats))
+	for i := range stats {
+		st := &stats[i]
+		row := &rows[i]
+		row.Name = st.Name
+		if !st.Found {
+			row.Size, row.Atime, row.Cksum = teb.NotSetVal, teb.NotSetVal, teb.NotSetVal
+			row.Status = fred(st.Err)
+			continue
+		}
+		row.Size = teb.FmtSize(st.Size, units, 2)
+		row.Atime = teb.NotSetVal
+		if st.Atime != 0 {
+			row.Atime = cos.FormatNanoTime(st.Atime, "")
+		}
+		row.Cksum = teb.NotSetVal
+		if st.Cksum != "" {
+			row.Cksum = st.Cksum
+		}
+		row.Status = "ok"
+	}
+	opts := teb.Opts{Fit: true}
+	if flagIsSet(c, noHeaderFlag) {
+		return teb.Print(rows, teb.ObjStatTmplNoHdr, opts)
+	}
+	return teb.Print(rows, teb.ObjStatTmpl, opts)
+}
+
+// names from --list, --template, or --prefix (the latter via list-objects)
+func batchStatNames(c *cli.Context, bck cmn.Bck) ([]string, error) {
+	limit := parseIntFlag(c, objLimitFlag)
+	switch {
+	case flagIsSet(c, listFlag):
+		if flagIsSet(c, templateFlag) || flagIsSet(c, verbObjPrefixFlag) {
+			return nil, errExclusive(qflprn(listFlag), qflprn(templateFlag)+" and "+qflprn(verbObjPrefixFlag))
+		}
+		names := splitCsv(parseStrFlag(c, listFlag))
+		if limit > 0 && len(names) > limit {
+			names = names[:limit]
+		}
+		return names, nil
+	case flagIsSet(c, templateFlag):
+		if flagIsSet(c, verbObjPrefixFlag) {
+			return nil, errExclusive(qflprn(templateFlag), qflprn(verbObjPrefixFlag))
+		}
+		pt, err := cos.NewParsedTemplate(parseStrFlag(c, templateFlag))
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 {
+			return pt.ToSlice(limit), nil
+		}
+		return pt.ToSlice(), nil
+	default:
+		msg := &apc.LsoMsg{Prefix: parseStrFlag(c, verbObjPrefixFlag), Props: apc.GetPropsName}
+		msg.SetFlag(apc.LsNameOnly)
+		if !flagIsSet(c, objNotCachedPropsFlag) {
+			msg.SetFlag(apc.LsCached)
+		}
+		lst, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{Limit: int64(limit)})
+		if err != nil {
+			return nil, V(err)
+		}
+		names := make([]string, 0, len(lst.Entries))
+		for _, en := range lst.Entries {
+			if !en.IsAnyFlagSet(apc.EntryIsDir) {
+				names = append(names, en.Name)
+			}
+		}
+		return names, nil
+	}
+}
+
+// not-found (and failed) objects always go last
+func sortObjStats(stats []objStat, sortBy string, desc bool) {
+	less := func(a, b *objStat) bool {
+		switch sortBy {
+		case statSortSize:
+			if a.Size != b.Size {
+				return a.Size < b.Size
+			}
+		case statSortAtime:
+			if a.Atime != b.Atime {
+				return a.Atime < b.Atime
+			}
+		case statSortCksum:
+			if a.Cksum != b.Cksum {
+				return a.Cksum < b.Cksum
+			}
+		}
+		return a.Name < b.Name
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		a, b := &stats[i], &stats[j]
+		if a.Found != b.Found {
+			return a.Found
+		}
+		if desc {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
@@ -0,0 +1,372 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements interactive mode (`ais repl`).
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+
+	"github.com/urfave/cli"
+	"golang.org/x/term"
+)
+
+// Interactive mode: a single process, loaded config and initialized clients (see `Init`)
+// executing one command line at a time via the same command tree (`acli.app`).
+// - history: up/down arrows (in-session)
+// - completion: <TAB> completes commands, subcommands, flags, and (cluster-listed) buckets and objects
+// - Ctrl-C: cancels the current command (a second Ctrl-C abandons it if it doesn't stop) or clears the line
+// - Ctrl-D or 'exit': ends the session
+
+const (
+	replPrompt     = cliName + "> "
+	replCmplMaxObj = 128 // max number of listed objects to complete from
+)
+
+const replUsage = "Interactive mode: run multiple commands in one session, with command history and <TAB> completion\n" +
+	indent1 + "(of commands, flags, buckets, and objects);\n" +
+	indent1 + "Ctrl-C cancels the current command (or clears the line), Ctrl-D or 'exit' ends the session"
+
+var replCmd = cli.Command{
+	Name:  commandREPL,
+	Usage: replUsage,
+	Action: func(c *cli.Context) error {
+		return incorrectUsageMsg(c, "interactive mode takes no arguments and cannot be nested")
+	},
+}
+
+// set once upon entering interactive mode
+var replMode bool
+
+type (
+	// input filter: Ctrl-C at the prompt clears the line (erase + enter) rather than ending the session
+	replIn struct {
+		r   io.Reader
+		buf []byte
+	}
+	// output of the running command; closed (and muted) upon Ctrl-C
+	replGate struct {
+		w      io.Writer
+		closed ratomic.Bool
+	}
+)
+
+// interface guard
+var (
+	_ io.Reader = (*replIn)(nil)
+	_ io.Writer = (*replGate)(nil)
+)
+
+func IsREPL(args []string) bool { return len(args) == 2 && args[1] == commandREPL }
+
+func (a *acli) repl(arg0 string) error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return errors.New("interactive mode requires a terminal")
+	}
+	replMode = true
+
+	rw := struct {
+		io.Reader
+		io.Writer
+	}{&replIn{r: os.Stdin}, os.Stdout}
+	t := term.NewTerminal(rw, replPrompt)
+	t.AutoCompleteCallback = a.replComplete
+
+	fmt.Fprintln(a.outWriter, "Interactive mode ('exit' or Ctrl-D to exit, <TAB> to complete)")
+	for {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		line, err := t.ReadLine()
+		term.Restore(fd, state)
+		if err != nil {
+			if err == io.EOF {
+				fmt.Fprintln(a.outWriter)
+				return nil
+			}
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		switch line {
+		case "":
+			continue
+		case "exit", "quit":
+			return nil
+		}
+		args, err := splitCmdline(line)
+		if err != nil {
+			fmt.Fprintln(a.errWriter, err)
+			continue
+		}
+		a.replRun(append([]string{arg0}, args...))
+	}
+}
+
+// run one command line, possibly long-running (compare with `Run`)
+func (a *acli) replRun(args []string) {
+	var (
+		outGate = &replGate{w: a.outWriter}
+		errGate = &replGate{w: a.errWriter}
+		lr      = &longRun{}
+		done    = make(chan error, 1)
+		sigCh   = make(chan os.Signal, 1)
+	)
+	a.longRun = lr
+	a.app.Metadata[metadata] = lr
+	a.app.Writer, a.app.ErrWriter = outGate, errGate
+	teb.Init(outGate, noColor)
+
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+
+	go func() {
+		err := a.runOnce(args)
+		if err == nil && lr.isSet() {
+			lr.iters = 1
+			if lr.isForever() {
+				err = a.runForever(args)
+			} else {
+				err = a.runN(args)
+			}
+		}
+		if lr.outFile != nil {
+			lr.outFile.Close()
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil && err.Error() != "" {
+			fmt.Fprintln(a.errWriter, err)
+		}
+		return
+	case <-sigCh:
+	}
+
+	// cancel: mute the command and stop long-running iterations
+	lr.stopped.Store(true)
+	outGate.closed.Store(true)
+	errGate.closed.Store(true)
+	fmt.Fprintln(a.errWriter, "^C (canceling; press Ctrl-C again to abandon)")
+	select {
+	case <-done:
+	case <-sigCh:
+		// NOTE: the abandoned command keeps running (muted) until it returns on its own
+		fmt.Fprintln(a.errWriter, "^C (abandoned)")
+	}
+}
+
+////////////
+// replIn //
+////////////
+
+func (in *replIn) Read(p []byte) (int, error) {
+	if len(in.buf) == 0 {
+		n, err := in.r.Read(p)
+		if n == 0 {
+			return 0, err
+		}
+		for i := range n {
+			if p[i] == 0x03 { // Ctrl-C => Ctrl-U (erase line) + Enter
+				in.buf = append(in.buf, 0x15, '\r')
+			} else {
+				in.buf = append(in.buf, p[i])
+			}
+		}
+	}
+	n := copy(p, in.buf)
+	in.buf = in.buf[n:]
+	return n, nil
+}
+
+//////////////
+// replGate //
+//////////////
+
+func (g *replGate) Write(p []byte) (int, error) {
+	if g.closed.Load() {
+		return len(p), nil
+	}
+	return g.w.Write(p)
+}
+
+//
+// completion
+//
+
+func (a *acli) replComplete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	var (
+		head, tail = line[:pos], line[pos:]
+		fields     = strings.Fields(head)
+		word       string
+	)
+	if len(fields) > 0 && !strings.HasSuffix(head, " ") {
+		word = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+
+	var cands []string
+	for _, cand := range a.replCandidates(fields, word) {
+		if strings.HasPrefix(cand, word) {
+			cands = append(cands, cand)
+		}
+	}
+	if len(cands) == 0 {
+		return "", 0, false
+	}
+	compl := cands[0]
+	for _, cand := range cands[1:] {
+		for !strings.HasPrefix(cand, compl) {
+			compl = compl[:len(compl)-1]
+		}
+	}
+	if len(cands) == 1 && !strings.HasSuffix(compl, "/") {
+		compl += " "
+	}
+	if len(compl) <= len(word) {
+		return "", 0, false
+	}
+	head = head[:len(head)-len(word)] + compl
+	return head + tail, len(head), true
+}
+
+func (a *acli) replCandidates(fields []string, word string) []string {
+	// resolve (sub)command, skipping flags and arguments
+	var (
+		cmds  = a.app.Commands
+		cmd   *cli.Command
+		cands []string
+	)
+	for _, f := range fields {
+		for i := range cmds {
+			if cmds[i].HasName(f) {
+				cmd = &cmds[i]
+				cmds = cmd.Subcommands
+				break
+			}
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(word, "-"):
+		if cmd == nil {
+			return nil
+		}
+		for _, flag := range cmd.Flags {
+			for _, name := range splitCsv(flag.GetName()) {
+				if len(name) == 1 {
+					cands = append(cands, "-"+name)
+				} else {
+					cands = append(cands, "--"+name)
+				}
+			}
+		}
+	case strings.Contains(word, apc.BckProviderSeparator):
+		cands = replBckObjCandidates(word)
+	default:
+		for i := range cmds {
+			if !cmds[i].Hidden {
+				cands = append(cands, cmds[i].Name)
+			}
+		}
+	}
+	sort.Strings(cands)
+	return cands
+}
+
+// buckets (e.g., "ais://") or objects (e.g., "ais://nnn/dir/")
+func replBckObjCandidates(word string) (cands []string) {
+	bck, objName, err := cmn.ParseBckObjectURI(word, cmn.ParseURIOpts{IsQuery: true})
+	if err != nil {
+		return nil
+	}
+	if !strings.Contains(word[strings.Index(word, apc.BckProviderSeparator)+len(apc.BckProviderSeparator):], "/") {
+		qbck := cmn.QueryBcks{Provider: bck.Provider, Ns: bck.Ns}
+		bcks, err := api.ListBuckets(apiBP, qbck, apc.FltPresent)
+		if err != nil {
+			return nil
+		}
+		for i := range bcks {
+			cands = append(cands, bcks[i].Cname("")+"/")
+		}
+		return cands
+	}
+	msg := &apc.LsoMsg{Prefix: objName, PageSize: replCmplMaxObj, Props: apc.GetPropsName}
+	msg.SetFlag(apc.LsNameOnly | apc.LsNoRecursion)
+	lst, err := api.ListObjectsPage(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil
+	}
+	for _, en := range lst.Entries {
+		name := bck.Cname(en.Name)
+		if en.IsAnyFlagSet(apc.EntryIsDir) && !strings.HasSuffix(name, "/") {
+			name += "/"
+		}
+		cands = append(cands, name)
+	}
+	return cands
+}
+
+// split command line into arguments: whitespace-separated, with single and double quotes
+// and backslash escapes (outside single quotes)
+func splitCmdline(line string) (args []string, _ error) {
+	var (
+		sb      strings.Builder
+		quote   rune
+		escaped bool
+		inArg   bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			sb.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				sb.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, sb.String())
+				sb.Reset()
+				inArg = false
+			}
+		default:
+			sb.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", line)
+	}
+	if inArg {
+		args = append(args, sb.String())
+	}
+	return args, nil
+}
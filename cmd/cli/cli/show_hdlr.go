@@ -33,6 +33,14 @@ var (
 			noHeaderFlag,
 			unitsFlag,
 			silentFlag,
+			// batch
+			listFlag,
+			templateFlag,
+			verbObjPrefixFlag,
+			objLimitFlag,
+			objStatSortFlag,
+			objStatConcFlag,
+			jsonFlag,
 		},
 		cmdCluster: append(
 			longRunFlags,
@@ -101,9 +109,11 @@ var (
 		},
 	}
 	showCmdObject = cli.Command{
-		Name:         cmdObject,
-		Usage:        "Show object properties",
-		ArgsUsage:    objectArgument,
+		Name: cmdObject,
+		Usage: "Show object properties; given bucket and '--list', '--template', or '--prefix', show (and sort)\n" +
+			indent1 + "size, atime, and checksum of multiple objects, e.g.:\n" +
+			indent1 + "\t- 'ais show object ais://abc --prefix logs/ --sort -size'\t- largest objects under the 'logs/' prefix first",
+		ArgsUsage:    optionalObjectsArgument,
 		Flags:        sortFlags(showCmdsFlags[cmdObject]),
 		Action:       showObjectHandler,
 		BashComplete: bucketCompletions(bcmplop{separator: true}),
@@ -239,14 +249,20 @@ func showObjectHandler(c *cli.Context) error {
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, "object name in the form "+objectArgument)
 	}
-	fullObjName := c.Args().Get(0)
-	bck, object, err := parseBckObjURI(c, fullObjName, false)
+	var (
+		fullObjName = c.Args().Get(0)
+		batch       = isBatchStat(c)
+	)
+	bck, object, err := parseBckObjURI(c, fullObjName, batch /*emptyObjnameOK*/)
 	if err != nil {
 		return err
 	}
 	if _, err := headBucket(bck, true /* don't add */); err != nil {
 		return err
 	}
+	if batch {
+		return batchStatHandler(c, bck, object)
+	}
 	_, err = showObjProps(c, bck, object, false /*silent*/)
 	return err
 }
@@ -464,7 +464,7 @@ func newBsummCtxMsg(c *cli.Context, qbck cmn.QueryBcks, prefix string, objCached
 
 	if ctx.args.DontWait = flagIsSet(c, dontWaitFlag); ctx.args.DontWait {
 		if showProgress := flagIsSet(c, progressFlag); showProgress {
-			return nil, fmt.Errorf(errFmtExclusive, qflprn(dontWaitFlag), qflprn(progressFlag))
+			return nil, errExclusive(qflprn(dontWaitFlag), qflprn(progressFlag))
 		}
 		return ctx, nil
 	}
@@ -34,6 +34,7 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
+	"golang.org/x/term"
 )
 
 // This file contains common utilities and low-level helpers.
@@ -890,12 +891,32 @@ func selectProvidersExclRais(bcks cmn.Bcks) (sorted []string) {
 
 // see related: `verboseWarnings()`
 
-func actionDone(c *cli.Context, msg string) { fmt.Fprintln(c.App.Writer, msg) }
+// global `--quiet`: informational output is suppressed (errors and warnings are not)
+func isQuiet(c *cli.Context) bool { return c.GlobalBool(quietFlag.Name) }
+
+// e.g., live progress makes sense only when the output is a terminal
+func isTerm(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+func actionDone(c *cli.Context, msg string) {
+	if !isQuiet(c) {
+		fmt.Fprintln(c.App.Writer, msg)
+	}
+}
+
 func actionWarn(c *cli.Context, msg string) { fmt.Fprintln(c.App.ErrWriter, fcyan("Warning: ")+msg) }
-func actionNote(c *cli.Context, msg string) { fmt.Fprintln(c.App.ErrWriter, fblue("Note: ")+msg) }
 
+func actionNote(c *cli.Context, msg string) {
+	if !isQuiet(c) {
+		fmt.Fprintln(c.App.ErrWriter, fblue("Note: ")+msg)
+	}
+}
+
+// (with `--quiet`, same as non-verbose: xaction ID only)
 func actionX(c *cli.Context, xargs *xact.ArgsMsg, s string) {
-	if flagIsSet(c, nonverboseFlag) {
+	if flagIsSet(c, nonverboseFlag) || isQuiet(c) {
 		fmt.Fprintln(c.App.Writer, xargs.ID)
 		return
 	}
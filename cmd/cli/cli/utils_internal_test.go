@@ -5,7 +5,14 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
 	"reflect"
+	"strings"
+	"syscall"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -464,3 +471,117 @@ func TestParseBckObjectURI(t *testing.T) {
 		tassert.Errorf(t, err != nil, "expected error on %s (bck: %q, obj_name: %q)", test.uri, bck.String(), objName)
 	}
 }
+
+func TestEndpointArg(t *testing.T) {
+	tests := []struct {
+		args     []string
+		endpoint string
+	}{
+		{[]string{"ais", "ls"}, ""},
+		{[]string{"ais", "--endpoint", "http://10.0.0.1:8080", "ls"}, "http://10.0.0.1:8080"},
+		{[]string{"ais", "--endpoint=http://10.0.0.1:8080", "ls"}, "http://10.0.0.1:8080"},
+		{[]string{"ais", "-h", "--endpoint", "http://10.0.0.1:8080"}, "http://10.0.0.1:8080"},
+		{[]string{"ais", "ls", "--endpoint", "http://10.0.0.1:8080"}, ""}, // not global
+		{[]string{"ais", "--endpoint"}, ""},
+		{[]string{"ais", "--dry-run", "api", "--endpoint", "http://10.0.0.1:8080", "ls"}, "http://10.0.0.1:8080"},
+		{[]string{"ais", "--quiet", "--endpoint", "http://10.0.0.1:8080", "put"}, "http://10.0.0.1:8080"}, // (bool)
+	}
+	for _, test := range tests {
+		if endpoint := endpointArg(test.args); endpoint != test.endpoint {
+			t.Errorf("%v: expected %q, got %q", test.args, test.endpoint, endpoint)
+		}
+	}
+	args := []string{"ais", "--endpoint", "http://10.0.0.1:8080", "--dry-run=api", "rm"}
+	if mode := globalArg(args, dryRunAPIFlag.Name); mode != dryRunAPI {
+		t.Errorf("%v: expected %q, got %q", args, dryRunAPI, mode)
+	}
+}
+
+func TestGlobalBool(t *testing.T) {
+	tests := []struct {
+		args []string
+		set  bool
+	}{
+		{[]string{"ais", "ls"}, false},
+		{[]string{"ais", "--no-color", "ls"}, true},
+		{[]string{"ais", "--no-color=false", "ls"}, false},
+		{[]string{"ais", "--endpoint", "http://10.0.0.1:8080", "--no-color", "ls"}, true},
+		{[]string{"ais", "ls", "--no-color"}, false}, // not global
+	}
+	for _, test := range tests {
+		if set := globalBool(test.args, noColorFlag.Name); set != test.set {
+			t.Errorf("%v: expected %t, got %t", test.args, test.set, set)
+		}
+	}
+}
+
+type sentRT struct{ sent []string }
+
+func (rt *sentRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.sent = append(rt.sent, req.Method+" "+req.URL.Path)
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+func TestAPIPrinter(t *testing.T) {
+	var (
+		sb     strings.Builder
+		rt     = &sentRT{}
+		client = newAPIPrinter(&http.Client{Transport: rt}, &sb)
+		msg    = `{"action":"delete-listrange","value":{"objnames":["a","b"]}}`
+	)
+	req, err := http.NewRequest(http.MethodHead, "http://localhost:8080/v1/buckets/nnn?provider=ais", http.NoBody)
+	tassert.CheckFatal(t, err)
+	resp, err := client.Do(req)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, resp.StatusCode == http.StatusOK, "expected lookup sent, got %d", resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodDelete, "http://localhost:8080/v1/buckets/nnn?provider=ais", strings.NewReader(msg))
+	tassert.CheckFatal(t, err)
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	req.Header.Set(apc.HdrAuthorization, "Bearer secret")
+	resp, err = client.Do(req)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, resp.StatusCode == http.StatusNoContent, "expected not sent, got %d", resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodPut, "http://localhost:8080/v1/objects/nnn/obj?provider=ais", strings.NewReader("content"))
+	tassert.CheckFatal(t, err)
+	_, err = client.Do(req)
+	tassert.CheckFatal(t, err)
+
+	tassert.Errorf(t, len(rt.sent) == 1 && rt.sent[0] == "HEAD /v1/buckets/nnn", "expected only HEAD sent, got %v", rt.sent)
+	out := sb.String()
+	for _, s := range []string{"HEAD /v1/buckets/nnn?provider=ais", "DELETE /v1/buckets/nnn?provider=ais", msg, "PUT /v1/objects/nnn/obj?provider=ais", "<7B body>"} {
+		tassert.Errorf(t, strings.Contains(out, s), "expected %q in:\n%s", s, out)
+	}
+	tassert.Errorf(t, !strings.Contains(out, "secret") && !strings.Contains(out, "content"), "unexpected output:\n%s", out)
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{nil, 0},
+		{errors.New("something went wrong"), exitGeneric},
+		{&errDoesNotExist{what: "object", name: "ais://bck/obj"}, exitNotFound},
+		{newAdditionalInfoError(&errDoesNotExist{name: "ais://bck"}, "try 'ais ls'"), exitNotFound},
+		{fmt.Errorf("failed to open: %w", os.ErrNotExist), exitNotFound},
+		{&cmn.ErrHTTP{Status: http.StatusNotFound}, exitNotFound},
+		{fmt.Errorf("GET: %w", &cmn.ErrHTTP{Status: http.StatusForbidden}), exitAuth},
+		{&cmn.ErrHTTP{Status: http.StatusUnauthorized}, exitAuth},
+		{&cmn.ErrHTTP{Status: http.StatusBadRequest}, exitInvalid},
+		{&cmn.ErrHTTP{Status: http.StatusServiceUnavailable}, exitUnreachable},
+		{&cmn.ErrHTTP{Status: http.StatusInternalServerError}, exitGeneric},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, exitUnreachable},
+		{errors.New("Get \"http://localhost:8080\": dial tcp 127.0.0.1:8080: i/o timeout"), exitUnreachable},
+		{errExclusive("--foo", "--bar"), exitInvalid},
+		{&errUsage{message: "missing argument"}, exitInvalid},
+		{&errExit{err: errors.New("formatted"), code: exitAuth}, exitAuth},
+		{&errNotModified{}, exitNotModified},
+	}
+	for i, test := range tests {
+		if code := ExitCode(test.err); code != test.code {
+			t.Errorf("%d (%T): expected exit code %d, got %d", i, test.err, test.code, code)
+		}
+	}
+}
@@ -42,11 +42,13 @@ type (
 		cptn       string
 		totalSize  int64
 		dryRun     bool
+		stopOnErr  bool
 	}
 	uctx struct {
 		wg            cos.WG
 		errCh         chan string
 		errCount      atomic.Int32 // uploads failed so far
+		skipCount     atomic.Int32 // not started due to '--stop-on-err'
 		processedCnt  atomic.Int32 // files processed so far
 		processedSize atomic.Int64 // size of already processed files
 		barObjs       *mpb.Bar
@@ -125,6 +127,7 @@ func verbFobjs(c *cli.Context, wop wop, fobjs []fobj, bck cmn.Bck, ndir int, rec
 		cptn:       cptn,
 		totalSize:  totalSize,
 		dryRun:     flagIsSet(c, dryRunFlag),
+		stopOnErr:  flagIsSet(c, stopOnErrorFlag),
 	}
 	return uparams.do(c)
 }
@@ -203,6 +206,10 @@ func (p *uparams) do(c *cli.Context) error {
 			}
 			fh.Close()
 		}
+		if numSkipped := u.skipCount.Load(); numSkipped > 0 {
+			return fmt.Errorf("failed to %s %d file%s (%q), skipped %d (%s)", p.wop.verb(), numFailed, cos.Plural(int(numFailed)), fn,
+				numSkipped, qflprn(stopOnErrorFlag))
+		}
 		return fmt.Errorf("failed to %s %d file%s (%q)", p.wop.verb(), numFailed, cos.Plural(int(numFailed)), fn)
 	}
 	if !flagIsSet(c, dryRunFlag) {
@@ -285,6 +292,11 @@ func (p *uparams) _a2aOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 //////////
 
 func (u *uctx) run(c *cli.Context, p *uparams, fobj fobj) {
+	if p.stopOnErr && u.errCount.Load() > 0 {
+		u.skipCount.Inc()
+		u.fini(c, p, fobj)
+		return
+	}
 	fh, bar, err := u.init(c, fobj)
 	if err == nil {
 		updateBar := func(n int, _ error) {
@@ -352,7 +364,7 @@ func (u *uctx) do(c *cli.Context, p *uparams, fobj fobj, fh *cos.FileHandle, upd
 			err = p._putOne(c, fobj, countReader, skipVC, isTout)
 			if err == nil {
 				if i > 0 {
-					fmt.Fprintf(c.App.Writer, "[#%d] %s - done.\n", i+1, fobj.path)
+					actionDone(c, fmt.Sprintf("[#%d] %s - done.", i+1, fobj.path))
 				}
 				break
 			}
@@ -410,7 +422,7 @@ func (u *uctx) fini(c *cli.Context, p *uparams, f fobj) {
 	// lock after releasing semaphore, so the next file can start
 	// uploading even if we are stuck on mutex for a while
 	u.mx.Lock()
-	if !u.showProgress && time.Since(u.lastReport) > u.reportEvery {
+	if !u.showProgress && time.Since(u.lastReport) > u.reportEvery && !isQuiet(c) {
 		fmt.Fprintf(
 			c.App.Writer, "Uploaded %d(%d%%) objects, %s (%d%%).\n",
 			total, 100*total/len(p.fobjs), cos.ToSizeIEC(size, 1), 100*size/p.totalSize,
@@ -468,7 +480,7 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 		_, err = api.PutObject(&putArgs)
 		if err == nil {
 			if i > 0 {
-				fmt.Fprintf(c.App.Writer, "[#%d] %s - done.\n", i+1, path)
+				actionDone(c, fmt.Sprintf("[#%d] %s - done.", i+1, path))
 			}
 			break
 		}
@@ -119,6 +119,16 @@ func waitXactBlob(xargs *xact.ArgsMsg) error {
 	}
 }
 
+// cluster-wide progress of a running multi-target job (via IC), e.g. "42%" or "~42%" (approximate);
+// empty when unknown
+func xactPct(xargs *xact.ArgsMsg) string {
+	status, err := api.GetOneXactionStatus(apiBP, &xact.ArgsMsg{ID: xargs.ID, Kind: xargs.Kind})
+	if err != nil || status.Finished() {
+		return ""
+	}
+	return status.PctString()
+}
+
 func getKindNameForID(xid string, otherKind ...string) (kind, xname string, rerr error) {
 	xargs := xact.ArgsMsg{ID: xid}
 	status, err := api.GetOneXactionStatus(apiBP, &xargs) // via IC
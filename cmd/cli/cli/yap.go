@@ -26,6 +26,7 @@ type (
 		abspath string
 		tmpl    string
 		finfo   os.FileInfo
+		files   string   // CSV (local-path, object-name) via '--files'
 		fdnames []string // files and directories (names)
 		isdir   bool
 		recurs  bool
@@ -91,6 +92,10 @@ func (a *putargs) parse(c *cli.Context, emptyDstOnameOK bool) (err error) {
 	if flagIsSet(c, listFlag) && flagIsSet(c, templateFlag) {
 		return incorrectUsageMsg(c, errFmtExclusive, qflprn(listFlag), qflprn(templateFlag))
 	}
+	if flagIsSet(c, putFilesFlag) && (flagIsSet(c, listFlag) || flagIsSet(c, templateFlag)) {
+		return incorrectUsageMsg(c, "%s cannot be used together with %s or %s",
+			qflprn(putFilesFlag), qflprn(listFlag), qflprn(templateFlag))
+	}
 	if flagIsSet(c, progressFlag) || flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) {
 		// check connectivity (since '--progress' steals STDOUT with multi-object producing
 		// scary looking errors when there's no cluster)
@@ -106,8 +111,11 @@ func (a *putargs) parse(c *cli.Context, emptyDstOnameOK bool) (err error) {
 			return err
 		}
 
-		// source files via '--list' or '--template'
+		// source files via '--list', '--template', or '--files'
 		switch {
+		case flagIsSet(c, putFilesFlag):
+			a.src.files = parseStrFlag(c, putFilesFlag)
+			return nil
 		case flagIsSet(c, listFlag):
 			csv := parseStrFlag(c, listFlag)
 			a.src.fdnames = splitCsv(csv)
@@ -142,6 +150,9 @@ func (a *putargs) parse(c *cli.Context, emptyDstOnameOK bool) (err error) {
 		if flagIsSet(c, templateFlag) {
 			return fmt.Errorf(efmt, a.src.arg, qflprn(templateFlag))
 		}
+		if flagIsSet(c, putFilesFlag) {
+			return fmt.Errorf(efmt, a.src.arg, qflprn(putFilesFlag))
+		}
 
 		// STDIN
 		if a.src.arg == "-" {
@@ -1,6 +1,6 @@
 // Package cli is used as command-line interpreter for AIS
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
  */
 package main
 
@@ -28,18 +28,24 @@ func dispatchInterruptHandler() {
 }
 
 func main() {
-	dispatchInterruptHandler()
+	// interactive mode handles Ctrl-C on its own (cancels the current command)
+	if !cli.IsREPL(os.Args) {
+		dispatchInterruptHandler()
+	}
 
 	if err := cli.Init(os.Args); err != nil {
-		exitf("%v", err)
+		exitf(cli.ExitCode(err), "%v", err)
 	}
 
 	if err := cli.Run(cmn.VersionCLI+"."+build, buildtime, os.Args); err != nil {
-		exitf("%v", err)
+		exitf(cli.ExitCode(err), "%v", err)
 	}
 }
 
-func exitf(f string, a ...any) {
-	fmt.Fprintf(os.Stderr, f+"\n", a...)
-	os.Exit(1)
+// (see docs/cli.md for the exit codes)
+func exitf(code int, f string, a ...any) {
+	if msg := fmt.Sprintf(f, a...); msg != "" { // (empty when not an error, e.g. conditional GET: not modified)
+		fmt.Fprintln(os.Stderr, msg)
+	}
+	os.Exit(code)
 }
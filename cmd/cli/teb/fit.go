@@ -0,0 +1,119 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tables rendered with `Opts.Fit`: same column layout as the tabwriter in `Print` - tab stops
+// every `tabWidth` columns - except that (i) color (ANSI) sequences do not count toward
+// the cell widths, and (ii) given the terminal `Width`, the widest column(s) get truncated
+// (in the middle, keeping both the head and the tail, e.g. of an object name) so that
+// each line fits.
+
+const (
+	tabWidth  = 8
+	minFitCol = 16 // never truncate a column below this width
+	ellipsis  = "…"
+)
+
+var reANSI = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func visibleLen(s string) int { return utf8.RuneCountInString(reANSI.ReplaceAllString(s, "")) }
+
+// cell width (including padding) rounded up to the next tab stop; compare w/ `tabwriter.Writer`
+func tabStop(w int) int { return (w + 1 + tabWidth - 1) / tabWidth * tabWidth }
+
+func fitTable(w io.Writer, out string, width int) error {
+	var (
+		lines  = strings.Split(out, "\n")
+		rows   = make([][]string, len(lines))
+		widths []int // max visible width per column (the last one is not padded)
+	)
+	for i, line := range lines {
+		cells := strings.Split(line, "\t")
+		rows[i] = cells
+		if len(cells) == 1 {
+			continue // not part of the table
+		}
+		for k, cell := range cells {
+			if k == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[k] = max(widths[k], visibleLen(cell))
+		}
+	}
+	limits := fitWidths(widths, width)
+
+	var sb strings.Builder
+	for i, cells := range rows {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		if len(cells) == 1 {
+			sb.WriteString(cells[0])
+			continue
+		}
+		for k, cell := range cells {
+			vlen := visibleLen(cell)
+			if vlen > limits[k] {
+				cell, vlen = truncMiddle(cell, limits[k]), limits[k]
+			}
+			sb.WriteString(cell)
+			if k < len(cells)-1 {
+				sb.WriteString(strings.Repeat(" ", tabStop(limits[k])-vlen))
+			}
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// given terminal width (zero if unknown), returns per-column limits
+func fitWidths(widths []int, width int) []int {
+	limits := make([]int, len(widths))
+	copy(limits, widths)
+	if width <= 0 {
+		return limits
+	}
+	for {
+		total, widest := 0, 0
+		for k, l := range limits {
+			if k < len(limits)-1 {
+				total += tabStop(l)
+			} else {
+				total += l
+			}
+			if l > limits[widest] {
+				widest = k
+			}
+		}
+		excess := total - width
+		if excess <= 0 {
+			break
+		}
+		l := max(limits[widest]-excess, minFitCol)
+		if l >= limits[widest] {
+			break // can't fit
+		}
+		limits[widest] = l
+	}
+	return limits
+}
+
+// keeps both the head and the tail; drops colors, if any
+func truncMiddle(s string, n int) string {
+	runes := []rune(reANSI.ReplaceAllString(s, ""))
+	if len(runes) <= n {
+		return string(runes)
+	}
+	head := (n - 1) / 2
+	tail := n - 1 - head
+	return string(runes[:head]) + ellipsis + string(runes[len(runes)-tail:])
+}
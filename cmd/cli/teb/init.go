@@ -7,18 +7,34 @@ package teb
 import (
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/fatih/color"
+	"golang.org/x/term"
 )
 
-var Writer io.Writer
+var (
+	Writer io.Writer
+
+	// terminal width (columns) to fit tables into (see `Opts.Fit`); zero when not a terminal
+	Width int
+)
 
 var (
 	fred, fcyan, fgreen, fblue func(format string, a ...any) string
+
+	colored bool
 )
 
 func Init(w io.Writer, noColor bool) {
 	Writer = w
+	Width = 0
+	if f, ok := w.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		if cols, _, err := term.GetSize(int(f.Fd())); err == nil {
+			Width = cols
+		}
+	}
+	colored = !noColor
 	if noColor {
 		fred, fcyan, fgreen, fblue = fmt.Sprintf, fmt.Sprintf, fmt.Sprintf, fmt.Sprintf
 	} else {
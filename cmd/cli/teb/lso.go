@@ -114,5 +114,8 @@ func fmtLsObjIsCached(en *cmn.LsoEnt) string {
 	if en.IsAnyFlagSet(apc.EntryIsDir) {
 		return ""
 	}
-	return FmtBool(en.IsPresent())
+	if en.IsPresent() {
+		return fgreen(FmtBool(true))
+	}
+	return FmtBool(false)
 }
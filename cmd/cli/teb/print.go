@@ -6,6 +6,7 @@ package teb
 
 import (
 	"fmt"
+	"strings"
 	"text/tabwriter"
 	"text/template"
 
@@ -17,6 +18,7 @@ type Opts struct {
 	AltMap  template.FuncMap
 	Units   string
 	UseJSON bool
+	Fit     bool // align colored cells and fit the table into the terminal width (see fit.go)
 }
 
 func Jopts(usejs bool) Opts { return Opts{UseJSON: usejs} }
@@ -56,7 +58,15 @@ func Print(object any, templ string, aux ...Opts) error {
 		return err
 	}
 
-	w := tabwriter.NewWriter(Writer, 0, 8, 1, '\t', 0)
+	if opts.Fit && (Width > 0 || colored) {
+		var sb strings.Builder
+		if err := parsedTempl.Execute(&sb, object); err != nil {
+			return err
+		}
+		return fitTable(Writer, sb.String(), Width)
+	}
+
+	w := tabwriter.NewWriter(Writer, 0, tabWidth, 1, '\t', 0)
 	if err := parsedTempl.Execute(w, object); err != nil {
 		return err
 	}
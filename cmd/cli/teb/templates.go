@@ -69,6 +69,14 @@ const (
 	ObjLockTmpl      = objLockTmplHdr + ObjLockTmplNoHdr
 	ObjLockTmplNoHdr = "{{range $o := . }}" + "{{$o.Name}}\t {{$o.Status}}\n" + "{{end}}"
 
+	objStatTmplHdr   = "NAME\t SIZE\t ATIME\t CHECKSUM\t STATUS\n"
+	ObjStatTmpl      = objStatTmplHdr + ObjStatTmplNoHdr
+	ObjStatTmplNoHdr = "{{range $o := . }}" + "{{$o.Name}}\t {{$o.Size}}\t {{$o.Atime}}\t {{$o.Cksum}}\t {{$o.Status}}\n" + "{{end}}"
+
+	objLocateTmplHdr   = "TARGET\t HRW SCORE\t OWNER\t PRESENT\t LOCATION\n"
+	ObjLocateTmpl      = objLocateTmplHdr + ObjLocateTmplNoHdr
+	ObjLocateTmplNoHdr = "{{range $t := . }}" + "{{$t.ID}}\t {{$t.Score}}\t {{$t.Owner}}\t {{$t.Present}}\t {{$t.Location}}\n" + "{{end}}"
+
 	// w/ special arrangement for feature flags
 	FeatDescTmplHdr = "FEATURE\t DESCRIPTION\n"
 
@@ -63,6 +63,7 @@ type (
 		LogDir    string         `json:"log_dir"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
 		HostNet   LocalNetConfig `json:"host_net"`
+		Weight    uint32         `json:"weight,omitempty"` // static HRW weight of this node (see meta.Snode.Weight); zero means 1
 	}
 
 	// ais node: (local) network config
@@ -583,9 +584,121 @@ type (
 
 	DownloaderConf struct {
 		Timeout cos.Duration `json:"timeout"`
+		// target-side caching of computed job status (to serve rapid repeated polls);
+		// zero value translates as the default (`DfltDloadStatusTTL`)
+		StatusTTL cos.Duration `json:"status_ttl,omitempty"`
+		// target-wide ceiling on the total size of concurrently downloaded objects,
+		// across all jobs; zero means unlimited (takes effect with the next downloader xaction)
+		MaxInflight cos.SizeIEC `json:"max_inflight,omitempty"`
+		// parallel chunked fetch: resumable downloads larger than `ChunkSize` get assembled from ranges
+		// fetched `ChunkConc` at a time, each range retried up to `ChunkRetries` times (independently
+		// from the `MaxRetries` of the object as a whole); disabled when ChunkSize is zero (default);
+		// zero concurrency and retries translate as the respective defaults, `ChunkRetries` = -1 disables
+		ChunkSize    cos.SizeIEC `json:"chunk_size,omitempty"`
+		ChunkConc    int         `json:"chunk_concurrency,omitempty"`
+		ChunkRetries int         `json:"chunk_retries,omitempty"`
+		// (target-local) JSON file with per-origin credentials used to sign download requests
+		// (see ext/dload/creds.go); loaded with the next downloader xaction
+		Credentials string `json:"credentials,omitempty"`
+		// hard limit on the number of objects a single range (template) download may expand to;
+		// zero value translates as the default (`DfltDloadMaxRange`)
+		MaxRange int64 `json:"max_range,omitempty"`
+		// when a (per-mountpath) queue of pending tasks is full, spill its least urgent tasks into the
+		// target-local downloader DB rather than stalling the job (takes effect with the next downloader xaction)
+		SpillQueue bool `json:"spill_queue,omitempty"`
+		// while rebalance is running (on a given target), do not start new downloads (those in flight
+		// are allowed to finish) and resume upon rebalance completion (takes effect with the next downloader xaction)
+		PauseOnRebalance bool `json:"pause_on_rebalance,omitempty"`
+		// (per target) maximum number of concurrently running jobs that download into a given bucket;
+		// jobs beyond the cap wait (in FIFO order) for a slot to free up - or get rejected,
+		// see `RejectOverCap`; zero means unlimited (takes effect with the next downloader xaction)
+		MaxJobsPerBck int `json:"max_jobs_per_bck,omitempty"`
+		// reject (rather than queue) new jobs that exceed `MaxJobsPerBck`
+		RejectOverCap bool `json:"reject_over_cap,omitempty"`
+		// transient (stream-through) downloads fetch from the origin and stream the bytes straight
+		// back to the client without storing; disabled when zero (default), otherwise the maximum
+		// size of the streamed object
+		MaxStreamSize cos.SizeIEC `json:"max_stream_size,omitempty"`
+		// adaptive ingest: the target-wide number of concurrently running downloads follows the node's load
+		// (disk utilization, load average), backing off when busy and ramping up when idle, within
+		// [AdaptiveMin, AdaptiveMax]; disabled when AdaptiveMax is zero (takes effect with the next downloader xaction)
+		AdaptiveMin int `json:"adaptive_min,omitempty"`
+		AdaptiveMax int `json:"adaptive_max,omitempty"`
+		// per-origin (host) circuit breaker: once the number of failed downloads from a given host
+		// within `BreakerWindow` reaches `BreakerErrs`, subsequent downloads from the host fail fast
+		// for the duration of `BreakerCooldown` - until a single probing download succeeds;
+		// disabled when BreakerErrs is zero; zero window and cooldown translate as the respective
+		// defaults (takes effect with the next downloader xaction)
+		BreakerErrs     int          `json:"breaker_errs,omitempty"`
+		BreakerWindow   cos.Duration `json:"breaker_window,omitempty"`
+		BreakerCooldown cos.Duration `json:"breaker_cooldown,omitempty"`
+		// (per target) summaries of completed jobs retained for historical reporting, separately from
+		// the jobs themselves: at most `HistoryMax` records, none older than `HistoryTTL`;
+		// zero values translate as the respective defaults
+		HistoryMax int          `json:"history_max,omitempty"`
+		HistoryTTL cos.Duration `json:"history_ttl,omitempty"`
+		// transient failures (connection errors, timeouts, 408, 429, and 5xx except 501 and 505) get retried
+		// up to `MaxRetries` times, with exponential backoff that starts at `RetryBackoff` and doubles
+		// with each retry; zero values translate as the respective defaults, while
+		// `MaxRetries` = -1 (NoDloadRetries) disables retries altogether
+		MaxRetries   int          `json:"max_retries,omitempty"`
+		RetryBackoff cos.Duration `json:"retry_backoff,omitempty"`
+		// target-wide download bandwidth (bytes per second) shared by all mountpath joggers and all jobs,
+		// so that the aggregate stays bounded; a job may further lower its own rate (see dload `Limits.BytesPerSecond`);
+		// zero means unlimited (takes effect with the next downloader xaction)
+		MaxBandwidth cos.SizeIEC `json:"max_bandwidth,omitempty"`
+		// number of concurrently running downloads per mountpath (jogger); zero value translates
+		// as the default (`DfltDloadJoggerConc`) (takes effect with the next downloader xaction)
+		JoggerConc int `json:"jogger_concurrency,omitempty"`
+		// maximum number of redirects to follow when downloading a given link (the object fails when exceeded);
+		// zero value translates as the default (`DfltDloadMaxRedirects`)
+		MaxRedirects int `json:"max_redirects,omitempty"`
+		// per-job download throughput (as reported with the job's status) is averaged over this trailing window;
+		// zero value translates as the default (`DfltDloadRateWindow`)
+		RateWindow cos.Duration `json:"rate_window,omitempty"`
+		// origin connections: time to connect (zero value translates as `DfltDialupTimeout`), to complete
+		// the TLS handshake (`DfltDloadTLSHandshake`), and to receive response headers once the request
+		// is sent (zero means no separate limit); take effect upon target restart
+		ConnectTimeout        cos.Duration `json:"connect_timeout,omitempty"`
+		TLSHandshakeTimeout   cos.Duration `json:"tls_handshake_timeout,omitempty"`
+		ResponseHeaderTimeout cos.Duration `json:"response_header_timeout,omitempty"`
+		// when non-zero, a download fails (and gets retried) once no content arrives for this long; the
+		// download timeout (see `Timeout`) then bounds the wait for the response only, not the entire
+		// transfer - so that large objects over slow-but-steady connections do complete
+		ReadIdleTimeout cos.Duration `json:"read_idle_timeout,omitempty"`
 	}
 	DownloaderConfToSet struct {
-		Timeout *cos.Duration `json:"timeout,omitempty"`
+		Timeout          *cos.Duration `json:"timeout,omitempty"`
+		StatusTTL        *cos.Duration `json:"status_ttl,omitempty"`
+		MaxInflight      *cos.SizeIEC  `json:"max_inflight,omitempty"`
+		ChunkSize        *cos.SizeIEC  `json:"chunk_size,omitempty"`
+		ChunkConc        *int          `json:"chunk_concurrency,omitempty"`
+		ChunkRetries     *int          `json:"chunk_retries,omitempty"`
+		Credentials      *string       `json:"credentials,omitempty"`
+		MaxRange         *int64        `json:"max_range,omitempty"`
+		SpillQueue       *bool         `json:"spill_queue,omitempty"`
+		PauseOnRebalance *bool         `json:"pause_on_rebalance,omitempty"`
+		MaxJobsPerBck    *int          `json:"max_jobs_per_bck,omitempty"`
+		RejectOverCap    *bool         `json:"reject_over_cap,omitempty"`
+		MaxStreamSize    *cos.SizeIEC  `json:"max_stream_size,omitempty"`
+		AdaptiveMin      *int          `json:"adaptive_min,omitempty"`
+		AdaptiveMax      *int          `json:"adaptive_max,omitempty"`
+		BreakerErrs      *int          `json:"breaker_errs,omitempty"`
+		BreakerWindow    *cos.Duration `json:"breaker_window,omitempty"`
+		BreakerCooldown  *cos.Duration `json:"breaker_cooldown,omitempty"`
+		HistoryMax       *int          `json:"history_max,omitempty"`
+		HistoryTTL       *cos.Duration `json:"history_ttl,omitempty"`
+		MaxRetries       *int          `json:"max_retries,omitempty"`
+		RetryBackoff     *cos.Duration `json:"retry_backoff,omitempty"`
+		MaxBandwidth     *cos.SizeIEC  `json:"max_bandwidth,omitempty"`
+		JoggerConc       *int          `json:"jogger_concurrency,omitempty"`
+		MaxRedirects     *int          `json:"max_redirects,omitempty"`
+		RateWindow       *cos.Duration `json:"rate_window,omitempty"`
+
+		ConnectTimeout        *cos.Duration `json:"connect_timeout,omitempty"`
+		TLSHandshakeTimeout   *cos.Duration `json:"tls_handshake_timeout,omitempty"`
+		ResponseHeaderTimeout *cos.Duration `json:"response_header_timeout,omitempty"`
+		ReadIdleTimeout       *cos.Duration `json:"read_idle_timeout,omitempty"`
 	}
 
 	DsortConf struct {
@@ -829,6 +942,9 @@ func (c *Config) Validate() error {
 	if err := c.LocalConfig.TestFSP.Validate(c); err != nil {
 		return err
 	}
+	if c.LocalConfig.Weight > MaxNodeWeight {
+		return fmt.Errorf("invalid weight %d (expecting [0, %d])", c.LocalConfig.Weight, MaxNodeWeight)
+	}
 
 	opts := IterOpts{VisitAll: true}
 	return IterFields(c, _validateFld, opts)
@@ -1491,6 +1607,9 @@ func (c *FSHCConf) Validate() error {
 
 const HostnameListSepa = ","
 
+// max `LocalConfig.Weight`
+const MaxNodeWeight = 1000
+
 func (c *LocalNetConfig) Validate(contextConfig *Config) error {
 	c.Hostname = strings.ReplaceAll(c.Hostname, " ", "")
 	c.HostnameIntraControl = strings.ReplaceAll(c.HostnameIntraControl, " ", "")
@@ -1870,13 +1989,245 @@ func (c *TimeoutConf) Validate() error {
 // DownloaderConf //
 ////////////////////
 
+const (
+	DfltDloadStatusTTL = time.Second
+	maxDloadStatusTTL  = time.Minute
+
+	minDloadChunkSize     = cos.MiB
+	DfltDloadChunkConc    = 4
+	maxDloadChunkConc     = 64
+	DfltDloadChunkRetries = 3
+
+	DfltDloadMaxRange = 10_000_000
+
+	DfltDloadBreakerWindow   = time.Minute
+	DfltDloadBreakerCooldown = 30 * time.Second
+
+	DfltDloadHistoryMax = 10_000
+	DfltDloadHistoryTTL = 90 * 24 * time.Hour
+
+	DfltDloadMaxRetries   = 10
+	maxDloadMaxRetries    = 100
+	NoDloadRetries        = -1 // downloader.max_retries: fail on the first error
+	DfltDloadRetryBackoff = 500 * time.Millisecond
+	maxDloadRetryBackoff  = time.Minute
+
+	DfltDloadJoggerConc = 1
+	maxDloadJoggerConc  = 64
+
+	DfltDloadMaxRedirects = 10
+	maxDloadMaxRedirects  = 100
+
+	DfltDloadRateWindow = 10 * time.Second
+	minDloadRateWindow  = time.Second
+	maxDloadRateWindow  = 10 * time.Minute
+
+	DfltDloadTLSHandshake = 10 * time.Second
+	maxDloadConnTimeout   = 10 * time.Minute // (connect, TLS handshake, response headers)
+	maxDloadReadIdle      = time.Hour
+)
+
 func (c *DownloaderConf) Validate() error {
 	if j := c.Timeout.D(); j < time.Second || j > time.Hour {
 		return fmt.Errorf("invalid downloader.timeout=%s (expected range [1s, 1h])", j)
 	}
+	if j := c.StatusTTL.D(); j < 0 || j > maxDloadStatusTTL {
+		return fmt.Errorf("invalid downloader.status_ttl=%s (expected range [0, %s])", j, maxDloadStatusTTL)
+	}
+	if c.MaxInflight < 0 {
+		return fmt.Errorf("invalid downloader.max_inflight=%d (expecting non-negative)", c.MaxInflight)
+	}
+	if c.ChunkSize != 0 && c.ChunkSize < minDloadChunkSize {
+		return fmt.Errorf("invalid downloader.chunk_size=%s (expecting 0 (zero) to disable or at least %s)",
+			c.ChunkSize, cos.ToSizeIEC(minDloadChunkSize, 0))
+	}
+	if c.ChunkConc < 0 || c.ChunkConc > maxDloadChunkConc {
+		return fmt.Errorf("invalid downloader.chunk_concurrency=%d (expected range [0, %d])", c.ChunkConc, maxDloadChunkConc)
+	}
+	if c.ChunkRetries < NoDloadRetries || c.ChunkRetries > maxDloadMaxRetries {
+		return fmt.Errorf("invalid downloader.chunk_retries=%d (expected range [%d, %d])",
+			c.ChunkRetries, NoDloadRetries, maxDloadMaxRetries)
+	}
+	if c.MaxBandwidth < 0 {
+		return fmt.Errorf("invalid downloader.max_bandwidth=%d (expecting non-negative)", c.MaxBandwidth)
+	}
+	if c.Credentials != "" && !filepath.IsAbs(c.Credentials) {
+		return fmt.Errorf("invalid downloader.credentials=%q (expecting absolute path)", c.Credentials)
+	}
+	if c.MaxRange < 0 {
+		return fmt.Errorf("invalid downloader.max_range=%d (expecting non-negative)", c.MaxRange)
+	}
+	if c.MaxJobsPerBck < 0 {
+		return fmt.Errorf("invalid downloader.max_jobs_per_bck=%d (expecting non-negative)", c.MaxJobsPerBck)
+	}
+	if c.MaxStreamSize < 0 {
+		return fmt.Errorf("invalid downloader.max_stream_size=%d (expecting non-negative)", c.MaxStreamSize)
+	}
+	if c.AdaptiveMin < 0 || c.AdaptiveMax < 0 {
+		return fmt.Errorf("invalid downloader.adaptive_min=%d, adaptive_max=%d (expecting non-negative)", c.AdaptiveMin, c.AdaptiveMax)
+	}
+	if c.AdaptiveMax > 0 && c.AdaptiveMin > c.AdaptiveMax {
+		return fmt.Errorf("invalid downloader.adaptive_min=%d (expecting less than or equal adaptive_max=%d)", c.AdaptiveMin, c.AdaptiveMax)
+	}
+	if c.BreakerErrs < 0 {
+		return fmt.Errorf("invalid downloader.breaker_errs=%d (expecting non-negative)", c.BreakerErrs)
+	}
+	if c.BreakerWindow < 0 || c.BreakerCooldown < 0 {
+		return fmt.Errorf("invalid downloader.breaker_window=%s, breaker_cooldown=%s (expecting non-negative)",
+			c.BreakerWindow, c.BreakerCooldown)
+	}
+	if c.HistoryMax < 0 || c.HistoryTTL < 0 {
+		return fmt.Errorf("invalid downloader.history_max=%d, history_ttl=%s (expecting non-negative)",
+			c.HistoryMax, c.HistoryTTL)
+	}
+	if c.MaxRetries < NoDloadRetries || c.MaxRetries > maxDloadMaxRetries {
+		return fmt.Errorf("invalid downloader.max_retries=%d (expected range [%d, %d])",
+			c.MaxRetries, NoDloadRetries, maxDloadMaxRetries)
+	}
+	if j := c.RetryBackoff.D(); j < 0 || j > maxDloadRetryBackoff {
+		return fmt.Errorf("invalid downloader.retry_backoff=%s (expected range [0, %s])", j, maxDloadRetryBackoff)
+	}
+	if c.JoggerConc < 0 || c.JoggerConc > maxDloadJoggerConc {
+		return fmt.Errorf("invalid downloader.jogger_concurrency=%d (expected range [0, %d])", c.JoggerConc, maxDloadJoggerConc)
+	}
+	if c.MaxRedirects < 0 || c.MaxRedirects > maxDloadMaxRedirects {
+		return fmt.Errorf("invalid downloader.max_redirects=%d (expected range [0, %d])", c.MaxRedirects, maxDloadMaxRedirects)
+	}
+	if j := c.RateWindow.D(); j != 0 && (j < minDloadRateWindow || j > maxDloadRateWindow) {
+		return fmt.Errorf("invalid downloader.rate_window=%s (expecting 0 (zero) for system default or [%s, %s] range)",
+			j, minDloadRateWindow, maxDloadRateWindow)
+	}
+	for _, t := range []struct {
+		name string
+		j    time.Duration
+	}{
+		{"connect_timeout", c.ConnectTimeout.D()},
+		{"tls_handshake_timeout", c.TLSHandshakeTimeout.D()},
+		{"response_header_timeout", c.ResponseHeaderTimeout.D()},
+	} {
+		if t.j < 0 || t.j > maxDloadConnTimeout {
+			return fmt.Errorf("invalid downloader.%s=%s (expected range [0, %s])", t.name, t.j, maxDloadConnTimeout)
+		}
+	}
+	if j := c.ReadIdleTimeout.D(); j < 0 || j > maxDloadReadIdle {
+		return fmt.Errorf("invalid downloader.read_idle_timeout=%s (expected range [0, %s])", j, maxDloadReadIdle)
+	}
 	return nil
 }
 
+func (c *DownloaderConf) StatusCacheTTL() time.Duration {
+	if c.StatusTTL == 0 {
+		return DfltDloadStatusTTL
+	}
+	return c.StatusTTL.D()
+}
+
+func (c *DownloaderConf) ChunkConcurrency() int {
+	if c.ChunkConc == 0 {
+		return DfltDloadChunkConc
+	}
+	return c.ChunkConc
+}
+
+func (c *DownloaderConf) ChunkRetryCount() int {
+	switch c.ChunkRetries {
+	case 0:
+		return DfltDloadChunkRetries
+	case NoDloadRetries:
+		return 0
+	default:
+		return c.ChunkRetries
+	}
+}
+
+func (c *DownloaderConf) MaxRangeCount() int64 {
+	if c.MaxRange == 0 {
+		return DfltDloadMaxRange
+	}
+	return c.MaxRange
+}
+
+func (c *DownloaderConf) BreakerWindowDur() time.Duration {
+	if c.BreakerWindow == 0 {
+		return DfltDloadBreakerWindow
+	}
+	return c.BreakerWindow.D()
+}
+
+func (c *DownloaderConf) BreakerCooldownDur() time.Duration {
+	if c.BreakerCooldown == 0 {
+		return DfltDloadBreakerCooldown
+	}
+	return c.BreakerCooldown.D()
+}
+
+func (c *DownloaderConf) HistoryMaxCount() int {
+	if c.HistoryMax == 0 {
+		return DfltDloadHistoryMax
+	}
+	return c.HistoryMax
+}
+
+func (c *DownloaderConf) HistoryMaxAge() time.Duration {
+	if c.HistoryTTL == 0 {
+		return DfltDloadHistoryTTL
+	}
+	return c.HistoryTTL.D()
+}
+
+func (c *DownloaderConf) MaxRetryCount() int {
+	switch c.MaxRetries {
+	case 0:
+		return DfltDloadMaxRetries
+	case NoDloadRetries:
+		return 0
+	default:
+		return c.MaxRetries
+	}
+}
+
+func (c *DownloaderConf) RetryBackoffDur() time.Duration {
+	if c.RetryBackoff == 0 {
+		return DfltDloadRetryBackoff
+	}
+	return c.RetryBackoff.D()
+}
+
+func (c *DownloaderConf) JoggerConcurrency() int {
+	if c.JoggerConc == 0 {
+		return DfltDloadJoggerConc
+	}
+	return c.JoggerConc
+}
+
+func (c *DownloaderConf) MaxRedirectCount() int {
+	if c.MaxRedirects == 0 {
+		return DfltDloadMaxRedirects
+	}
+	return c.MaxRedirects
+}
+
+func (c *DownloaderConf) RateWindowDur() time.Duration {
+	if c.RateWindow == 0 {
+		return DfltDloadRateWindow
+	}
+	return c.RateWindow.D()
+}
+
+func (c *DownloaderConf) ConnectTimeoutDur() time.Duration {
+	if c.ConnectTimeout == 0 {
+		return DfltDialupTimeout
+	}
+	return c.ConnectTimeout.D()
+}
+
+func (c *DownloaderConf) TLSHandshakeTimeoutDur() time.Duration {
+	if c.TLSHandshakeTimeout == 0 {
+		return DfltDloadTLSHandshake
+	}
+	return c.TLSHandshakeTimeout.D()
+}
+
 ///////////////////
 // RebalanceConf //
 ///////////////////
@@ -213,6 +213,9 @@ func IsErrNotDir(err error) bool {
 	return strings.Contains(err.Error(), "directory") && errors.Is(err, syscall.ENOTDIR)
 }
 
+// "invalid cross-device link" - rename(2) across filesystems
+func IsErrXdev(err error) bool { return errors.Is(err, syscall.EXDEV) }
+
 // likely out of socket descriptors
 func IsErrConnectionNotAvail(err error) (yes bool) {
 	return errors.Is(err, syscall.EADDRNOTAVAIL)
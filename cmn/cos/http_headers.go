@@ -30,11 +30,14 @@ const (
 	HdrContentRange          = "Content-Range"
 	HdrContentRangeValPrefix = "bytes " // Ref: https://tools.ietf.org/html/rfc7233#section-4.2
 	HdrAcceptRanges          = "Accept-Ranges"
+	HdrIfRange               = "If-Range" // Ref: https://www.rfc-editor.org/rfc/rfc7233#section-3.2
 
 	// content length & type
 	HdrContentType        = "Content-Type"
 	HdrContentTypeOptions = "X-Content-Type-Options"
 	HdrContentLength      = "Content-Length"
+	HdrContentEncoding    = "Content-Encoding"
+	HdrContentMD5         = "Content-MD5" // base64-encoded MD5 of the body (RFC 1864)
 
 	// misc. gen
 	HdrUserAgent = "User-Agent"
@@ -43,6 +46,10 @@ const (
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 
+	// conditional GET (see cmn.MatchETag)
+	HdrIfMatch     = "If-Match"
+	HdrIfNoneMatch = "If-None-Match"
+
 	HdrHSTS = "Strict-Transport-Security"
 
 	HdrLastModified = "Last-Modified" // RFC1123GMT or, same, http.TimeFormat ("Mon, 02 Jan 2006 15:04:05 GMT")
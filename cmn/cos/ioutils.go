@@ -151,6 +151,23 @@ eret:
 	return 0, nil, err
 }
 
+// MoveAcross moves src to dst when the two are on different filesystems (and `Rename` fails
+// with EXDEV): copies src to tmp - a temporary file on the destination's filesystem - fsyncs,
+// and renames tmp => dst. This way, dst is never observed partially written; src gets removed
+// only once dst is in place.
+func MoveAcross(src, dst, tmp string, buf []byte) error {
+	if _, _, err := CopyFile(src, tmp, buf, ChecksumNone); err != nil {
+		return err
+	}
+	if err := Rename(tmp, dst); err != nil {
+		if nerr := RemoveFile(tmp); nerr != nil {
+			nlog.Errorln("move-across: nested err: [", nerr, "]")
+		}
+		return err
+	}
+	return RemoveFile(src)
+}
+
 // Saves the `reader` directly to `fqn`, checksums if requested
 func SaveReader(fqn string, reader io.Reader, buf []byte, cksumType string, size int64) (*CksumHash, error) {
 	wfh, erc := CreateFile(fqn)
@@ -0,0 +1,53 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestMoveAcross(t *testing.T) {
+	var (
+		srcDir = t.TempDir()
+		dstDir = t.TempDir()
+		data   = bytes.Repeat([]byte("0123456789abcdef"), 64*1024+1)
+		buf    = make([]byte, 32*1024)
+	)
+	// when available, a different filesystem for the source (e.g., tmpfs)
+	if dir, err := os.MkdirTemp("/dev/shm", "xdev"); err == nil {
+		srcDir = dir
+		t.Cleanup(func() { os.RemoveAll(dir) })
+	}
+	var (
+		src = filepath.Join(srcDir, "work")
+		dst = filepath.Join(dstDir, "bck", "obj")
+		tmp = filepath.Join(dstDir, "tmp")
+	)
+	tassert.CheckFatal(t, os.WriteFile(src, data, 0o644))
+
+	err := &os.LinkError{Op: "rename", Old: src, New: dst, Err: syscall.EXDEV}
+	tassert.Errorf(t, cos.IsErrXdev(err), "expected EXDEV: %v", err)
+	tassert.Errorf(t, !cos.IsErrXdev(os.ErrNotExist), "unexpected EXDEV")
+
+	tassert.CheckFatal(t, cos.MoveAcross(src, dst, tmp, buf))
+	got, e := os.ReadFile(dst)
+	tassert.CheckFatal(t, e)
+	tassert.Errorf(t, bytes.Equal(got, data), "content mismatch: %d vs %d bytes", len(got), len(data))
+	tassert.Errorf(t, cos.Stat(src) != nil, "expected %s removed", src)
+	tassert.Errorf(t, cos.Stat(tmp) != nil, "expected %s removed", tmp)
+
+	// failure to copy leaves the destination intact
+	tassert.Errorf(t, cos.MoveAcross(src, dst, tmp, buf) != nil, "expected error (no source)")
+	got, e = os.ReadFile(dst)
+	tassert.CheckFatal(t, e)
+	tassert.Errorf(t, bytes.Equal(got, data), "expected destination intact")
+}
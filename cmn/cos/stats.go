@@ -24,6 +24,7 @@ type (
 		Get(name string) int64
 		AddWith(namedVal64 ...NamedVal64)
 		IncWith(name string, VarLabs map[string]string)
+		DelWith(name string, VarLabs map[string]string) // remove labeled series, if any (e.g., upon job removal)
 	}
 	NamedVal64 struct {
 		VarLabs map[string]string
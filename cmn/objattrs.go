@@ -36,6 +36,9 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// downloader: fallback link (mirror) that served the content, if not the object's own link
+	MirrorObjMD = "mirror"
+
 	// RFC3339; see also: cos.HdrLastModified formatted RFC1123GMT
 	LsoLastModified = "LastModified"
 
@@ -64,6 +67,24 @@ type ObjectProps struct {
 	Present bool `json:"present"`
 }
 
+// object placement: all targets sorted by their respective HRW scores for a given object,
+// and whether each one of them actually stores the object (see `apc.WhatObjLocate`)
+type (
+	ObjLocation struct {
+		Bck     Bck            `json:"bucket"`
+		Name    string         `json:"name"`
+		Targets []ObjLocTarget `json:"targets"` // in descending HRW-score order
+	}
+	ObjLocTarget struct {
+		ID       string `json:"id"`
+		Location string `json:"location,omitempty"` // when present (compare with `ObjectProps.Location`)
+		Score    uint64 `json:"score,string"`
+		Owner    bool   `json:"owner"`           // selected by HRW (and therefore, expected to store the object)
+		Present  bool   `json:"present"`         // physically present
+		Maint    bool   `json:"maint,omitempty"` // in maintenance mode or being decommissioned
+	}
+)
+
 // see also apc.HdrObjAtime et al. @ api/apc/const.go (and note that naming must be consistent)
 type ObjAttrs struct {
 	Cksum    *cos.Cksum `json:"checksum,omitempty"`  // object checksum (cloned)
@@ -216,6 +237,36 @@ func ToHeader(oah cos.OAH, hdr http.Header, size int64, cksums ...*cos.Cksum) {
 	}
 }
 
+// ETag to match conditional requests against (see MatchETag):
+// the remote ETag, if present, or else the object's checksum value (unquoted, possibly empty)
+func ObjETag(oah cos.OAH) string {
+	if v, ok := oah.GetCustomKey(ETag); ok && v != "" {
+		return UnquoteCEV(v)
+	}
+	if cksum := oah.Checksum(); !cksum.IsEmpty() {
+		return cksum.Val()
+	}
+	return ""
+}
+
+// reports whether `If-Match` or `If-None-Match` header value (a comma-separated list of
+// quoted, possibly weak, entity tags - or "*") matches the given one
+func MatchETag(hval, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	for _, tag := range strings.Split(hval, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if UnquoteCEV(strings.TrimPrefix(tag, "W/")) == etag {
+			return true
+		}
+	}
+	return false
+}
+
 // NOTE: returning checksum separately for subsequent validation
 func (oa *ObjAttrs) FromHeader(hdr http.Header) (cksum *cos.Cksum) {
 	if ty := hdr.Get(apc.HdrObjCksumType); ty != "" {
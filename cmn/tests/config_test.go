@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +74,19 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestDownloaderMaxRetries(t *testing.T) {
+	tests := []struct{ maxRetries, expected int }{
+		{0, cmn.DfltDloadMaxRetries},
+		{cmn.NoDloadRetries, 0},
+		{5, 5},
+	}
+	for _, test := range tests {
+		c := cmn.DownloaderConf{Timeout: cos.Duration(time.Minute), MaxRetries: test.maxRetries}
+		tassert.CheckFatal(t, c.Validate())
+		tassert.Errorf(t, c.MaxRetryCount() == test.expected,
+			"max_retries=%d: expected %d, got %d", test.maxRetries, test.expected, c.MaxRetryCount())
+	}
+	c := cmn.DownloaderConf{Timeout: cos.Duration(time.Minute), MaxRetries: cmn.NoDloadRetries - 1}
+	tassert.Errorf(t, c.Validate() != nil, "expected max_retries=%d to fail validation", c.MaxRetries)
+}
@@ -86,3 +86,28 @@ func TestMatchRESTItems(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchETag(t *testing.T) {
+	const etag = "abc123"
+	tests := []struct {
+		hval  string
+		match bool
+	}{
+		{`"abc123"`, true},
+		{`abc123`, true},
+		{`W/"abc123"`, true},
+		{`"xyz", "abc123"`, true},
+		{`*`, true},
+		{`"xyz"`, false},
+		{`"abc"`, false},
+		{``, false},
+	}
+	for _, test := range tests {
+		if cmn.MatchETag(test.hval, etag) != test.match {
+			t.Errorf("%q: expected match=%t", test.hval, test.match)
+		}
+	}
+	if cmn.MatchETag("*", "") {
+		t.Error("expected no match with an empty ETag")
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
 )
 
 const (
@@ -244,12 +245,31 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 			lom.PopFntl(saved)
 		}
 		return err
+	case cos.IsErrXdev(err):
+		// workfile on a different filesystem (e.g., remapped or reattached mountpath) -
+		// copy and fsync on the destination side, and then rename
+		return lom._xdevFinalize(wfqn)
 	default:
 		T.FSHC(err, lom.Mountpath(), wfqn)
 		return cmn.NewErrFailedTo(T, "finalize", lom.Cname(), err)
 	}
 }
 
+func (lom *LOM) _xdevFinalize(wfqn string) error {
+	var (
+		tmp       = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileXdev)
+		buf, slab = g.pmm.Alloc()
+	)
+	err := cos.MoveAcross(wfqn, lom.FQN, tmp, buf)
+	slab.Free(buf)
+	if err != nil {
+		T.FSHC(err, lom.Mountpath(), tmp)
+		return cmn.NewErrFailedTo(T, "finalize (cross-fs)", lom.Cname(), err)
+	}
+	nlog.Warningln("finalized", lom.Cname(), "across filesystems: [", wfqn, "=>", lom.FQN, "]")
+	return nil
+}
+
 // extract a single file from a (.tar, .tgz or .tar.gz, .zip, .tar.lz4) shard
 // uses the provided `mime` or lom.ObjName to detect formatting (empty = auto-detect)
 func (lom *LOM) NewArchpathReader(lmfh cos.LomReader, archpath, mime string) (csl cos.ReadCloseSizer, err error) {
@@ -6,6 +6,9 @@ package meta
 
 import (
 	"fmt"
+	"iter"
+	"math"
+	"sort"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -20,15 +23,90 @@ import (
 // aka highest random weight (HRW)
 // See also: fs/hrw.go
 
+type HrwHash struct {
+	Digest func(b []byte) uint64 // name => digest
+	Score  func(uint64) uint64   // (node digest ^ name digest) => random weight
+}
+
+// production defaults: xxhash and xoshiro256, respectively
+var hrwHash = HrwHash{Digest: hrwDigest, Score: xoshiro256.Hash}
+
+func hrwDigest(b []byte) uint64 { return onexxh.Checksum64S(b, cos.MLCG32) }
+
+// SetHrwHash substitutes HRW hashing (nil fields retain the defaults) and returns
+// the previous one, to restore. Unit tests only - not thread-safe.
+func SetHrwHash(h HrwHash) (prev HrwHash) {
+	prev = hrwHash
+	if h.Digest == nil {
+		h.Digest = hrwDigest
+	}
+	if h.Score == nil {
+		h.Score = xoshiro256.Hash
+	}
+	hrwHash = h
+	return prev
+}
+
 func (smap *Smap) HrwName2T(uname []byte) (*Snode, error) {
-	digest := onexxh.Checksum64S(uname, cos.MLCG32)
+	digest := hrwHash.Digest(uname)
 	return smap.HrwHash2T(digest)
 }
 
+// HrwName2TSalted selects a target on an alternate HRW "ring" derived from the same Smap:
+// the name digest is seeded with the (non-zero) salt, and so distinct salts produce
+// independent placements - e.g., for a given name the probability that two rings select
+// the same target is 1/N (N targets), same as for two unrelated names.
+// Use case: uncorrelated "data" and "parity" (or tier-1 and tier-2) target selections.
+// Zero salt is the default ring (same as HrwName2T).
+// Unless `skipMaint` is false, targets in maintenance (or being decommissioned) are skipped
+// (compare HrwHash2T vs HrwHash2Tall).
+func (smap *Smap) HrwName2TSalted(uname []byte, salt uint64, skipMaint bool) (*Snode, error) {
+	digest := HrwDigestSalted(uname, salt)
+	if skipMaint {
+		return smap.HrwHash2T(digest)
+	}
+	return smap.HrwHash2Tall(digest)
+}
+
+// name => digest on the (salt-selected) alternate ring; see HrwName2TSalted
+func HrwDigestSalted(uname []byte, salt uint64) uint64 {
+	if salt == 0 {
+		return hrwHash.Digest(uname)
+	}
+	return onexxh.Checksum64S(uname, cos.MLCG32^salt)
+}
+
+// MpathHrw selects one of the given mountpaths for the given name: same xxhash digests and
+// xoshiro256 scoring as the target's own selection - see fs.Hrw and `fs.Mountpath.PathDigest` -
+// and therefore, given the same (cleaned) mountpaths, the same result. Unlike fs.Hrw, does not
+// require the mountpaths to exist locally, e.g.: to predict (or verify) placement within a
+// given target, to plan mountpath changes, or to test.
+func MpathHrw(uname []byte, mpaths []string) (string, error) {
+	var (
+		maxH   uint64
+		mpath  string
+		digest = hrwHash.Digest(uname)
+	)
+	for _, mp := range mpaths {
+		cs := hrwHash.Score(HrwMpathDigest(mp) ^ digest)
+		if cs >= maxH {
+			maxH = cs
+			mpath = mp
+		}
+	}
+	if mpath == "" {
+		return "", cmn.ErrNoMountpaths
+	}
+	return mpath, nil
+}
+
+// mountpath => digest (compare w/ `Snode.digest()`)
+func HrwMpathDigest(mpath string) uint64 { return hrwDigest(cos.UnsafeB(mpath)) }
+
 // TODO: control plane multihoming: return LRU data plane interface
 
 func (smap *Smap) HrwMultiHome(uname []byte) (si *Snode, netName string, err error) {
-	digest := onexxh.Checksum64S(uname, cos.MLCG32)
+	digest := hrwHash.Digest(uname)
 	si, err = smap.HrwHash2T(digest)
 	if err != nil {
 		return nil, cmn.NetPublic, err
@@ -44,7 +122,7 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 		if tsi.InMaintOrDecomm() { // always skipping targets 'in maintenance mode'
 			continue
 		}
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := hrwHash.Score(tsi.digest() ^ digest)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -56,11 +134,97 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 	return si, err
 }
 
+// HrwName2TWeighted is a weighted variant of HrwName2T for clusters with heterogeneous
+// hardware. Each target's random weight is scaled by its static administrative weight
+// (`Snode.Weight`) using the logarithmic method (Schindelhauer and Schomaker,
+// "Weighted Distributed Hash Tables"):
+//
+//	score = weight / -ln(u), where u = (hash + 0.5) / 2^64 is uniformly distributed in (0, 1)
+//
+// Properties:
+//   - deterministic: given the same Smap, a name always maps to the same target;
+//   - proportional: on average, target `i` receives weight(i)/sum(weights) of all names;
+//   - minimally disruptive: adding, removing, or re-weighting a target only moves names
+//     to or from that target;
+//   - with all weights equal, the selection is the same as HrwName2T.
+func (smap *Smap) HrwName2TWeighted(uname []byte) (si *Snode, err error) {
+	var (
+		maxS   = -1.0
+		digest = hrwHash.Digest(uname)
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		cs := hrwHash.Score(tsi.digest() ^ digest)
+		if s := hrwScaled(cs, tsi.HrwWeight()); s >= maxS {
+			maxS = s
+			si = tsi
+		}
+	}
+	if si == nil {
+		err = cmn.NewErrNoNodes(apc.Target, len(smap.Tmap))
+	}
+	return si, err
+}
+
+func hrwScaled(cs uint64, weight float64) float64 {
+	const two64 = 1 << 64
+	u := (float64(cs) + 0.5) / two64
+	if u >= 1 {
+		u = math.Nextafter(1, 0) // (float64 rounding)
+	}
+	return weight / -math.Log(u)
+}
+
+// HrwOwnerDiff: ownership changes between two cluster maps, as per a given sample of names
+// (see `HrwOwnerChanges`)
+type HrwOwnerDiff struct {
+	Net   map[string]int // [target ID => net gain (positive) or loss (negative)]
+	Moved []string       // sampled names that would change owners
+	Total int            // sample size
+}
+
+// HrwOwnerChanges previews the shape and cost of the rebalance that'd follow
+// updating the cluster map `from` => `to` (e.g., adding or removing targets):
+// for each sampled uname, compares the respective `HrwName2T` owners.
+// Note that targets in maintenance mode own nothing (ditto).
+func HrwOwnerChanges(from, to *Smap, unames iter.Seq[string]) (*HrwOwnerDiff, error) {
+	diff := &HrwOwnerDiff{Net: make(map[string]int, max(len(from.Tmap), len(to.Tmap)))}
+	for uname := range unames {
+		digest := hrwHash.Digest(cos.UnsafeB(uname))
+		src, err := from.HrwHash2T(digest)
+		if err != nil {
+			return nil, err
+		}
+		dst, err := to.HrwHash2T(digest)
+		if err != nil {
+			return nil, err
+		}
+		diff.Total++
+		if src.ID() == dst.ID() {
+			continue
+		}
+		diff.Moved = append(diff.Moved, uname)
+		diff.Net[src.ID()]--
+		diff.Net[dst.ID()]++
+	}
+	return diff, nil
+}
+
+// fraction of the sampled names that would change owners
+func (diff *HrwOwnerDiff) Fraction() float64 {
+	if diff.Total == 0 {
+		return 0
+	}
+	return float64(len(diff.Moved)) / float64(diff.Total)
+}
+
 // NOTE: including targets 'in maintenance mode', if any
 func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
 	var maxH uint64
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := hrwHash.Score(tsi.digest() ^ digest)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -75,13 +239,7 @@ func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
 func (smap *Smap) HrwProxy(idToSkip string) (pi *Snode, err error) {
 	var maxH uint64
 	for pid, psi := range smap.Pmap {
-		if pid == idToSkip {
-			continue
-		}
-		if psi.Flags.IsSet(SnodeNonElectable) {
-			continue
-		}
-		if psi.InMaintOrDecomm() {
+		if !_electable(pid, psi, idToSkip) {
 			continue
 		}
 		if d := psi.digest(); d >= maxH {
@@ -95,16 +253,43 @@ func (smap *Smap) HrwProxy(idToSkip string) (pi *Snode, err error) {
 	return pi, err
 }
 
+// HrwProxyRank returns electable proxies in the order HrwProxy would select them
+// (i.e., the order of succession upon successive primary failures)
+func (smap *Smap) HrwProxyRank(idToSkip string) (Nodes, error) {
+	pis := make(Nodes, 0, len(smap.Pmap))
+	for pid, psi := range smap.Pmap {
+		if _electable(pid, psi, idToSkip) {
+			pis = append(pis, psi)
+		}
+	}
+	if len(pis) == 0 {
+		return nil, cmn.NewErrNoNodes(apc.Proxy, len(smap.Pmap))
+	}
+	sort.Slice(pis, func(i, j int) bool {
+		di, dj := pis[i].digest(), pis[j].digest()
+		if di != dj {
+			return di > dj
+		}
+		return pis[i].ID() < pis[j].ID()
+	})
+	return pis, nil
+}
+
+// (HrwProxy and HrwProxyRank)
+func _electable(pid string, psi *Snode, idToSkip string) bool {
+	return pid != idToSkip && !psi.Flags.IsSet(SnodeNonElectable) && !psi.InMaintOrDecomm()
+}
+
 func (smap *Smap) HrwIC(uuid string) (pi *Snode, err error) {
 	var (
 		maxH   uint64
-		digest = onexxh.Checksum64S(cos.UnsafeB(uuid), cos.MLCG32)
+		digest = hrwHash.Digest(cos.UnsafeB(uuid))
 	)
 	for _, psi := range smap.Pmap {
 		if psi.InMaintOrDecomm() || !psi.IsIC() {
 			continue
 		}
-		cs := xoshiro256.Hash(psi.digest() ^ digest)
+		cs := hrwHash.Score(psi.digest() ^ digest)
 		if cs >= maxH {
 			maxH = cs
 			pi = psi
@@ -121,13 +306,13 @@ func (smap *Smap) HrwIC(uuid string) (pi *Snode, err error) {
 func (smap *Smap) HrwTargetTask(uuid string) (si *Snode, err error) {
 	var (
 		maxH   uint64
-		digest = onexxh.Checksum64S(cos.UnsafeB(uuid), cos.MLCG32)
+		digest = hrwHash.Digest(cos.UnsafeB(uuid))
 	)
 	for _, tsi := range smap.Tmap {
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := hrwHash.Score(tsi.digest() ^ digest)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -162,11 +347,11 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 		return
 	}
 	b := cos.UnsafeBptr(uname)
-	digest := onexxh.Checksum64S(*b, cos.MLCG32)
+	digest := hrwHash.Digest(*b)
 	hlist := newHrwList(count)
 
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := hrwHash.Score(tsi.digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
@@ -180,6 +365,62 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	return sis, nil
 }
 
+// HrwTargetIter yields targets in descending HRW order for a given name, one at a time,
+// so that the caller can stop early - e.g., upon finding a target that satisfies a given predicate.
+// Same order as HrwTargetList (with the first target being the one selected by HrwName2T), and
+// likewise skipping targets in maintenance - but without requiring the count upfront and without
+// sorting: each next target is selected on demand. Equal scores (if any) are ordered by node ID.
+func (smap *Smap) HrwTargetIter(uname []byte) iter.Seq[*Snode] {
+	digest := hrwHash.Digest(uname)
+	return func(yield func(*Snode) bool) {
+		scored := make([]HrwScored, 0, len(smap.Tmap))
+		for _, tsi := range smap.Tmap {
+			if tsi.InMaintOrDecomm() {
+				continue
+			}
+			scored = append(scored, HrwScored{Node: tsi, Score: hrwHash.Score(tsi.digest() ^ digest)})
+		}
+		for n := len(scored); n > 0; n-- {
+			best := 0
+			for i := 1; i < n; i++ {
+				s, b := &scored[i], &scored[best]
+				if s.Score > b.Score || (s.Score == b.Score && s.Node.ID() < b.Node.ID()) {
+					best = i
+				}
+			}
+			if !yield(scored[best].Node) {
+				return
+			}
+			scored[best] = scored[n-1]
+		}
+	}
+}
+
+// HrwScored is a target and its HRW score for a given name.
+type HrwScored struct {
+	Node  *Snode
+	Score uint64
+}
+
+// Returns all targets, including those in maintenance, sorted by their respective
+// HRW scores for the given name in descending order.
+// Non-maintenance target with the highest score is the one selected by HrwName2T.
+// (used for debugging and troubleshooting - see `apc.WhatObjLocate`)
+func (smap *Smap) HrwTargetScores(uname []byte) []HrwScored {
+	var (
+		digest = hrwHash.Digest(uname)
+		hlist  = newHrwList(len(smap.Tmap))
+	)
+	for _, tsi := range smap.Tmap {
+		hlist.add(hrwHash.Score(tsi.digest()^digest), tsi)
+	}
+	out := make([]HrwScored, len(hlist.sis))
+	for i, tsi := range hlist.sis {
+		out[i] = HrwScored{Node: tsi, Score: hlist.hs[i]}
+	}
+	return out
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
@@ -0,0 +1,448 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/xoshiro256"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func newTestSmap(weights ...uint32) *meta.Smap {
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, len(weights)), Pmap: make(meta.NodeMap)}
+	for i, w := range weights {
+		si := &meta.Snode{Weight: w}
+		si.Init(fmt.Sprintf("t%03d", i), apc.Target)
+		smap.Tmap.Add(si)
+	}
+	return smap
+}
+
+var _ = Describe("HRW", func() {
+	const numNames = 40_000
+
+	Describe("HrwName2TWeighted", func() {
+		It("should select the same target as HrwName2T when weights are equal", func() {
+			smap := newTestSmap(0, 0, 0, 0, 0)
+			for i := range 1000 {
+				uname := []byte(fmt.Sprintf("bck/obj-%d", i))
+				si, err := smap.HrwName2T(uname)
+				Expect(err).NotTo(HaveOccurred())
+				wsi, err := smap.HrwName2TWeighted(uname)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(wsi.ID()).To(Equal(si.ID()))
+			}
+		})
+
+		It("should distribute names in proportion to weights", func() {
+			var (
+				smap   = newTestSmap(1, 2, 1)
+				counts = make(map[string]int, 3)
+			)
+			for i := range numNames {
+				si, err := smap.HrwName2TWeighted([]byte(fmt.Sprintf("bck/obj-%d", i)))
+				Expect(err).NotTo(HaveOccurred())
+				counts[si.ID()]++
+			}
+			Expect(float64(counts["t000"]) / numNames).To(BeNumerically("~", 0.25, 0.02))
+			Expect(float64(counts["t001"]) / numNames).To(BeNumerically("~", 0.5, 0.02))
+			Expect(float64(counts["t002"]) / numNames).To(BeNumerically("~", 0.25, 0.02))
+		})
+
+		It("should shift placement to a joining node with non-default weight", func() {
+			// the weight comes with the node's local config (`weight`) and travels
+			// as part of its Snode in the join request
+			var (
+				smap   = newTestSmap(0, 0, 0, 0)
+				before = make(map[string]int, 4)
+				after  = make(map[string]int, 4)
+			)
+			for i := range numNames {
+				si, err := smap.HrwName2TWeighted([]byte(fmt.Sprintf("bck/obj-%d", i)))
+				Expect(err).NotTo(HaveOccurred())
+				before[si.ID()]++
+			}
+
+			nsi := smap.Tmap["t003"].Clone()
+			nsi.Weight = 4
+			Expect(nsi.Validate()).NotTo(HaveOccurred())
+			b := cos.MustMarshal(nsi)
+			joined := &meta.Snode{}
+			Expect(jsoniter.Unmarshal(b, joined)).NotTo(HaveOccurred())
+			Expect(joined.Weight).To(Equal(uint32(4)))
+			smap.Tmap["t003"] = joined
+
+			for i := range numNames {
+				si, err := smap.HrwName2TWeighted([]byte(fmt.Sprintf("bck/obj-%d", i)))
+				Expect(err).NotTo(HaveOccurred())
+				after[si.ID()]++
+			}
+			Expect(float64(before["t003"]) / numNames).To(BeNumerically("~", 0.25, 0.02))
+			Expect(float64(after["t003"]) / numNames).To(BeNumerically("~", 4.0/7, 0.02))
+			for _, id := range []string{"t000", "t001", "t002"} {
+				Expect(after[id]).To(BeNumerically("<", before[id]))
+			}
+
+			nsi.Weight = cmn.MaxNodeWeight + 1
+			Expect(nsi.Validate()).To(HaveOccurred())
+		})
+
+		It("should be deterministic", func() {
+			smap := newTestSmap(3, 1, 7)
+			uname := []byte("bck/some/object")
+			si, err := smap.HrwName2TWeighted(uname)
+			Expect(err).NotTo(HaveOccurred())
+			for range 10 {
+				again, err := smap.HrwName2TWeighted(uname)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(again.ID()).To(Equal(si.ID()))
+			}
+		})
+	})
+
+	Describe("HrwName2TSalted", func() {
+		It("should select the same target as HrwName2T when not salted", func() {
+			smap := newTestSmap(0, 0, 0, 0, 0)
+			for i := range 1000 {
+				uname := []byte(fmt.Sprintf("bck/obj-%d", i))
+				si, err := smap.HrwName2T(uname)
+				Expect(err).NotTo(HaveOccurred())
+				ssi, err := smap.HrwName2TSalted(uname, 0, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ssi.ID()).To(Equal(si.ID()))
+			}
+		})
+
+		It("should yield uncorrelated placements for distinct salts", func() {
+			const numTargets = 5
+			var (
+				smap = newTestSmap(make([]uint32, numTargets)...)
+				same = make(map[uint64]int, 2)
+			)
+			for i := range numNames {
+				uname := []byte(fmt.Sprintf("bck/obj-%d", i))
+				si, err := smap.HrwName2TSalted(uname, 0, true)
+				Expect(err).NotTo(HaveOccurred())
+				for _, salt := range []uint64{1, 0xdeadbeef} {
+					ssi, err := smap.HrwName2TSalted(uname, salt, true)
+					Expect(err).NotTo(HaveOccurred())
+					if ssi.ID() == si.ID() {
+						same[salt]++
+					}
+				}
+			}
+			// independent rings agree by chance, i.e., 1/N of the time
+			for _, cnt := range same {
+				Expect(float64(cnt) / numNames).To(BeNumerically("~", 1.0/numTargets, 0.02))
+			}
+		})
+
+		It("should be deterministic", func() {
+			smap := newTestSmap(0, 0, 0)
+			uname := []byte("bck/some/object")
+			si, err := smap.HrwName2TSalted(uname, 42, true)
+			Expect(err).NotTo(HaveOccurred())
+			for range 10 {
+				again, err := smap.HrwName2TSalted(uname, 42, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(again.ID()).To(Equal(si.ID()))
+			}
+		})
+	})
+
+	Describe("MpathHrw", func() {
+		mpaths := []string{"/ais/mp1", "/ais/mp2", "/ais/mp3", "/ais/mp4", "/ais/mp5"}
+
+		It("should distribute names evenly across mountpaths", func() {
+			cnt := make(map[string]int, len(mpaths))
+			for i := range numNames {
+				mpath, err := meta.MpathHrw([]byte(fmt.Sprintf("bck/obj-%d", i)), mpaths)
+				Expect(err).NotTo(HaveOccurred())
+				cnt[mpath]++
+			}
+			Expect(cnt).To(HaveLen(len(mpaths)))
+			for _, n := range cnt {
+				Expect(float64(n) / numNames).To(BeNumerically("~", 1.0/float64(len(mpaths)), 0.02))
+			}
+		})
+
+		It("should select the same mountpath as the target (fs.Hrw)", func() {
+			for i := range 1000 {
+				uname := []byte(fmt.Sprintf("bck/obj-%d", i))
+				mpath, err := meta.MpathHrw(uname, mpaths)
+				Expect(err).NotTo(HaveOccurred())
+
+				// see fs/hrw.go
+				var (
+					maxH     uint64
+					expected string
+					digest   = onexxh.Checksum64S(uname, cos.MLCG32)
+				)
+				for _, mp := range mpaths {
+					pathDigest := onexxh.Checksum64S(cos.UnsafeB(mp), cos.MLCG32)
+					if cs := xoshiro256.Hash(pathDigest ^ digest); cs >= maxH {
+						maxH, expected = cs, mp
+					}
+				}
+				Expect(mpath).To(Equal(expected))
+			}
+		})
+
+		It("should only move names from the removed mountpath", func() {
+			var (
+				removed = mpaths[2]
+				fewer   = append(append([]string{}, mpaths[:2]...), mpaths[3:]...)
+			)
+			for i := range 10_000 {
+				uname := []byte(fmt.Sprintf("bck/obj-%d", i))
+				before, err := meta.MpathHrw(uname, mpaths)
+				Expect(err).NotTo(HaveOccurred())
+				after, err := meta.MpathHrw(uname, fewer)
+				Expect(err).NotTo(HaveOccurred())
+				if before != removed {
+					Expect(after).To(Equal(before))
+				}
+			}
+		})
+
+		It("should fail when there are no mountpaths", func() {
+			_, err := meta.MpathHrw([]byte("bck/obj"), nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("HrwProxyRank", func() {
+		newProxySmap := func(num int) *meta.Smap {
+			smap := &meta.Smap{Tmap: make(meta.NodeMap), Pmap: make(meta.NodeMap, num)}
+			for i := range num {
+				pi := &meta.Snode{}
+				pi.Init(fmt.Sprintf("p%03d", i), apc.Proxy)
+				smap.Pmap.Add(pi)
+			}
+			return smap
+		}
+
+		It("should rank proxies in the order of succession", func() {
+			smap := newProxySmap(8)
+			rank, err := smap.HrwProxyRank("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rank).To(HaveLen(8))
+
+			// each next one is who HrwProxy selects once all the preceding ones are gone
+			for i, pi := range rank {
+				clone := newProxySmap(0)
+				for _, psi := range rank[i:] {
+					clone.Pmap.Add(psi)
+				}
+				next, err := clone.HrwProxy("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next.ID()).To(Equal(pi.ID()))
+			}
+		})
+
+		It("should skip the same proxies HrwProxy skips", func() {
+			smap := newProxySmap(6)
+			rank, err := smap.HrwProxyRank("")
+			Expect(err).NotTo(HaveOccurred())
+			var (
+				skip   = rank[0].ID()
+				nonEl  = rank[1]
+				maint  = rank[2]
+				others = rank[3:]
+			)
+			nonEl.Flags = nonEl.Flags.Set(meta.SnodeNonElectable)
+			maint.Flags = maint.Flags.Set(meta.SnodeMaint)
+
+			rank, err = smap.HrwProxyRank(skip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rank).To(Equal(others))
+
+			pi, err := smap.HrwProxy(skip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pi.ID()).To(Equal(rank[0].ID()))
+		})
+
+		It("should fail when no proxies are electable", func() {
+			smap := newProxySmap(1)
+			_, err := smap.HrwProxyRank("p000")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("HrwTargetScores", func() {
+		It("should sort all targets by score and agree with HrwName2T", func() {
+			smap := newTestSmap(0, 0, 0, 0, 0, 0)
+			maint := smap.Tmap["t002"]
+			maint.Flags = maint.Flags.Set(meta.SnodeMaint)
+			for i := range 1000 {
+				uname := []byte(fmt.Sprintf("bck/obj-%d", i))
+				scored := smap.HrwTargetScores(uname)
+				Expect(scored).To(HaveLen(len(smap.Tmap)))
+				for j := 1; j < len(scored); j++ {
+					Expect(scored[j-1].Score).To(BeNumerically(">=", scored[j].Score))
+				}
+				si, err := smap.HrwName2T(uname)
+				Expect(err).NotTo(HaveOccurred())
+				for _, s := range scored {
+					if !s.Node.InMaintOrDecomm() {
+						Expect(s.Node.ID()).To(Equal(si.ID()))
+						break
+					}
+				}
+			}
+		})
+	})
+
+	Describe("HrwTargetIter", func() {
+		It("should yield the same targets as HrwTargetList, in the same order", func() {
+			smap := newTestSmap(0, 0, 0, 0, 0, 0)
+			maint := smap.Tmap["t003"]
+			maint.Flags = maint.Flags.Set(meta.SnodeMaint)
+			for i := range 1000 {
+				name := fmt.Sprintf("bck/obj-%d", i)
+				sis, err := smap.HrwTargetList(&name, smap.CountActiveTs())
+				Expect(err).NotTo(HaveOccurred())
+				var ids []string
+				for tsi := range smap.HrwTargetIter([]byte(name)) {
+					Expect(tsi.ID()).NotTo(Equal("t003"))
+					ids = append(ids, tsi.ID())
+				}
+				Expect(ids).To(HaveLen(len(sis)))
+				for j, tsi := range sis {
+					Expect(ids[j]).To(Equal(tsi.ID()))
+				}
+			}
+		})
+
+		It("should stop early", func() {
+			var (
+				smap = newTestSmap(0, 0, 0, 0)
+				cnt  int
+			)
+			for range smap.HrwTargetIter([]byte("bck/obj")) {
+				if cnt++; cnt == 2 {
+					break
+				}
+			}
+			Expect(cnt).To(Equal(2))
+			for range newTestSmap().HrwTargetIter([]byte("bck/obj")) {
+				Fail("expected no targets")
+			}
+		})
+	})
+
+	Describe("HrwOwnerChanges", func() {
+		unames := func(yield func(string) bool) {
+			for i := range numNames {
+				if !yield(fmt.Sprintf("bck/obj-%d", i)) {
+					return
+				}
+			}
+		}
+
+		It("should move names only to the added target", func() {
+			var (
+				from = newTestSmap(0, 0, 0, 0)
+				to   = newTestSmap(0, 0, 0, 0, 0)
+			)
+			diff, err := meta.HrwOwnerChanges(from, to, unames)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Total).To(Equal(numNames))
+			Expect(diff.Fraction()).To(BeNumerically("~", 0.2, 0.02))
+			Expect(diff.Net["t004"]).To(Equal(len(diff.Moved)))
+
+			var net int
+			for id, n := range diff.Net {
+				if id != "t004" {
+					Expect(n).To(BeNumerically("<", 0))
+				}
+				net += n
+			}
+			Expect(net).To(BeZero())
+		})
+
+		It("should report no changes for the same map", func() {
+			smap := newTestSmap(0, 0, 0)
+			diff, err := meta.HrwOwnerChanges(smap, smap, unames)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.Moved).To(BeEmpty())
+			Expect(diff.Fraction()).To(BeZero())
+		})
+
+		It("should fail when there are no targets", func() {
+			_, err := meta.HrwOwnerChanges(newTestSmap(0), newTestSmap(), unames)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("SetHrwHash", func() {
+		// with a constant name digest and identity scorer, node digests become the weights
+		BeforeEach(func() {
+			prev := meta.SetHrwHash(meta.HrwHash{
+				Digest: func([]byte) uint64 { return 0 },
+				Score:  func(h uint64) uint64 { return h },
+			})
+			DeferCleanup(func() { meta.SetHrwHash(prev) })
+		})
+
+		It("should select targets by their (substituted) scores", func() {
+			smap := newTestSmap(1, 1, 1, 1)
+			for id, digest := range map[string]uint64{"t000": 10, "t001": 40, "t002": 30, "t003": 20} {
+				smap.Tmap[id].IDDigest = digest
+			}
+			si, err := smap.HrwName2T([]byte("bck/obj"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(si.ID()).To(Equal("t001"))
+
+			name := "bck/obj"
+			sis, err := smap.HrwTargetList(&name, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(3))
+			Expect([]string{sis[0].ID(), sis[1].ID(), sis[2].ID()}).To(Equal([]string{"t001", "t002", "t003"}))
+
+			// skipping targets in maintenance
+			smap.Tmap["t001"].Flags = smap.Tmap["t001"].Flags.Set(meta.SnodeMaint)
+			si, err = smap.HrwName2T([]byte("bck/obj"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(si.ID()).To(Equal("t002"))
+		})
+
+		It("should break equal scores by weight", func() {
+			smap := newTestSmap(1, 3, 2)
+			for _, tsi := range smap.Tmap {
+				tsi.IDDigest = 1 << 63
+			}
+			si, err := smap.HrwName2TWeighted([]byte("bck/obj"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(si.ID()).To(Equal("t001"))
+		})
+	})
+
+	It("should restore the default hashing", func() {
+		var (
+			smap  = newTestSmap(1, 1, 1, 1, 1, 1, 1, 1)
+			uname = []byte("bck/some/object")
+		)
+		si, err := smap.HrwName2T(uname)
+		Expect(err).NotTo(HaveOccurred())
+
+		prev := meta.SetHrwHash(meta.HrwHash{Score: func(uint64) uint64 { return 0 }})
+		meta.SetHrwHash(prev)
+
+		again, err := smap.HrwName2T(uname)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again.ID()).To(Equal(si.ID()))
+	})
+})
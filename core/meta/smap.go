@@ -80,6 +80,7 @@ type (
 		PubExtra   []NetInfo    `json:"pub_extra,omitempty"`
 		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
 		IDDigest   uint64       `json:"id_digest"`
+		Weight     uint32       `json:"weight,omitempty"` // static administrative weight (see HrwName2TWeighted); zero means 1
 	}
 
 	Nodes   []*Snode          // slice of Snodes
@@ -216,6 +217,9 @@ func (d *Snode) Validate() error {
 	if d.DaeType != apc.Proxy && d.DaeType != apc.Target {
 		cos.Assertf(false, "invalid Snode type %q", d.DaeType)
 	}
+	if d.Weight > cmn.MaxNodeWeight {
+		return fmt.Errorf("invalid Snode %s: weight %d exceeds %d", d.StringEx(), d.Weight, cmn.MaxNodeWeight)
+	}
 	return nil
 }
 
@@ -311,6 +315,14 @@ func (d *Snode) HasURL(rawURL string) bool {
 	return false
 }
 
+// HRW weight; see HrwName2TWeighted
+func (d *Snode) HrwWeight() float64 {
+	if d.Weight == 0 {
+		return 1
+	}
+	return float64(d.Weight)
+}
+
 func (d *Snode) IsProxy() bool  { return d.DaeType == apc.Proxy }
 func (d *Snode) IsTarget() bool { return d.DaeType == apc.Target }
 
@@ -28,6 +28,7 @@ func (*StatsTracker) StartedUp() bool
 func (*StatsTracker) Get(string) int64                                          { return 0 }
 func (*StatsTracker) Inc(string)                                                {}
 func (*StatsTracker) IncWith(string, map[string]string)                         {}
+func (*StatsTracker) DelWith(string, map[string]string)                         {}
 func (*StatsTracker) IncBck(string, *cmn.Bck)                                   {}
 func (*StatsTracker) Add(string, int64)                                         {}
 func (*StatsTracker) SetFlag(string, cos.NodeStateFlags)                        {}
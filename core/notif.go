@@ -27,6 +27,7 @@ import (
 const (
 	UponTerm     = Upon(1 << iota) // success or fail is separately provided via error
 	UponProgress                   // periodic (BytesCount, ObjCount)
+	UponStart                      // once, when actually started (e.g., upon leaving the queue)
 )
 
 type (
@@ -36,6 +37,7 @@ type (
 	Notif interface {
 		OnFinishedCB() func(Notif, error, bool /*aborted*/)
 		OnProgressCB() func(Notif)
+		OnStartedCB() func(Notif)
 		NotifyInterval() time.Duration // notify interval in secs
 		LastNotifTime() int64          // time last notified
 		SetLastNotified(now int64)
@@ -127,6 +127,9 @@ func (xsnap *Snap) Running() bool {
 
 func (xsnap *Snap) Finished() bool { return xsnap.Started() && !xsnap.EndTime.IsZero() }
 
+// implements nl.Progress (the total is unknown)
+func (xsnap *Snap) Progress() (done, total int64) { return xsnap.Stats.Objs, 0 }
+
 // snap.Packed layout:
 //
 // [[ --------- bits 20 through 63 ----------] [--- bits 10 through 19 ---] [------ bits 0 through 9 ------]]
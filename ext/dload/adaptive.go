@@ -0,0 +1,103 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Adaptive ingest (see `DownloaderConf.AdaptiveMax`): the target-wide number of concurrently
+// running download tasks (the "level") follows the node's load. The level is periodically
+// adjusted - halved when busy, incremented when idle - and consulted by all joggers
+// prior to starting their respective next tasks.
+
+const (
+	adaptIval    = 5 * time.Second
+	adaptIdlePct = 50 // below this (ThrottlePct) the level ramps up; at or above `fs.MaxThrottlePct` - backs off
+)
+
+type adaptive struct {
+	wake    chan struct{} // closed (and replaced) upon release or level change
+	level   int
+	running int
+	lo, hi  int
+	mu      sync.Mutex
+}
+
+func newAdaptive(lo, hi int) *adaptive {
+	if hi <= 0 {
+		return nil // disabled
+	}
+	lo = max(lo, 1)
+	return &adaptive{wake: make(chan struct{}), level: hi, lo: lo, hi: hi}
+}
+
+// blocks until the number of running tasks is below the current level or the context is done;
+// returns true if acquired (in which case the caller must release)
+func (a *adaptive) acquire(ctx context.Context) bool {
+	if a == nil {
+		return false
+	}
+	for {
+		a.mu.Lock()
+		if a.running < a.level {
+			a.running++
+			a.mu.Unlock()
+			return true
+		}
+		wake := a.wake
+		a.mu.Unlock()
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+func (a *adaptive) release() {
+	a.mu.Lock()
+	a.running--
+	a._wake()
+	a.mu.Unlock()
+}
+
+func (a *adaptive) _wake() {
+	close(a.wake)
+	a.wake = make(chan struct{})
+}
+
+// given the current load (see `fs.ThrottlePct`), adjusts and returns the level
+func (a *adaptive) adjust(pct int) int {
+	a.mu.Lock()
+	prev := a.level
+	switch {
+	case pct >= fs.MaxThrottlePct:
+		a.level = max(a.level>>1, a.lo)
+	case pct < adaptIdlePct:
+		a.level = min(a.level+1, a.hi)
+	}
+	if a.level > prev {
+		a._wake()
+	}
+	level := a.level
+	a.mu.Unlock()
+	return level
+}
+
+// returns zero when disabled
+func (a *adaptive) get() int {
+	if a == nil {
+		return 0
+	}
+	a.mu.Lock()
+	level := a.level
+	a.mu.Unlock()
+	return level
+}
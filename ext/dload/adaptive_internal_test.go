@@ -0,0 +1,56 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAdaptive(t *testing.T) {
+	var none *adaptive
+	tassert.Errorf(t, newAdaptive(2, 0) == nil, "expected disabled")
+	tassert.Errorf(t, !none.acquire(context.Background()) && none.get() == 0, "expected no-op when disabled")
+
+	a := newAdaptive(0, 8)
+	tassert.Fatalf(t, a.get() == 8, "expected to start at max, got %d", a.get())
+
+	// back off when busy (but not below min), ramp up when idle (but not above max)
+	for _, expected := range []int{4, 2, 1, 1} {
+		level := a.adjust(fs.MaxThrottlePct)
+		tassert.Errorf(t, level == expected, "busy: expected %d, got %d", expected, level)
+	}
+	tassert.Errorf(t, a.adjust(adaptIdlePct) == 1, "expected no change in between")
+	tassert.Errorf(t, a.adjust(0) == 2, "idle: expected to ramp up")
+	for range 10 {
+		a.adjust(0)
+	}
+	tassert.Errorf(t, a.get() == 8, "expected max, got %d", a.get())
+
+	// at the level: blocks until released, level raised, or context done
+	a.adjust(100)
+	a.adjust(100)
+	a.adjust(100) // 1
+	tassert.Fatalf(t, a.acquire(context.Background()), "expected to acquire")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	tassert.Errorf(t, !a.acquire(ctx), "expected to time out at the level")
+	cancel()
+
+	acquired := make(chan bool)
+	go func() { acquired <- a.acquire(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	a.adjust(0) // 2
+	tassert.Errorf(t, <-acquired, "expected to acquire upon ramp-up")
+
+	go func() { acquired <- a.acquire(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	a.release()
+	tassert.Errorf(t, <-acquired, "expected to acquire upon release")
+}
@@ -9,11 +9,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -34,6 +37,93 @@ const PrefixJobID = "dnl-"
 
 const DownloadProgressInterval = 10 * time.Second
 
+// max number of remote buckets per (multi-bucket) backend download job
+const maxBackendBuckets = 64
+
+// response headers recorded with `Base.CaptureHeaders` (the set is fixed)
+var CapturedHeaders = [...]string{
+	cos.HdrContentType,
+	cos.HdrContentLength,
+	cos.HdrETag,
+	cos.HdrLastModified,
+	cos.HdrContentEncoding,
+}
+
+// HEAD requests (see `Base.HeadMode`)
+const (
+	// pre-flight: HEAD each link to record its reachability and size (`TaskDlInfo.Total`) - without
+	// downloading anything; unreachable links are reported as download errors
+	HeadModeOnly = "only"
+)
+
+// user-defined metadata (see `Base.Metadata`)
+const (
+	maxMetadataKeys   = 64
+	maxMetadataKeyLen = 128
+	maxMetadataValLen = 1024
+)
+
+// max fallback links per object (see `MultiBody.ObjMirrors`)
+const maxObjMirrors = 8
+
+// job priority (see `Base.Priority`): [-MaxPriority, MaxPriority], zero by default
+const MaxPriority = 100
+
+// content validators (see `Base.Validator`)
+const (
+	// reject HTML (e.g., error or login pages served with status 200), as sniffed from the first 512 bytes
+	ValidatorNotHTML = "not-html"
+	// reject anything other than a (sequence of) well-formed JSON value(s), e.g. JSON Lines
+	ValidatorJSON = "json"
+)
+
+// content post-processors (see `Base.PostProcess`)
+const (
+	// convert Windows (CRLF) line endings to Unix (LF)
+	PostProcNormalizeEOL = "normalize-eol"
+)
+
+// when some of the targets fail to start a job (see `Base.OnPartial`)
+const (
+	PartialFailFast = "fail-fast"      // fail the submission (default)
+	PartialAccept   = "accept-partial" // run the job on the targets that started it; record the rest as errors
+)
+
+// per-object state (see `ObjState`)
+const (
+	ObjPending  = "pending"  // dispatched to (and waiting in) the mountpath queue
+	ObjRunning  = "running"  // being downloaded
+	ObjFinished = "finished" // downloaded (or skipped)
+	ObjFailed   = "failed"
+	ObjAborted  = "aborted" // job aborted, timed out, or the downloader stopped
+)
+
+// why a given object didn't complete (see `TaskErrInfo.Cause`)
+const (
+	CauseClientCancel  = "client-cancelled"   // job aborted by user
+	CauseObjCancel     = "object-cancelled"   // the object (alone) cancelled by user (see `AdminBody.ObjName`)
+	CauseJobTimeout    = "job-timeout"        // job exceeded its `Base.Deadline`
+	CauseShutdown      = "target-shutdown"    // downloader stopped (e.g., target shutting down)
+	CauseMpathDisabled = "mountpath-disabled" // destination mountpath disabled or detached
+	CauseOriginError   = "origin-error"       // failed to fetch from the origin (remote link or bucket)
+	CauseDispatch      = "dispatch-failed"    // designated target failed to start the job (see `PartialAccept`)
+	CauseBreakerOpen   = "breaker-open"       // the origin's circuit breaker is open (see `DownloaderConf.BreakerErrs`)
+	CauseCksumMismatch = "cksum-mismatch"     // downloaded content does not match the expected checksum
+	CauseManifest      = "manifest-failed"    // failed to load the job's checksum manifest (see `Base.CksumManifest`)
+	CauseSpill         = "spill-failed"       // failed to load the (spilled) pending object back (see `DownloaderConf.SpillQueue`)
+)
+
+// link download failures by class (see `TaskErrInfo.Class`)
+const (
+	ErrClassDNS      = "dns"      // failed to resolve the link's host
+	ErrClassConnect  = "connect"  // connection refused, reset, or otherwise failed to dial
+	ErrClassTLS      = "tls"      // TLS handshake or certificate verification failed
+	ErrClassTimeout  = "timeout"  // request timed out
+	ErrClassHTTP4xx  = "http-4xx" // origin responded with 4xx status
+	ErrClassHTTP5xx  = "http-5xx" // origin responded with 5xx status
+	ErrClassRedirect = "redirect" // too many redirects (see `DownloaderConf.MaxRedirects`)
+)
+
 type (
 	// NOTE: Changing this structure requires changes in `MarshalJSON` and `UnmarshalJSON` methods.
 	Body struct {
@@ -44,6 +134,12 @@ type (
 	// Download POST result returned to the user
 	DlPostResp struct {
 		ID string `json:"id"`
+		// (PartialAccept) the job runs without these targets, and the objects
+		// they would've downloaded are reported as errors (see `CauseDispatch`)
+		FailedTargets []string `json:"failed_targets,omitempty"`
+		Partial       bool     `json:"partial,omitempty"`
+		// derived object names that got sanitized (see `Base.NamePolicy`): derived => final
+		Renamed cos.StrKVs `json:"renamed,omitempty"`
 	}
 
 	Job struct {
@@ -52,70 +148,223 @@ type (
 		Description   string    `json:"description"`
 		StartedTime   time.Time `json:"started_time"`
 		FinishedTime  time.Time `json:"finished_time"`
-		FinishedCnt   int       `json:"finished_cnt"`
+		FinishedCnt   int       `json:"finished_cnt"`  // including skipped (see `DownloadedCnt`)
 		ScheduledCnt  int       `json:"scheduled_cnt"` // tasks being processed or already processed by dispatched
 		SkippedCnt    int       `json:"skipped_cnt"`   // number of tasks skipped
+		ExistingCnt   int       `json:"existing_cnt"`  // (subset of the skipped) objects that already exist
 		ErrorCnt      int       `json:"error_cnt"`
 		Total         int       `json:"total"`          // total number of tasks, negative if unknown
 		AllDispatched bool      `json:"all_dispatched"` // if true, dispatcher has already scheduled all tasks for given job
 		Aborted       bool      `json:"aborted"`
+		TimedOut      bool      `json:"timed_out,omitempty"` // aborted upon exceeding `Base.Deadline`
+		Scheduled     bool      `json:"scheduled,omitempty"` // waiting for `StartAt` (see `Base.StartAfter`)
+		Paused        bool      `json:"paused,omitempty"`    // rebalance in progress (see `DownloaderConf.PauseOnRebalance`)
+		StartAt       time.Time `json:"start_at,omitempty"`
+		Queued        bool      `json:"queued,omitempty"`     // waiting for a per-bucket slot (see `DownloaderConf.MaxJobsPerBck`)
+		JobsAhead     int       `json:"jobs_ahead,omitempty"` // (status only) number of jobs ahead in line
+		QueuedBck     string    `json:"queued_bck,omitempty"` // (ditto) bucket the job is waiting for
+
+		Buckets []BckProgress `json:"buckets,omitempty"` // multi-bucket job: per-bucket progress (see `BackendBody.Buckets`)
+	}
+
+	BckProgress struct {
+		Bck          cmn.Bck `json:"bck"`
+		ScheduledCnt int     `json:"scheduled_cnt"`
+		FinishedCnt  int     `json:"finished_cnt"`
+		ErrorCnt     int     `json:"error_cnt"`
 	}
 
 	JobInfos []*Job
 
 	StatusResp struct {
 		Job
-		CurrentTasks  []TaskDlInfo  `json:"current_tasks,omitempty"`
-		FinishedTasks []TaskDlInfo  `json:"finished_tasks,omitempty"`
-		Errs          []TaskErrInfo `json:"download_errors,omitempty"`
+		Request       *Base          `json:"request,omitempty"` // as submitted (to retry failed objects; n/a with `OnlyActive`)
+		CurrentTasks  []TaskDlInfo   `json:"current_tasks,omitempty"`
+		FinishedTasks []TaskDlInfo   `json:"finished_tasks,omitempty"`
+		Errs          []TaskErrInfo  `json:"download_errors,omitempty"`
+		Adaptive      map[string]int `json:"adaptive,omitempty"` // target ID => current adaptive ingest level (see `DownloaderConf.AdaptiveMax`)
+		// target ID => (host => open or half-open), see `DownloaderConf.BreakerErrs`
+		Breakers map[string]cos.StrKVs `json:"breakers,omitempty"`
+		// bytes received so far: downloaded objects plus the partial content of those in flight
+		Bytes int64 `json:"bytes,string,omitempty"`
+		// total size of the objects as reported by the origins (see `MultiBody.ValidateLinks`);
+		// objects of unknown size do not count
+		ExpectedBytes int64 `json:"expected_bytes,string,omitempty"`
+		// bytes per second received over the trailing `DownloaderConf.RateWindow`, summed across targets
+		Throughput int64 `json:"throughput,string,omitempty"`
+		// the job is no longer known to (some of) the targets - e.g., after restart - and its
+		// status was reconstructed from the persisted per-object state (see `ObjState`)
+		Persisted bool `json:"persisted,omitempty"`
 	}
 
 	Limits struct {
 		Connections  int `json:"connections"`
 		BytesPerHour int `json:"bytes_per_hour"`
+		// per-target download rate of the job; applies in addition to the target-wide
+		// `DownloaderConf.MaxBandwidth` (i.e., can only lower it)
+		BytesPerSecond int `json:"bytes_per_second,omitempty"`
 	}
 
 	Base struct {
-		Description      string      `json:"description"`
-		Bck              cmn.Bck     `json:"bucket"`
-		Timeout          string      `json:"timeout"`
-		ProgressInterval string      `json:"progress_interval"`
-		Limits           Limits      `json:"limits"`
-		Headers          http.Header `json:"headers,omitempty"`
+		Description      string         `json:"description"`
+		Bck              cmn.Bck        `json:"bucket"`
+		Timeout          string         `json:"timeout"`
+		ProgressInterval string         `json:"progress_interval"`
+		Limits           Limits         `json:"limits"`
+		Headers          http.Header    `json:"headers,omitempty"`
+		VerifyExisting   bool           `json:"verify_existing,omitempty"`   // validate existing objects before skipping (re-download if damaged)
+		NameRule         *NameRule      `json:"name_rule,omitempty"`         // transforms object names derived from links
+		ResolveRedirects bool           `json:"resolve_redirects,omitempty"` // follow redirects to dedup links by their canonical location
+		Extract          bool           `json:"extract,omitempty"`           // store archive members (rather than the archive itself) as separate objects
+		ExtractPrefix    string         `json:"extract_prefix,omitempty"`    // (when extracting) destination virtual directory for the members
+		CksumManifest    *CksumManifest `json:"cksum_manifest,omitempty"`    // verify downloaded objects against the referenced checksums
+		HeadMode         string         `json:"head_mode,omitempty"`         // "" (default) | HeadModeOnly
+		CaptureHeaders   bool           `json:"capture_headers,omitempty"`   // record selected response headers (see `CapturedHeaders`)
+		ForceOverwrite   []string       `json:"force_overwrite,omitempty"`   // names of the objects to (re)download even if they already exist
+		Deadline         string         `json:"deadline,omitempty"`          // job deadline: duration since submission (e.g. "2h") or RFC3339 time
+		StartAfter       string         `json:"start_after,omitempty"`       // delayed start: duration since submission (e.g. "6h") or RFC3339 time
+		Validator        string         `json:"validator,omitempty"`         // validate content prior to storing: "" (none) | ValidatorNotHTML | ValidatorJSON
+		Metadata         cos.StrKVs     `json:"metadata,omitempty"`          // custom metadata to store with each downloaded object (e.g., dataset labels)
+		PostProcess      string         `json:"post_process,omitempty"`      // transform content prior to storing: "" (none) | PostProcNormalizeEOL
+		OnPartial        string         `json:"on_partial,omitempty"`        // some targets failed to start the job: "" (PartialFailFast) | PartialAccept
+		BasicAuth        string         `json:"basic_auth,omitempty"`        // name of a "basic" entry in the (target-local) credentials file
+		NamePolicy       string         `json:"name_policy,omitempty"`       // invalid derived object names: "" (NamePolicyReject) | NamePolicySanitize
+		VerifyOrigin     bool           `json:"verify_origin,omitempty"`     // verify against origin-provided MD5 (`Content-MD5` or a plain-MD5 ETag), if any
+		// store the decoded content of gzip- or deflate-encoded responses (see `Content-Encoding`);
+		// by default, the content gets stored exactly as received
+		DecompressEncoding bool `json:"decompress_encoding,omitempty"`
+		// pending objects of higher-priority jobs get downloaded first (see `MaxPriority`)
+		Priority int `json:"priority,omitempty"`
+
+		renamed cos.StrKVs // (see `Renamed`)
+	}
+
+	// CksumManifest references a checksum file (e.g., SHA256SUMS) in the `sha256sum` format:
+	// one "<checksum> <filename>" per line; the file is fetched (once) by each target
+	CksumManifest struct {
+		URL    string `json:"url"`
+		Type   string `json:"type,omitempty"`   // checksum type (default: sha256)
+		Strict bool   `json:"strict,omitempty"` // fail objects missing in the manifest (otherwise, download unverified)
+	}
+
+	// NameRule rewrites object names derived from download links (e.g., `path.Base(link)`);
+	// the steps are applied in the order of declaration; explicitly named objects are not affected
+	NameRule struct {
+		StripPrefix string `json:"strip_prefix,omitempty"` // remove this prefix, if present
+		Regex       string `json:"regex,omitempty"`        // replace all matches of this regex...
+		Replace     string `json:"replace,omitempty"`      // ...with this (may reference capture groups, e.g. "${1}")
+		Lowercase   bool   `json:"lowercase,omitempty"`
+		re          *regexp.Regexp
 	}
 
 	SingleObj struct {
 		ObjName    string `json:"object_name"`
 		Link       string `json:"link"`
 		FromRemote bool   `json:"from_remote"`
+		// optional expected checksum of the downloaded content (see `ObjChecksum`)
+		Checksum     string `json:"checksum,omitempty"`
+		ChecksumType string `json:"checksum_type,omitempty"`
+		// optional fallback links, in order (same as `MultiBody.ObjMirrors`)
+		Mirrors []string `json:"mirrors,omitempty"`
+	}
+
+	// expected checksum of a given object: the downloaded content gets verified prior to
+	// storing, and the object fails (with `CauseCksumMismatch`) when the digests do not match
+	ObjChecksum struct {
+		Type  string `json:"type,omitempty"` // checksum type (default: sha256)
+		Value string `json:"value"`
+	}
+
+	// transient (stream-through) download: fetch `Link` from the origin and stream the content
+	// back to the requesting client without storing it (see `DownloaderConf.MaxStreamSize`)
+	StreamBody struct {
+		Link      string      `json:"link"`
+		Headers   http.Header `json:"headers,omitempty"`
+		Timeout   string      `json:"timeout,omitempty"`
+		Validator string      `json:"validator,omitempty"` // "" (none) | ValidatorNotHTML | ValidatorJSON
 	}
 
 	AdminBody struct {
 		ID         string `json:"id"`
 		Regex      string `json:"regex"`
 		OnlyActive bool   `json:"only_active_tasks"` // Skips detailed info about tasks finished/errored
+		History    bool   `json:"history,omitempty"` // list summaries of completed jobs (see `HistEntry`)
+		// abort: cancel only the named object of the (running) job - the rest of the job continues
+		ObjName string `json:"objname,omitempty"`
 	}
 
+	// summary of a completed job retained for historical reporting - after the job itself
+	// is removed (see `DownloaderConf.HistoryMax`); each target records its own share
+	HistEntry struct {
+		ID           string     `json:"id"`
+		Description  string     `json:"description,omitempty"`
+		Bck          cmn.Bck    `json:"bucket"`
+		Labels       cos.StrKVs `json:"labels,omitempty"` // (see `Base.Metadata`)
+		StartedTime  time.Time  `json:"started_time"`
+		FinishedTime time.Time  `json:"finished_time"`
+		Total        int        `json:"total"`
+		FinishedCnt  int        `json:"finished_cnt"` // including skipped
+		SkippedCnt   int        `json:"skipped_cnt"`
+		ErrorCnt     int        `json:"error_cnt"`
+		Size         int64      `json:"size,string"` // total size of the downloaded objects
+		Aborted      bool       `json:"aborted,omitempty"`
+		TimedOut     bool       `json:"timed_out,omitempty"`
+	}
+	HistEntries []*HistEntry
+
 	TaskDlInfo struct {
-		Name       string    `json:"name"`
-		Downloaded int64     `json:"downloaded,string"`
-		Total      int64     `json:"total,string,omitempty"`
-		StartTime  time.Time `json:"start_time,omitempty"`
-		EndTime    time.Time `json:"end_time,omitempty"`
+		Name       string     `json:"name"`
+		Downloaded int64      `json:"downloaded,string"`
+		Total      int64      `json:"total,string,omitempty"`
+		StartTime  time.Time  `json:"start_time,omitempty"`
+		EndTime    time.Time  `json:"end_time,omitempty"`
+		Headers    cos.StrKVs `json:"headers,omitempty"`   // (with `Base.CaptureHeaders`)
+		Mirror     string     `json:"mirror,omitempty"`    // fallback link that served the content (see `MultiBody.ObjMirrors`)
+		FinalURL   string     `json:"final_url,omitempty"` // where the content came from, when redirected
 	}
 	TaskInfoByName []TaskDlInfo
 
 	TaskErrInfo struct {
-		Name string `json:"name"`
-		Err  string `json:"error"`
+		Name  string `json:"name"`
+		Err   string `json:"error"`
+		Cause string `json:"cause,omitempty"` // one of the enumerated causes below (empty when not determined)
+		Link  string `json:"link,omitempty"`  // source link to retry (n/a: remote-bucket objects and archive members)
+		Class string `json:"class,omitempty"` // link download failure: one of the enumerated classes (empty when not classified)
+		Code  int    `json:"code,omitempty"`  // HTTP status of the failed request, if any (e.g., 404 from the origin)
 	}
 	TaskErrByName []TaskErrInfo
 
+	// per-object state persisted in the downloader DB as the object progresses
+	// (see `ObjPending` and friends); survives target restarts
+	ObjState struct {
+		State string `json:"state"`
+		Err   string `json:"error,omitempty"`       // failed or aborted: the last error
+		Size  int64  `json:"size,string,omitempty"` // bytes written
+		// finished: the fallback link that served the content, if any (see `MultiBody.ObjMirrors`)
+		Mirror string `json:"mirror,omitempty"`
+		// captured response headers, if any (see `Base.CaptureHeaders`)
+		Headers cos.StrKVs `json:"headers,omitempty"`
+		// finished, failed, or aborted
+		EndTime time.Time `json:"end_time,omitempty"`
+	}
+
 	BackendBody struct {
 		Base
 		Prefix string `json:"prefix"`
 		Suffix string `json:"suffix"`
 		Sync   bool   `json:"synchronize"`
+		// download only the objects that are missing in-cluster: stream (sorted) in-cluster content
+		// and the remote listing side by side, skip the names present in both (without comparing),
+		// and keep in-cluster objects that are no longer present remotely (compare with `Sync`)
+		Backfill bool `json:"backfill,omitempty"`
+		// additional remote buckets to ingest under the same job ID (in addition to `Base.Bck`);
+		// the (prefix, suffix) above is the default for those that do not specify their own
+		Buckets []BackendSpec `json:"buckets,omitempty"`
+	}
+	BackendSpec struct {
+		Bck    cmn.Bck `json:"bck"`
+		Prefix string  `json:"prefix,omitempty"`
+		Suffix string  `json:"suffix,omitempty"`
 	}
 
 	SingleBody struct {
@@ -127,11 +376,41 @@ type (
 		Base
 		Template string `json:"template"`
 		Subdir   string `json:"subdir"`
+		// optional safeguard: when non-zero, the job is rejected unless the template
+		// expands to exactly this number of objects
+		ExpectedCount int64 `json:"expected_count,omitempty"`
 	}
 
 	MultiBody struct {
 		Base
 		ObjectsPayload any `json:"objects"`
+		// optional per-object timeouts (object name => duration, e.g. "1h") that override
+		// `Base.Timeout` for the respective objects only (e.g., a few known-huge ones)
+		ObjTimeouts cos.StrKVs `json:"object_timeouts,omitempty"`
+		// optional per-object providers (object name => provider, e.g. "aws" or "s3") for
+		// mixed-provider jobs: the respective objects go to the same-named bucket of that provider
+		ObjProviders cos.StrKVs `json:"object_providers,omitempty"`
+		// optional per-object fallback links (object name => ordered list of mirrors): when the
+		// object's own link fails (after retries), the mirrors are tried in order, each with
+		// its own retries - the object fails only when all of them do
+		ObjMirrors map[string][]string `json:"object_mirrors,omitempty"`
+		// optional per-object expected checksums (object name => checksum); take precedence
+		// over `Base.CksumManifest` and `Base.VerifyOrigin`
+		ObjChecksums map[string]ObjChecksum `json:"object_checksums,omitempty"`
+		// optional per-object headers (object name => headers, e.g. an object-specific `Authorization`)
+		// that override the same-named `Base.Headers` for the respective objects only
+		ObjHeaders map[string]http.Header `json:"object_headers,omitempty"`
+		// pre-flight: HEAD all the links prior to scheduling any downloads, and reject the entire
+		// job if any of them fails with 4xx; the sizes reported by the origins add up to the
+		// job's `StatusResp.ExpectedBytes`
+		ValidateLinks bool `json:"validate_links,omitempty"`
+		// paged submission of a large job: the first page (`Append` and no `JobID`) creates a job
+		// that stays open for more objects; each following page references the returned job ID
+		// to append its objects, and `Seal` (with or without objects) marks the last page -
+		// the job completes only after it's sealed
+		JobID  string `json:"job_id,omitempty"`
+		Append bool   `json:"append,omitempty"`
+		Seal   bool   `json:"seal,omitempty"`
 	}
 )
 
@@ -144,14 +423,62 @@ func IsType(a string) bool {
 // Job //
 /////////
 
+// (not comparable via '==' because of the per-bucket slice)
+func (j *Job) equal(rhs *Job) bool {
+	if j.ID != rhs.ID || j.XactID != rhs.XactID || j.Description != rhs.Description ||
+		j.FinishedCnt != rhs.FinishedCnt || j.ScheduledCnt != rhs.ScheduledCnt || j.SkippedCnt != rhs.SkippedCnt ||
+		j.ExistingCnt != rhs.ExistingCnt || j.ErrorCnt != rhs.ErrorCnt || j.Total != rhs.Total ||
+		j.AllDispatched != rhs.AllDispatched || j.Aborted != rhs.Aborted || j.TimedOut != rhs.TimedOut ||
+		j.Scheduled != rhs.Scheduled || j.Paused != rhs.Paused || j.Queued != rhs.Queued ||
+		j.JobsAhead != rhs.JobsAhead || j.QueuedBck != rhs.QueuedBck {
+		return false
+	}
+	if !j.StartedTime.Equal(rhs.StartedTime) || !j.FinishedTime.Equal(rhs.FinishedTime) || !j.StartAt.Equal(rhs.StartAt) {
+		return false
+	}
+	if len(j.Buckets) != len(rhs.Buckets) {
+		return false
+	}
+	for i := range j.Buckets {
+		if j.Buckets[i] != rhs.Buckets[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (j *Job) Aggregate(rhs *Job) {
 	j.FinishedCnt += rhs.FinishedCnt
 	j.ScheduledCnt += rhs.ScheduledCnt
 	j.SkippedCnt += rhs.SkippedCnt
+	j.ExistingCnt += rhs.ExistingCnt
 	j.ErrorCnt += rhs.ErrorCnt
 	j.Total += rhs.Total
 	j.AllDispatched = j.AllDispatched && rhs.AllDispatched
 	j.Aborted = j.Aborted || rhs.Aborted
+	j.TimedOut = j.TimedOut || rhs.TimedOut
+	j.Scheduled = j.Scheduled || rhs.Scheduled
+	j.Paused = j.Paused || rhs.Paused
+	if j.StartAt.IsZero() {
+		j.StartAt = rhs.StartAt
+	}
+	j.Queued = j.Queued || rhs.Queued
+	j.JobsAhead = max(j.JobsAhead, rhs.JobsAhead)
+	if j.QueuedBck == "" {
+		j.QueuedBck = rhs.QueuedBck
+	}
+	for i := range rhs.Buckets {
+		r := &rhs.Buckets[i]
+		idx := slices.IndexFunc(j.Buckets, func(l BckProgress) bool { return l.Bck.Equal(&r.Bck) })
+		if idx < 0 {
+			j.Buckets = append(j.Buckets, *r)
+			continue
+		}
+		l := &j.Buckets[idx]
+		l.ScheduledCnt += r.ScheduledCnt
+		l.FinishedCnt += r.FinishedCnt
+		l.ErrorCnt += r.ErrorCnt
+	}
 	if j.StartedTime.After(rhs.StartedTime) {
 		j.StartedTime = rhs.StartedTime
 	}
@@ -186,9 +513,15 @@ func (j *Job) TotalCnt() int {
 	return j.ScheduledCnt
 }
 
+// DownloadedCnt returns number of objects that were actually downloaded (i.e., not skipped).
+func (j *Job) DownloadedCnt() int { return j.FinishedCnt - j.SkippedCnt }
+
 // DoneCnt returns number of tasks that have finished (either successfully or with an error).
 func (j *Job) DoneCnt() int { return j.FinishedCnt + j.ErrorCnt }
 
+// Progress implements nl.Progress (the total is known unless the job is still being scheduled).
+func (j *Job) Progress() (done, total int64) { return int64(j.DoneCnt()), int64(j.Total) }
+
 // PendingCnt returns number of tasks which are currently being processed.
 func (j *Job) PendingCnt() int {
 	pending := j.TotalCnt() - j.DoneCnt()
@@ -213,10 +546,21 @@ func (j *Job) String() string {
 	sb.WriteString(": ")
 
 	switch {
+	case j.TimedOut:
+		sb.WriteString("aborted (deadline exceeded)")
 	case j.Aborted:
 		sb.WriteString("aborted")
+	case j.Scheduled:
+		sb.WriteString("scheduled, starts at ")
+		sb.WriteString(j.StartAt.Format(time.RFC3339))
 	case finished:
 		sb.WriteString("finished")
+	case j.Queued && j.QueuedBck != "":
+		sb.WriteString(fmt.Sprintf("queued: %d job%s ahead for bucket %s", j.JobsAhead, cos.Plural(j.JobsAhead), j.QueuedBck))
+	case j.Queued:
+		sb.WriteString("queued: waiting for a per-bucket slot")
+	case j.Paused:
+		sb.WriteString(fmt.Sprintf("paused: rebalance in progress (%d file%s pending)", pending, cos.Plural(pending)))
 	default:
 		sb.WriteString(fmt.Sprintf("%d file%s still being downloaded", pending, cos.Plural(pending)))
 	}
@@ -272,6 +616,33 @@ func (d JobInfos) Swap(i, j int) {
 	d[i], d[j] = d[j], d[i]
 }
 
+///////////////
+// HistEntry //
+///////////////
+
+func (h *HistEntry) Aggregate(rhs *HistEntry) {
+	h.Total += rhs.Total
+	h.FinishedCnt += rhs.FinishedCnt
+	h.SkippedCnt += rhs.SkippedCnt
+	h.ErrorCnt += rhs.ErrorCnt
+	h.Size += rhs.Size
+	h.Aborted = h.Aborted || rhs.Aborted
+	h.TimedOut = h.TimedOut || rhs.TimedOut
+	if rhs.StartedTime.Before(h.StartedTime) {
+		h.StartedTime = rhs.StartedTime
+	}
+	if rhs.FinishedTime.After(h.FinishedTime) {
+		h.FinishedTime = rhs.FinishedTime
+	}
+}
+
+func (h *HistEntry) Duration() time.Duration { return h.FinishedTime.Sub(h.StartedTime) }
+
+// most recently finished first
+func (d HistEntries) Len() int           { return len(d) }
+func (d HistEntries) Less(i, j int) bool { return d[i].FinishedTime.After(d[j].FinishedTime) }
+func (d HistEntries) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+
 ////////////////
 // StatusResp //
 ////////////////
@@ -284,9 +655,28 @@ func (d *StatusResp) Aggregate(rhs *StatusResp) *StatusResp {
 		return &r
 	}
 	d.Job.Aggregate(&rhs.Job)
+	if d.Request == nil {
+		d.Request = rhs.Request
+	}
 	d.CurrentTasks = append(d.CurrentTasks, rhs.CurrentTasks...)
 	d.FinishedTasks = append(d.FinishedTasks, rhs.FinishedTasks...)
 	d.Errs = append(d.Errs, rhs.Errs...)
+	for tid, level := range rhs.Adaptive {
+		if d.Adaptive == nil {
+			d.Adaptive = make(map[string]int, 4)
+		}
+		d.Adaptive[tid] = level
+	}
+	for tid, states := range rhs.Breakers {
+		if d.Breakers == nil {
+			d.Breakers = make(map[string]cos.StrKVs, 4)
+		}
+		d.Breakers[tid] = states
+	}
+	d.Bytes += rhs.Bytes
+	d.ExpectedBytes += rhs.ExpectedBytes
+	d.Throughput += rhs.Throughput
+	d.Persisted = d.Persisted || rhs.Persisted
 	return d
 }
 
@@ -309,9 +699,244 @@ func (b *Base) Validate() error {
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	if b.Limits.BytesPerSecond < 0 {
+		return fmt.Errorf("'limit.bytes_per_second' must be non-negative (got: %d)", b.Limits.BytesPerSecond)
+	}
+	if b.Priority < -MaxPriority || b.Priority > MaxPriority {
+		return fmt.Errorf("invalid 'priority' %d (expecting [%d, %d])", b.Priority, -MaxPriority, MaxPriority)
+	}
+	switch b.HeadMode {
+	case "":
+	case HeadModeOnly:
+		if b.Extract {
+			return fmt.Errorf("'head_mode' %q cannot be used together with 'extract'", b.HeadMode)
+		}
+	default:
+		return fmt.Errorf("invalid 'head_mode' %q (expecting %q)", b.HeadMode, HeadModeOnly)
+	}
+	if b.Validator != "" {
+		if newValidator(b.Validator) == nil {
+			return fmt.Errorf("invalid 'validator' %q (expecting one of %v)", b.Validator, validatorNames())
+		}
+		if b.HeadMode == HeadModeOnly {
+			return fmt.Errorf("'validator' cannot be used together with 'head_mode' %q", b.HeadMode)
+		}
+		if b.Extract && b.Validator == ValidatorJSON {
+			return fmt.Errorf("'validator' %q cannot be used together with 'extract'", b.Validator)
+		}
+	}
+	if err := ValidateOnPartial(b.OnPartial); err != nil {
+		return err
+	}
+	if b.PostProcess != "" {
+		if newPostProcessor(b.PostProcess) == nil {
+			return fmt.Errorf("invalid 'post_process' %q (expecting one of %v)", b.PostProcess, postProcessorNames())
+		}
+		switch {
+		case b.HeadMode == HeadModeOnly:
+			return fmt.Errorf("'post_process' cannot be used together with 'head_mode' %q", b.HeadMode)
+		case b.Extract:
+			return errors.New("'post_process' cannot be used together with 'extract'")
+		case b.CksumManifest != nil:
+			// (the manifest lists checksums of the source content)
+			return errors.New("'post_process' cannot be used together with 'cksum_manifest'")
+		}
+	}
+	if err := validateMetadata(b.Metadata); err != nil {
+		return err
+	}
+	if b.ExtractPrefix != "" && !b.Extract {
+		return fmt.Errorf("'extract_prefix' (%q) requires 'extract'", b.ExtractPrefix)
+	}
+	if err := b.CksumManifest.Validate(); err != nil {
+		return err
+	}
+	if b.VerifyOrigin && b.Extract {
+		return errors.New("'verify_origin' cannot be used together with 'extract'")
+	}
+	if b.DecompressEncoding {
+		switch {
+		case b.HeadMode == HeadModeOnly:
+			return fmt.Errorf("'decompress_encoding' cannot be used together with 'head_mode' %q", b.HeadMode)
+		case b.Extract:
+			return errors.New("'decompress_encoding' cannot be used together with 'extract'")
+		}
+	}
+	now := time.Now()
+	dline, err := ParseDeadline(b.Deadline, now)
+	if err != nil {
+		return err
+	}
+	startAt, err := ParseStartAfter(b.StartAfter, now)
+	if err != nil {
+		return err
+	}
+	if !dline.IsZero() && !startAt.IsZero() && !dline.After(startAt) {
+		return fmt.Errorf("'deadline' %q precedes 'start_after' %q", b.Deadline, b.StartAfter)
+	}
+	for _, objName := range b.ForceOverwrite {
+		if objName == "" {
+			return errors.New("'force_overwrite' contains empty object name")
+		}
+	}
+	if err := ValidateNamePolicy(b.NamePolicy); err != nil {
+		return err
+	}
+	return b.NameRule.Validate()
+}
+
+func (b *Base) namer() *namer {
+	return &namer{rule: b.NameRule, sanitize: b.NamePolicy == NamePolicySanitize, renamed: b.renamed}
+}
+
+// user-defined keys must not collide with the system-maintained ones (see cmn/objattrs.go)
+func validateMetadata(md cos.StrKVs) error {
+	if len(md) > maxMetadataKeys {
+		return fmt.Errorf("'metadata': too many keys (%d, max %d)", len(md), maxMetadataKeys)
+	}
+	for k, v := range md {
+		switch {
+		case k == "":
+			return errors.New("'metadata' contains empty key")
+		case len(k) > maxMetadataKeyLen:
+			return fmt.Errorf("'metadata' key %.32q... is too long (max %d)", k, maxMetadataKeyLen)
+		case len(v) > maxMetadataValLen:
+			return fmt.Errorf("'metadata' value of %q is too long (max %d)", k, maxMetadataValLen)
+		case strings.ContainsAny(k, "=,") || strings.TrimSpace(k) != k:
+			return fmt.Errorf("'metadata' key %q: must not contain '=', ',', or leading/trailing spaces", k)
+		}
+		switch k {
+		case cmn.SourceObjMD, cmn.VersionObjMD, cmn.CRC32CObjMD, cmn.MD5ObjMD, cmn.ETag,
+			cmn.OrigURLObjMD, cmn.LsoLastModified, cmn.OrigFntl, cmn.MirrorObjMD:
+			return fmt.Errorf("'metadata' key %q is reserved", k)
+		}
+	}
 	return nil
 }
 
+// fallback links of a given object (see `MultiBody.ObjMirrors`, `SingleObj.Mirrors`)
+func validateMirrors(mirrors []string) error {
+	if len(mirrors) > maxObjMirrors {
+		return fmt.Errorf("too many mirrors (%d, max %d)", len(mirrors), maxObjMirrors)
+	}
+	for _, link := range mirrors {
+		if _, err := url.ParseRequestURI(cmn.PrependProtocol(link)); link == "" || err != nil {
+			return fmt.Errorf("invalid link %q", redactLink(link))
+		}
+	}
+	return nil
+}
+
+func ValidateOnPartial(s string) error {
+	switch s {
+	case "", PartialFailFast, PartialAccept:
+		return nil
+	default:
+		return fmt.Errorf("invalid 'on_partial' %q (expecting %q or %q)", s, PartialFailFast, PartialAccept)
+	}
+}
+
+// ParseDeadline returns zero time when not specified; a duration is counted from `now`
+// (i.e., job submission on a given target)
+func ParseDeadline(s string, now time.Time) (time.Time, error) {
+	return _parseTime("deadline", s, now)
+}
+
+// ditto - delayed (scheduled) start
+func ParseStartAfter(s string, now time.Time) (time.Time, error) {
+	return _parseTime("start_after", s, now)
+}
+
+func _parseTime(tag, s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("invalid '%s' %q (expecting positive duration)", tag, s)
+		}
+		return now.Add(d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid '%s' %q (expecting duration or RFC3339 time)", tag, s)
+	}
+	if !t.After(now) {
+		return time.Time{}, fmt.Errorf("'%s' %q has already passed", tag, s)
+	}
+	return t, nil
+}
+
+//////////////
+// NameRule //
+//////////////
+
+// nil-safe
+func (r *NameRule) Validate() (err error) {
+	if r == nil {
+		return nil
+	}
+	if r.Regex == "" {
+		if r.Replace != "" {
+			return errors.New("'name_rule.replace' requires 'name_rule.regex'")
+		}
+		return nil
+	}
+	if r.re, err = regexp.Compile(r.Regex); err != nil {
+		return fmt.Errorf("invalid 'name_rule.regex' %q: %v", r.Regex, err)
+	}
+	return nil
+}
+
+// Apply is nil-safe; PRECONDITION: validated
+func (r *NameRule) Apply(objName string) (string, error) {
+	if r == nil {
+		return objName, nil
+	}
+	name := strings.TrimPrefix(objName, r.StripPrefix)
+	if r.re != nil {
+		name = r.re.ReplaceAllString(name, r.Replace)
+	}
+	if r.Lowercase {
+		name = strings.ToLower(name)
+	}
+	if name == "" || name == "." || name == "/" {
+		return "", fmt.Errorf("name rule transforms %q into an invalid object name %q", objName, name)
+	}
+	return name, nil
+}
+
+// collision check: distinct links must not end up under the same (transformed) name
+func checkNameCollision(objects cos.StrKVs, objName, link string) error {
+	if prev, ok := objects[objName]; ok && prev != link {
+		return fmt.Errorf("links %q and %q map to the same object name %q", prev, link, objName)
+	}
+	return nil
+}
+
+///////////////////
+// CksumManifest //
+///////////////////
+
+func (m *CksumManifest) Validate() error {
+	if m == nil {
+		return nil
+	}
+	if m.URL == "" {
+		return errors.New("missing 'cksum_manifest.url'")
+	}
+	if _, err := url.ParseRequestURI(cmn.PrependProtocol(m.URL)); err != nil {
+		return fmt.Errorf("invalid 'cksum_manifest.url' %q: %v", m.URL, err)
+	}
+	if m.Type == "" {
+		m.Type = cos.ChecksumSHA256
+	}
+	if m.Type == cos.ChecksumNone {
+		return errors.New("invalid 'cksum_manifest.type' \"none\"")
+	}
+	return cos.ValidateCksumType(m.Type)
+}
+
 ///////////////
 // SingleObj //
 ///////////////
@@ -330,6 +955,70 @@ func (b *SingleObj) Validate() error {
 	if b.ObjName == "" {
 		return errors.New("missing 'object_name' in the request body")
 	}
+	if len(b.Mirrors) > 0 {
+		if b.FromRemote {
+			return errors.New("'mirrors' cannot be used together with 'from_remote'")
+		}
+		if err := validateMirrors(b.Mirrors); err != nil {
+			return fmt.Errorf("'mirrors': %v", err)
+		}
+	}
+	if b.Checksum == "" {
+		if b.ChecksumType != "" {
+			return fmt.Errorf("'checksum_type' %q requires 'checksum'", b.ChecksumType)
+		}
+		return nil
+	}
+	ck := ObjChecksum{Type: b.ChecksumType, Value: b.Checksum}
+	if err := ck.Validate(); err != nil {
+		return fmt.Errorf("invalid 'checksum': %v", err)
+	}
+	b.ChecksumType = ck.Type
+	return nil
+}
+
+/////////////////
+// ObjChecksum //
+/////////////////
+
+func (ck *ObjChecksum) Validate() error {
+	if ck.Value == "" {
+		return errors.New("missing checksum value")
+	}
+	if ck.Type == "" {
+		ck.Type = cos.ChecksumSHA256
+	}
+	if ck.Type == cos.ChecksumNone {
+		return errors.New("invalid checksum type \"none\"")
+	}
+	return cos.ValidateCksumType(ck.Type)
+}
+
+func (ck *ObjChecksum) cksum() *cos.Cksum {
+	return cos.NewCksum(ck.Type, strings.ToLower(ck.Value))
+}
+
+////////////////
+// StreamBody //
+////////////////
+
+func (b *StreamBody) String() string { return redactLink(b.Link) }
+
+func (b *StreamBody) Validate() error {
+	if b.Link == "" {
+		return errors.New("missing 'link' in the request body")
+	}
+	if _, err := url.ParseRequestURI(cmn.PrependProtocol(b.Link)); err != nil {
+		return fmt.Errorf("invalid 'link' %q: %v", b.Link, err)
+	}
+	if b.Timeout != "" {
+		if _, err := time.ParseDuration(b.Timeout); err != nil {
+			return fmt.Errorf("failed to parse timeout field: %v", err)
+		}
+	}
+	if b.Validator != "" && newValidator(b.Validator) == nil {
+		return fmt.Errorf("invalid 'validator' %q (expecting one of %v)", b.Validator, validatorNames())
+	}
 	return nil
 }
 
@@ -348,10 +1037,29 @@ func (b *AdminBody) Validate(requireID bool) error {
 	case b.ID == "" && requireID:
 		return errors.New("UUID not specified")
 	}
+	if b.History && b.ID != "" {
+		return fmt.Errorf("job ID %q cannot be used to query history (use regex instead)", b.ID)
+	}
+	if b.ObjName != "" && b.ID == "" {
+		return fmt.Errorf("object %q: job ID not specified", b.ObjName)
+	}
 
 	return nil
 }
 
+////////////////
+// TaskDlInfo //
+////////////////
+
+// percentage of the object received so far;
+// false when the size is unknown (the origin did not provide `Content-Length`)
+func (t *TaskDlInfo) Pct() (float64, bool) {
+	if t.Total <= 0 {
+		return 0, false
+	}
+	return 100 * float64(t.Downloaded) / float64(t.Total), true
+}
+
 ////////////////////
 // TaskInfoByName //
 ////////////////////
@@ -368,11 +1076,21 @@ func (t TaskErrByName) Less(i, j int) bool { return t[i].Name < t[j].Name }
 // SingleBody //
 ////////////////
 
-func (b *SingleBody) Validate() error {
-	if err := b.Base.Validate(); err != nil {
+func (b *SingleBody) Validate() (err error) {
+	if err = b.Base.Validate(); err != nil {
 		return err
 	}
-	return b.SingleObj.Validate()
+	derived := b.ObjName == ""
+	if err = b.SingleObj.Validate(); err != nil {
+		return err
+	}
+	if b.Checksum != "" && b.Extract {
+		return errors.New("'checksum' cannot be used together with 'extract'")
+	}
+	if derived {
+		b.ObjName, err = b.namer().derive(b.ObjName, "")
+	}
+	return err
 }
 
 func (b *SingleBody) ExtractPayload() (cos.StrKVs, error) {
@@ -403,9 +1121,30 @@ func (b *RangeBody) Validate() error {
 	if b.Template == "" {
 		return errors.New("missing 'template' in the request body")
 	}
+	if b.ExpectedCount < 0 {
+		return fmt.Errorf("invalid expected count %d (must be non-negative)", b.ExpectedCount)
+	}
 	return nil
 }
 
+// parse the template and check its expansion against (optional) expected count
+// and the configured hard limit (see `cmn.DownloaderConf.MaxRangeCount`)
+func (b *RangeBody) ParseTemplate(maxCount int64) (pt cos.ParsedTemplate, _ error) {
+	pt, err := cos.ParseBashTemplate(b.Template)
+	if err != nil {
+		return pt, err
+	}
+	cnt := pt.Count()
+	if b.ExpectedCount != 0 && cnt != b.ExpectedCount {
+		return pt, fmt.Errorf("template %q expands to %d objects (expected %d)", b.Template, cnt, b.ExpectedCount)
+	}
+	if cnt > maxCount {
+		return pt, fmt.Errorf("template %q expands to %d objects, exceeding the maximum %d (see 'downloader.max_range' config)",
+			b.Template, cnt, maxCount)
+	}
+	return pt, nil
+}
+
 func (b *RangeBody) Describe() string {
 	if b.Description != "" {
 		return b.Description
@@ -422,14 +1161,74 @@ func (b *RangeBody) String() string {
 ///////////////
 
 func (b *MultiBody) Validate() error {
-	if b.ObjectsPayload == nil {
+	if b.JobID != "" && !cos.IsValidUUID(b.JobID) {
+		return fmt.Errorf("invalid 'job_id' %q", b.JobID)
+	}
+	if b.Seal && b.JobID == "" {
+		return errors.New("'seal' requires 'job_id' (of the job opened with 'append')")
+	}
+	if b.ObjectsPayload == nil && !b.Seal {
 		return errors.New("body should not be empty")
 	}
+	for name, s := range b.ObjTimeouts {
+		if name == "" {
+			return errors.New("'object_timeouts': empty object name")
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("'object_timeouts': invalid timeout %q for %q: %v", s, name, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("'object_timeouts': timeout for %q must be positive (got %q)", name, s)
+		}
+	}
+	if len(b.ObjProviders) > 0 && b.Extract {
+		return errors.New("'object_providers' cannot be used with 'extract'")
+	}
+	for name, p := range b.ObjProviders {
+		if name == "" {
+			return errors.New("'object_providers': empty object name")
+		}
+		if np := apc.NormalizeProvider(p); p == "" || np == "" || np == apc.HT {
+			return fmt.Errorf("'object_providers': invalid provider %q for %q", p, name)
+		}
+	}
+	for name, mirrors := range b.ObjMirrors {
+		if name == "" {
+			return errors.New("'object_mirrors': empty object name")
+		}
+		if err := validateMirrors(mirrors); err != nil {
+			return fmt.Errorf("'object_mirrors': %v (object %q)", err, name)
+		}
+	}
+	if len(b.ObjChecksums) > 0 && b.Extract {
+		return errors.New("'object_checksums' cannot be used with 'extract'")
+	}
+	for name, ck := range b.ObjChecksums {
+		if name == "" {
+			return errors.New("'object_checksums': empty object name")
+		}
+		if err := ck.Validate(); err != nil {
+			return fmt.Errorf("'object_checksums': invalid checksum for %q: %v", name, err)
+		}
+		b.ObjChecksums[name] = ck // (default type)
+	}
+	for name, hdr := range b.ObjHeaders {
+		if name == "" {
+			return errors.New("'object_headers': empty object name")
+		}
+		if len(hdr) == 0 {
+			return fmt.Errorf("'object_headers': no headers for %q", name)
+		}
+	}
 	return b.Base.Validate()
 }
 
 func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
 	objects := make(cos.StrKVs, 10)
+	if b.ObjectsPayload == nil { // (sealing page)
+		return objects, nil
+	}
 	switch ty := b.ObjectsPayload.(type) {
 	case map[string]any:
 		for key, val := range ty {
@@ -442,6 +1241,7 @@ func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
 		}
 	case []any:
 		// process all links
+		nmr := b.namer()
 		for _, val := range ty {
 			switch link := val.(type) {
 			case string:
@@ -451,6 +1251,15 @@ func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
 					// TODO: ignore and continue?
 					return nil, err
 				}
+				objName, err := nmr.derive(objName, "")
+				if err != nil {
+					return nil, err
+				}
+				if b.NameRule != nil || nmr.sanitize {
+					if err := checkNameCollision(objects, objName, link); err != nil {
+						return nil, err
+					}
+				}
 				objects[objName] = link
 			default:
 				return nil, fmt.Errorf("expected download link to be a string, got: %T", link)
@@ -462,6 +1271,18 @@ func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
 	return objects, nil
 }
 
+// distinct destination buckets other than `b.Bck` (see `ObjProviders`)
+func (b *MultiBody) ProviderBcks() (bcks []cmn.Bck) {
+	for _, p := range b.ObjProviders {
+		bck := cmn.Bck{Name: b.Bck.Name, Provider: apc.NormalizeProvider(p), Ns: b.Bck.Ns}
+		if bck.Provider == apc.NormalizeProvider(b.Bck.Provider) || slices.ContainsFunc(bcks, func(other cmn.Bck) bool { return other.Equal(&bck) }) {
+			continue
+		}
+		bcks = append(bcks, bck)
+	}
+	return bcks
+}
+
 func (b *MultiBody) Describe() string {
 	if b.Description != "" {
 		return b.Description
@@ -477,11 +1298,57 @@ func (b *MultiBody) String() string {
 // BackendBody //
 /////////////////
 
-func (b *BackendBody) Validate() error { return b.Base.Validate() }
+func (b *BackendBody) Validate() error {
+	if b.HeadMode == HeadModeOnly {
+		return fmt.Errorf("'head_mode' %q is not supported for remote buckets (use 'ais ls')", b.HeadMode)
+	}
+	if b.PostProcess != "" {
+		return errors.New("'post_process' is not supported for remote buckets")
+	}
+	if b.BasicAuth != "" {
+		return errors.New("'basic_auth' is not supported for remote buckets")
+	}
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	if b.Backfill && b.Sync {
+		return errors.New("'backfill' and 'synchronize' are mutually exclusive")
+	}
+	if len(b.Buckets) == 0 {
+		return nil
+	}
+	if b.Sync || b.Backfill {
+		return errors.New("neither 'synchronize' nor 'backfill' is supported for multi-bucket downloads")
+	}
+	if len(b.Buckets) >= maxBackendBuckets {
+		return fmt.Errorf("too many buckets (%d, expecting at most %d)", len(b.Buckets)+1, maxBackendBuckets)
+	}
+	for i := range b.Buckets {
+		bck := &b.Buckets[i].Bck
+		if bck.Name == "" {
+			return fmt.Errorf("missing 'buckets[%d].bck.name'", i)
+		}
+		if bck.Equal(&b.Bck) {
+			return fmt.Errorf("duplicate bucket %s", bck.Cname(""))
+		}
+		for j := range i {
+			if bck.Equal(&b.Buckets[j].Bck) {
+				return fmt.Errorf("duplicate bucket %s", bck.Cname(""))
+			}
+		}
+	}
+	return nil
+}
 
 func (b *BackendBody) Describe() string {
 	if b.Description != "" {
 		return b.Description
 	}
+	if n := len(b.Buckets); n > 0 {
+		return fmt.Sprintf("remote buckets prefetch -> %s and %d other bucket%s", b.Bck.Cname(""), n, cos.Plural(n))
+	}
+	if b.Backfill {
+		return "remote bucket backfill -> " + b.Bck.Cname("")
+	}
 	return "remote bucket prefetch -> " + b.Bck.Cname("")
 }
@@ -0,0 +1,181 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Per-origin circuit breaker (see `DownloaderConf.BreakerErrs`): too many origin failures
+// (connection, DNS, TLS, timeout, 5xx, 429) from a given host within the window "open" the host's
+// breaker, and subsequent downloads from the host fail fast. Once the cooldown elapses, a single
+// download is let through (the breaker is "half-open"); the breaker closes if the probe succeeds
+// and reopens otherwise. Only hosts with recent failures are tracked.
+
+const (
+	breakerClosed   = "closed"
+	breakerOpen     = "open"
+	breakerHalfOpen = "half-open"
+)
+
+var errBreakerOpen = errors.New("circuit breaker open")
+
+type (
+	breakers struct {
+		m        map[string]*breaker // host => breaker
+		errs     int
+		window   time.Duration
+		cooldown time.Duration
+		mu       sync.Mutex
+	}
+	breaker struct {
+		state string
+		fails int   // within the current window (when closed)
+		since int64 // mono time: window start (when closed) or the time the breaker opened
+	}
+)
+
+func newBreakers(config *cmn.DownloaderConf) *breakers {
+	if config.BreakerErrs <= 0 {
+		return nil // disabled
+	}
+	return &breakers{
+		m:        make(map[string]*breaker, 4),
+		errs:     config.BreakerErrs,
+		window:   config.BreakerWindowDur(),
+		cooldown: config.BreakerCooldownDur(),
+	}
+}
+
+// empty when the link cannot be parsed
+func linkHost(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// returns an error if the host's breaker is open (or half-open with a probe already in flight);
+// otherwise, the caller must report the outcome (see `done`)
+func (bs *breakers) allow(host string) error {
+	if bs == nil || host == "" {
+		return nil
+	}
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	b, ok := bs.m[host]
+	if !ok {
+		return nil
+	}
+	switch b.state {
+	case breakerOpen:
+		if mono.Since(b.since) >= bs.cooldown {
+			b.state = breakerHalfOpen // this one is the probe
+			nlog.Infoln("breaker", host, "=> half-open")
+			return nil
+		}
+	case breakerHalfOpen:
+	default:
+		return nil
+	}
+	return fmt.Errorf("%w: %s (%s)", errBreakerOpen, host, b.state)
+}
+
+// records the outcome of a download from the host
+func (bs *breakers) done(host string, err error) {
+	if bs == nil || host == "" {
+		return
+	}
+	failed, neutral := breakerOutcome(err)
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	b, ok := bs.m[host]
+	switch {
+	case neutral:
+		if ok && b.state == breakerHalfOpen {
+			// inconclusive probe - let the next one through
+			b.state, b.since = breakerOpen, mono.NanoTime()-int64(bs.cooldown)
+		}
+	case !failed:
+		if ok {
+			if b.state != breakerClosed {
+				nlog.Infoln("breaker", host, "=> closed")
+			}
+			delete(bs.m, host)
+		}
+	case !ok:
+		bs.m[host] = &breaker{state: breakerClosed, fails: 1, since: mono.NanoTime()}
+		bs._open(host, bs.m[host])
+	case b.state == breakerClosed:
+		if mono.Since(b.since) > bs.window {
+			b.fails, b.since = 0, mono.NanoTime()
+		}
+		b.fails++
+		bs._open(host, b)
+	case b.state == breakerHalfOpen:
+		b.state, b.since = breakerOpen, mono.NanoTime()
+		nlog.Warningln("breaker", host, "=> open (probe failed)")
+	}
+}
+
+func (bs *breakers) _open(host string, b *breaker) {
+	if b.fails < bs.errs {
+		return
+	}
+	b.state, b.since = breakerOpen, mono.NanoTime()
+	nlog.Warningln("breaker", host, "=> open [", b.fails, "errors within", bs.window, "]")
+}
+
+// non-closed breakers (for status)
+func (bs *breakers) states() (out map[string]string) {
+	if bs == nil {
+		return nil
+	}
+	bs.mu.Lock()
+	for host, b := range bs.m {
+		if b.state == breakerClosed {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(bs.m))
+		}
+		out[host] = b.state
+	}
+	bs.mu.Unlock()
+	return out
+}
+
+// origin failure: counts toward opening the breaker;
+// neutral: says nothing about the origin (e.g., canceled, local error)
+func breakerOutcome(err error) (failed, neutral bool) {
+	if err == nil {
+		return false, false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
+		return false, true
+	}
+	if herr := cmn.Err2HTTPErr(err); herr != nil {
+		if herr.Status >= http.StatusInternalServerError || herr.Status == http.StatusTooManyRequests {
+			return true, false
+		}
+		return false, herr.Status < http.StatusBadRequest // (the origin did respond)
+	}
+	switch classifyLinkErr(err) {
+	case ErrClassDNS, ErrClassConnect, ErrClassTLS, ErrClassTimeout:
+		return true, false
+	}
+	return false, true
+}
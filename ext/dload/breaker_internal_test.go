@@ -0,0 +1,68 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBreakers(t *testing.T) {
+	var none *breakers
+	tassert.Errorf(t, newBreakers(&cmn.DownloaderConf{}) == nil, "expected disabled")
+	tassert.Errorf(t, none.allow("host") == nil && none.states() == nil, "expected no-op when disabled")
+
+	const host = "example.com:8080"
+	tassert.Errorf(t, linkHost("http://"+host+"/a/b") == host, "expected %q", host)
+
+	var (
+		bs = newBreakers(&cmn.DownloaderConf{
+			BreakerErrs:     2,
+			BreakerCooldown: cos.Duration(20 * time.Millisecond),
+		})
+		errConn  = context.DeadlineExceeded
+		err5xx   = cmn.NewErrHTTP(nil, errors.New("unavailable"), http.StatusServiceUnavailable)
+		err404   = cmn.NewErrHTTP(nil, errors.New("not found"), http.StatusNotFound)
+		errLocal = errors.New("local")
+	)
+
+	// neutral outcomes are not counted
+	bs.done(host, errLocal)
+	bs.done(host, context.Canceled)
+	tassert.Fatalf(t, len(bs.m) == 0, "expected no tracking")
+
+	// threshold reached => open
+	bs.done(host, errConn)
+	tassert.Errorf(t, bs.allow(host) == nil, "expected closed")
+	bs.done(host, err5xx)
+	err := bs.allow(host)
+	tassert.Fatalf(t, errors.Is(err, errBreakerOpen), "expected open, got %v", err)
+	tassert.Errorf(t, bs.states()[host] == breakerOpen, "expected open state, got %v", bs.states())
+	tassert.Errorf(t, bs.allow("other") == nil, "expected other hosts unaffected")
+
+	// cooldown => a single probe; failed probe => open again
+	time.Sleep(30 * time.Millisecond)
+	tassert.Fatalf(t, bs.allow(host) == nil, "expected probe")
+	tassert.Errorf(t, errors.Is(bs.allow(host), errBreakerOpen), "expected a single probe")
+	bs.done(host, errConn)
+	tassert.Errorf(t, errors.Is(bs.allow(host), errBreakerOpen), "expected reopened")
+
+	// inconclusive probe => next one goes through
+	time.Sleep(30 * time.Millisecond)
+	tassert.Fatalf(t, bs.allow(host) == nil, "expected probe")
+	bs.done(host, context.Canceled)
+	tassert.Fatalf(t, bs.allow(host) == nil, "expected another probe")
+
+	// origin responded => closed
+	bs.done(host, err404)
+	tassert.Errorf(t, bs.allow(host) == nil && bs.states() == nil, "expected closed, got %v", bs.states())
+}
@@ -0,0 +1,230 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Parallel chunked fetch: with `DownloaderConf.ChunkSize`, a resumable download (see resume.go)
+// larger than the chunk size gets assembled in its workfile from ranges (chunks) fetched
+// `DownloaderConf.ChunkConc` at a time. The response to the object's own GET only tells whether
+// the content is still the one the workfile holds (the origin honors `If-Range`); its body is not used.
+// Each completed chunk is committed (fsync-ed) and recorded in the downloader DB, so that the next
+// attempt - or a later job - fetches only the chunks that are missing.
+//
+// A failed chunk is retried (up to `DownloaderConf.ChunkRetries` times) on its own, independently
+// from the whole-object retries (`DownloaderConf.MaxRetries`) - the latter take over only when
+// the chunk's retries are exhausted, and then resume with the remaining chunks.
+//
+// Out-of-order assembly rules out streaming checksums: when the expected (whole-object) checksum
+// is known (see cksum.go), the assembled workfile gets checksummed and compared prior to PUT.
+// On mismatch, the workfile is discarded, and the object fails - with a single whole-object digest,
+// there's no telling which chunk(s) are corrupted.
+
+// the origin responded with content other than requested (e.g., the object has changed)
+var errChunkMismatch = errors.New("chunk mismatch")
+
+func numChunks(size, csz int64) int { return int((size + csz - 1) / csz) }
+
+// [start, end) of the i-th chunk
+func (p *partial) chunk(i int) (start, end int64) {
+	start = int64(i) * p.ChunkSize
+	return start, min(start+p.ChunkSize, p.Size)
+}
+
+func (p *partial) pending() (idx []int) {
+	for i, done := range p.Done {
+		if !done {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// fetch the chunks that are missing, ChunkConc at a time
+func (p *partial) fetchChunks(task *singleTask, link string, body io.ReadCloser) (bool /*err is fatal*/, error) {
+	cos.Close(body) // (see above)
+
+	var (
+		fh  *os.File
+		err error
+	)
+	if p.Offset == 0 {
+		if fh, err = cos.CreateFile(p.Wfqn); err == nil {
+			err = fh.Truncate(p.Size)
+		}
+		if err == nil {
+			g.store.setPartial(p.uname, &p.partialRec)
+		}
+	} else {
+		fh, err = os.OpenFile(p.Wfqn, os.O_WRONLY, 0)
+	}
+	if err != nil {
+		if fh != nil {
+			cos.Close(fh)
+		}
+		return true, err
+	}
+	task.currentSize.Store(p.Offset)
+
+	var (
+		conf        = &cmn.GCO.Get().Downloader
+		pending     = p.pending()
+		ch          = make(chan int, len(pending))
+		ctx, cancel = context.WithCancel(task.getCtx)
+		wg          sync.WaitGroup
+		mu          sync.Mutex
+		errFirst    error
+	)
+	for _, i := range pending {
+		ch <- i
+	}
+	close(ch)
+	for range min(conf.ChunkConcurrency(), len(pending)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ch {
+				if err := p.fetchChunk(ctx, task, fh, link, i); err != nil {
+					mu.Lock()
+					if errFirst == nil {
+						errFirst = err
+					}
+					mu.Unlock()
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	cancel()
+	cos.Close(fh)
+
+	var errLocal *os.PathError // (failed to write or sync)
+	switch {
+	case errFirst == nil:
+		return false, nil
+	case errors.As(errFirst, &errLocal):
+		return true, errFirst
+	case errors.Is(errFirst, errChunkMismatch):
+		size := p.Size
+		task.dropPartial()
+		return false, &errInterrupted{errFirst, 0, size} // (restart)
+	case !retriableErr(errFirst):
+		return false, errFirst
+	default:
+		return false, &errInterrupted{errFirst, p.Offset, p.Size} // (whole-object retry resumes with the remaining chunks)
+	}
+}
+
+// fetch the i-th chunk, retrying transient failures
+func (p *partial) fetchChunk(ctx context.Context, task *singleTask, fh *os.File, link string, i int) error {
+	var (
+		conf    = &cmn.GCO.Get().Downloader
+		retries = conf.ChunkRetryCount()
+		backoff = conf.RetryBackoffDur()
+	)
+	for j := 0; ; j++ {
+		n, err := p.getChunk(ctx, task, fh, link, i)
+		if err == nil {
+			return p.commitChunk(fh, i, n)
+		}
+		task.currentSize.Add(-n) // (the chunk gets refetched in its entirety)
+		if ctx.Err() != nil || j >= retries || !retriableErr(err) {
+			return err
+		}
+		nlog.Warningf("%s [chunk %d, retries: %d/%d]: %v - retrying", task, i, j, retries, err)
+		if errB := waitBackoff(ctx, backoff, j); errB != nil {
+			return err
+		}
+	}
+}
+
+func (p *partial) getChunk(ctx context.Context, task *singleTask, fh *os.File, link string, i int) (int64, error) {
+	actx, received, cancel := attemptCtx(ctx, task.initialTimeout(), cmn.GCO.Get().Downloader.ReadIdleTimeout.D())
+	defer cancel()
+
+	req, err := task.newReq(actx, link)
+	if err != nil {
+		return 0, err
+	}
+	start, end := p.chunk(i)
+	req.Header.Set(cos.HdrRange, cos.HdrRangeValPrefix+strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end-1, 10))
+	if p.Validator != "" {
+		req.Header.Set(cos.HdrIfRange, p.Validator)
+	}
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return 0, attemptErr(actx, err)
+	}
+	received(resp)
+	defer cos.Close(resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return 0, cmn.NewErrHTTP(req, fmt.Errorf("failed to download chunk %d of %q: status %d", i, redactLink(link), resp.StatusCode),
+			resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		return 0, fmt.Errorf("%w: %d of %q - status %d", errChunkMismatch, i, redactLink(link), resp.StatusCode)
+	}
+	if s, e, total, err := parseRange(resp.Header.Get(cos.HdrContentRange)); err != nil || s != start || e != end-1 || total != p.Size {
+		return 0, fmt.Errorf("%w: %d of %q - (%s) vs [%d, %d) of %d", errChunkMismatch, i, redactLink(link),
+			resp.Header.Get(cos.HdrContentRange), start, end, p.Size)
+	}
+
+	var (
+		w         = io.NewOffsetWriter(fh, start)
+		buf, slab = memsys.PageMM().AllocSize(memsys.DefaultBufSize)
+	)
+	n, err := io.CopyBuffer(w, io.LimitReader(task.wrapProgress(resp.Body), end-start), buf)
+	slab.Free(buf)
+	if err == nil && n != end-start {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, attemptErr(actx, err)
+}
+
+func (p *partial) commitChunk(fh *os.File, i int, n int64) error {
+	if err := fh.Sync(); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.Done[i] = true
+	p.Offset += n
+	g.store.setPartial(p.uname, &p.partialRec)
+	p.mu.Unlock()
+	return nil
+}
+
+// checksum the assembled workfile
+func (p *partial) verify(expct *cos.Cksum, cname string) error {
+	fh, err := os.Open(p.Wfqn)
+	if err != nil {
+		return err
+	}
+	_, cksum, err := cos.CopyAndChecksum(io.Discard, fh, nil, expct.Ty())
+	cos.Close(fh)
+	if err != nil {
+		return err
+	}
+	if !cksum.Equal(expct) {
+		return cos.NewErrDataCksum(&cksum.Cksum, expct, cname)
+	}
+	return nil
+}
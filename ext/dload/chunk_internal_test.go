@@ -0,0 +1,177 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFetchChunks(t *testing.T) {
+	const (
+		csz  = 1000
+		size = 10*csz + 1 // (the last chunk is 1 byte)
+	)
+	driver, err := kvdb.NewBuntDB(":memory:")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { driver.Close() })
+	store := g.store
+	g.store = &infoStore{downloaderDB: newDownloadDB(driver)}
+	t.Cleanup(func() { g.store = store })
+
+	config := cmn.GCO.BeginUpdate()
+	backoff := config.Downloader.RetryBackoff
+	config.Downloader.RetryBackoff = cos.Duration(time.Millisecond)
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Downloader.RetryBackoff = backoff
+		cmn.GCO.CommitUpdate(config)
+	})
+
+	var (
+		content = bytes.Repeat([]byte("0123456789abcdefghijklmnopqrstuvwxyz"), size/36+1)[:size]
+		mu      sync.Mutex
+		fails   = map[string]int{} // range => the number of times to fail it
+		ranges  []string
+	)
+	content[size/2] = 'X' // (not a repeating pattern)
+	setFails := func(rng string, n int) {
+		mu.Lock()
+		if n == 0 {
+			delete(fails, rng)
+		} else {
+			fails[rng] = n
+		}
+		mu.Unlock()
+	}
+	numRanges := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(ranges)
+	}
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rng := r.Header.Get(cos.HdrRange)
+		mu.Lock()
+		ranges = append(ranges, rng)
+		n := fails[rng]
+		if n != 0 {
+			fails[rng] = n - 1
+		}
+		mu.Unlock()
+		switch {
+		case n > 0:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case n < 0:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.Header().Set(cos.HdrETag, `"v1"`)
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+		}
+	}))
+	defer origin.Close()
+	client := g.clientH
+	g.clientH = origin.Client()
+	t.Cleanup(func() { g.clientH = client })
+
+	newTask := func() (*singleTask, *partial) {
+		task := &singleTask{
+			xdl: &Xact{dispatcher: &dispatcher{}},
+			job: &sliceDlJob{baseDlJob: baseDlJob{notif: &NotifDownload{}}},
+			obj: dlObj{objName: "obj", link: origin.URL, timeout: time.Minute},
+		}
+		task.init()
+		task.getCtx = task.downloadCtx
+		p := &partial{uname: "chunked"}
+		p.Link, p.Size, p.Validator = origin.URL, size, `"v1"`
+		p.Wfqn = filepath.Join(t.TempDir(), "workfile")
+		p.ChunkSize, p.Done = csz, make([]bool, numChunks(size, csz))
+		task.part = p
+		return task, p
+	}
+	assembled := func(p *partial) []byte {
+		b, err := os.ReadFile(p.Wfqn)
+		tassert.CheckFatal(t, err)
+		return b
+	}
+
+	// a transient failure gets retried at the chunk level
+	task, p := newTask()
+	setFails("bytes=3000-3999", 1)
+	fatal, err := p.fetchChunks(task, origin.URL, http.NoBody)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !fatal, "expected non-fatal")
+	tassert.Fatalf(t, bytes.Equal(assembled(p), content), "assembled content mismatch")
+	tassert.Errorf(t, p.Offset == size && task.currentSize.Load() == size, "expected %d committed, got (%d, %d)",
+		size, p.Offset, task.currentSize.Load())
+	tassert.Errorf(t, p.resumeAt() == size, "expected all chunks done, got resume at %d", p.resumeAt())
+	tassert.Errorf(t, numRanges() == numChunks(size, csz)+1, "expected a single retry, got %d requests", numRanges())
+	rec := g.store.partial(p.uname)
+	tassert.Fatalf(t, rec != nil && rec.Offset == size, "expected the progress to be persisted, got %+v", rec)
+
+	// whole-object checksum of the assembled workfile
+	var (
+		good = cos.NewCksumHash(cos.ChecksumMD5)
+		bad  = cos.NewCksum(cos.ChecksumMD5, strings.Repeat("0", 32))
+	)
+	good.H.Write(content)
+	good.Finalize()
+	tassert.CheckError(t, p.verify(&good.Cksum, "obj"))
+	err = p.verify(bad, "obj")
+	tassert.Errorf(t, isErrCksum(err), "expected checksum mismatch, got %v", err)
+
+	// chunk retries exhausted: the next (whole-object) attempt resumes with the remaining chunks
+	task, p = newTask()
+	setFails("bytes=5000-5999", 100)
+	_, err = p.fetchChunks(task, origin.URL, http.NoBody)
+	var errI *errInterrupted
+	tassert.Fatalf(t, errors.As(err, &errI), "expected %T, got %v", errI, err)
+	tassert.Errorf(t, !p.Done[5] && p.resumeAt() <= 5*csz, "expected chunk 5 pending, got resume at %d", p.resumeAt())
+	remaining := len(p.pending())
+
+	setFails("bytes=5000-5999", 0)
+	mu.Lock()
+	ranges = ranges[:0]
+	mu.Unlock()
+	_, err = p.fetchChunks(task, origin.URL, http.NoBody)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(assembled(p), content), "assembled content mismatch (resumed)")
+	tassert.Errorf(t, numRanges() == remaining, "expected only the remaining %d chunks to be fetched, got %d", remaining, numRanges())
+
+	// non-retriable
+	task, p = newTask()
+	setFails("bytes=0-999", -1)
+	_, err = p.fetchChunks(task, origin.URL, http.NoBody)
+	herr := cmn.Err2HTTPErr(err)
+	tassert.Errorf(t, herr != nil && !errors.As(err, &errI) && herr.Status == http.StatusForbidden, "expected 403, got %v", err)
+
+	// the content has changed: start anew
+	setFails("bytes=0-999", 0)
+	task, p = newTask()
+	p.Validator = `"v0"`
+	_, err = p.fetchChunks(task, origin.URL, http.NoBody)
+	tassert.Fatalf(t, errors.As(err, &errI) && errors.Is(err, errChunkMismatch), "expected chunk mismatch, got %v", err)
+	tassert.Errorf(t, errI.offset == 0 && task.part == nil, "expected the partial download to be discarded")
+
+	// canceled
+	task, p = newTask()
+	task.cancel()
+	_, err = p.fetchChunks(task, origin.URL, http.NoBody)
+	tassert.Errorf(t, errors.Is(err, context.Canceled), "expected canceled, got %v", err)
+}
@@ -0,0 +1,115 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Checksum verification: the expected checksum comes from (in the order of precedence)
+// the object itself (see `ObjChecksum`), the job's checksum manifest (`Base.CksumManifest`),
+// or the origin (`Base.VerifyOrigin`). The reader computes the digest of the source content
+// as it streams and, upon EOF, fails the read (and, therefore, the PUT) on mismatch - so that
+// the workfile never gets committed. Post-processing, if any, applies on top of the verified
+// content (see `_dput`).
+
+type cksumReader struct {
+	r     io.ReadCloser
+	expct *cos.Cksum
+	h     *cos.CksumHash
+	cname string
+	err   error // sticky: mismatch or io.EOF
+}
+
+// interface guard
+var _ io.ReadCloser = (*cksumReader)(nil)
+
+func newCksumReader(r io.ReadCloser, expct *cos.Cksum, cname string) *cksumReader {
+	return &cksumReader{r: r, expct: expct, h: cos.NewCksumHash(expct.Ty()), cname: cname}
+}
+
+func (cr *cksumReader) Read(b []byte) (n int, err error) {
+	if cr.err != nil {
+		return 0, cr.err
+	}
+	n, err = cr.r.Read(b)
+	if n > 0 {
+		cr.h.H.Write(b[:n])
+	}
+	if err != io.EOF {
+		return n, err
+	}
+	cr.h.Finalize()
+	if cr.h.Equal(cr.expct) {
+		cr.err = io.EOF
+	} else {
+		cr.err = cos.NewErrDataCksum(&cr.h.Cksum, cr.expct, cr.cname)
+	}
+	return n, cr.err
+}
+
+func (cr *cksumReader) Close() error { return cr.r.Close() }
+
+func isErrCksum(err error) bool {
+	var errCk *cos.ErrBadCksum
+	return errors.As(err, &errCk)
+}
+
+// expected checksum of the content to be received, if any (nil otherwise)
+func (task *singleTask) expectedCksum(resp *http.Response) (*cos.Cksum, error) {
+	if task.obj.cksum != nil {
+		return task.obj.cksum, nil
+	}
+	if m := task.job.manifest(); m != nil {
+		// (the expected checksum is the object's, whichever mirror serves it)
+		cksum, err := m.lookup(task.obj.objName, task.obj.link)
+		if err != nil || cksum != nil {
+			return cksum, err
+		}
+	}
+	if task.job.verifyOrigin() {
+		return originCksum(resp), nil
+	}
+	return nil, nil
+}
+
+func wrapCksum(r io.ReadCloser, cksum *cos.Cksum, lom *core.LOM) io.ReadCloser {
+	if cksum == nil {
+		return r
+	}
+	return newCksumReader(r, cksum, lom.Cname())
+}
+
+// MD5 of the entire content as advertised by the origin: `Content-MD5` (base64) or,
+// if absent, a strong ETag that looks like a plain MD5 (e.g., S3 single-part upload);
+// NOTE: `Content-MD5` of a (resumed) partial response covers the range only, and is ignored
+func originCksum(resp *http.Response) *cos.Cksum {
+	if v := resp.Header.Get(cos.HdrContentMD5); v != "" && resp.StatusCode != http.StatusPartialContent {
+		if b, err := base64.StdEncoding.DecodeString(v); err == nil && len(b) == 16 {
+			return cos.NewCksum(cos.ChecksumMD5, hex.EncodeToString(b))
+		}
+	}
+	etag := resp.Header.Get(cos.HdrETag)
+	if etag == "" || strings.HasPrefix(etag, "W/") {
+		return nil
+	}
+	etag = strings.ToLower(cmn.UnquoteCEV(etag))
+	if len(etag) != 32 {
+		return nil
+	}
+	if _, err := hex.DecodeString(etag); err != nil {
+		return nil
+	}
+	return cos.NewCksum(cos.ChecksumMD5, etag)
+}
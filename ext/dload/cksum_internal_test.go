@@ -0,0 +1,163 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCksumReader(t *testing.T) {
+	const content = "the quick brown fox jumps over the lazy dog"
+	sum := sha256.Sum256([]byte(content))
+	good := cos.NewCksum(cos.ChecksumSHA256, hex.EncodeToString(sum[:]))
+	bad := cos.NewCksum(cos.ChecksumSHA256, strings.Repeat("0", 64))
+
+	for _, r := range []io.Reader{strings.NewReader(content), iotest.OneByteReader(strings.NewReader(content))} {
+		b, err := io.ReadAll(newCksumReader(io.NopCloser(r), good, "bck/obj"))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, string(b) == content, "expected %q, got %q", content, string(b))
+	}
+
+	cr := newCksumReader(io.NopCloser(strings.NewReader(content)), bad, "bck/obj")
+	_, err := io.ReadAll(cr)
+	tassert.Fatalf(t, isErrCksum(err), "expected checksum mismatch, got %v", err)
+	_, err = cr.Read(make([]byte, 8))
+	tassert.Errorf(t, isErrCksum(err), "expected sticky checksum mismatch, got %v", err)
+}
+
+func TestOriginCksum(t *testing.T) {
+	sum := md5.Sum([]byte("content"))
+	hexv := hex.EncodeToString(sum[:])
+	tests := []struct {
+		key, val string
+		status   int
+		value    string // expected (empty: none)
+	}{
+		{"", "", http.StatusOK, ""},
+		{cos.HdrContentMD5, base64.StdEncoding.EncodeToString(sum[:]), http.StatusOK, hexv},
+		{cos.HdrContentMD5, base64.StdEncoding.EncodeToString(sum[:]), http.StatusPartialContent, ""},
+		{cos.HdrETag, `"` + strings.ToUpper(hexv) + `"`, http.StatusOK, hexv},
+		{cos.HdrETag, `W/"` + hexv + `"`, http.StatusOK, ""},
+		{cos.HdrETag, `"` + hexv + `-4"`, http.StatusOK, ""}, // multipart
+		{cos.HdrETag, `"abc"`, http.StatusOK, ""},
+	}
+	for _, test := range tests {
+		hdr := make(http.Header)
+		if test.key != "" {
+			hdr.Set(test.key, test.val)
+		}
+		cksum := originCksum(&http.Response{Header: hdr, StatusCode: test.status})
+		if test.value == "" {
+			tassert.Errorf(t, cksum == nil, "%v (%d): expected none, got %s", hdr, test.status, cksum)
+			continue
+		}
+		tassert.Fatalf(t, cksum != nil, "%v (%d): expected %s", hdr, test.status, test.value)
+		tassert.Errorf(t, cksum.Ty() == cos.ChecksumMD5 && cksum.Val() == test.value,
+			"%v (%d): expected md5 %s, got %s", hdr, test.status, test.value, cksum)
+	}
+}
+
+func TestObjChecksumsValidate(t *testing.T) {
+	b := &MultiBody{ObjectsPayload: []any{"https://example.com/a"}}
+	b.Bck.Name = "bck"
+	for _, ck := range []ObjChecksum{{}, {Type: cos.ChecksumNone, Value: "abc"}, {Type: "no-such-type", Value: "abc"}} {
+		b.ObjChecksums = map[string]ObjChecksum{"a": ck}
+		tassert.Errorf(t, b.Validate() != nil, "expected invalid checksum %+v", ck)
+	}
+	b.ObjChecksums = map[string]ObjChecksum{"a": {Value: "ABC"}}
+	tassert.CheckFatal(t, b.Validate())
+	tassert.Errorf(t, b.ObjChecksums["a"].Type == cos.ChecksumSHA256, "expected default type, got %q", b.ObjChecksums["a"].Type)
+
+	objs := []dlObj{{objName: "a"}, {objName: "b"}}
+	tassert.CheckFatal(t, setCksums(objs, b.ObjChecksums))
+	tassert.Errorf(t, objs[0].cksum != nil && objs[0].cksum.Val() == "abc", "expected checksum of 'a', got %s", objs[0].cksum)
+	tassert.Errorf(t, objs[1].cksum == nil, "expected no checksum of 'b', got %s", objs[1].cksum)
+
+	s := &SingleObj{Link: "https://example.com/a", ChecksumType: cos.ChecksumMD5}
+	tassert.Errorf(t, s.Validate() != nil, "expected 'checksum_type' to require 'checksum'")
+}
+
+func TestCksumManifestLoad(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	backoff := config.Downloader.RetryBackoff
+	config.Downloader.RetryBackoff = cos.Duration(time.Millisecond)
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Downloader.RetryBackoff = backoff
+		cmn.GCO.CommitUpdate(config)
+	})
+
+	const sum = "d41d8cd98f00b204e9800998ecf8427e"
+	var (
+		fails  atomic.Int32 // the number of times to respond with `status`
+		status atomic.Int32
+		cnt    atomic.Int32
+	)
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		cnt.Add(1)
+		if fails.Add(-1) >= 0 {
+			w.WriteHeader(int(status.Load()))
+			return
+		}
+		io.WriteString(w, sum+"  dir/obj.bin\n")
+	}))
+	defer origin.Close()
+	g.clientH = origin.Client()
+
+	newManifest := func(n int32, code int) *cksumManifest {
+		fails.Store(n)
+		status.Store(int32(code))
+		cnt.Store(0)
+		return newCksumManifest(&CksumManifest{URL: origin.URL, Type: cos.ChecksumMD5})
+	}
+
+	// not loaded
+	m := newManifest(0, 0)
+	_, err := m.lookup("dir/obj.bin", "")
+	tassert.Errorf(t, err != nil, "expected error prior to load")
+
+	// transient failure gets retried
+	m = newManifest(1, http.StatusServiceUnavailable)
+	tassert.CheckFatal(t, m.load(context.Background(), time.Minute))
+	tassert.Errorf(t, cnt.Load() == 2, "expected a single retry, got %d requests", cnt.Load())
+	cksum, err := m.lookup("dir/obj.bin", "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cksum != nil && cksum.Value() == sum, "expected %s, got %v", sum, cksum)
+
+	// non-retriable
+	m = newManifest(1, http.StatusNotFound)
+	err = m.load(context.Background(), time.Minute)
+	tassert.Errorf(t, errStatus(err) == http.StatusNotFound && cnt.Load() == 1, "expected 404 (no retries), got %v (%d)", err, cnt.Load())
+
+	// retries exhausted
+	m = newManifest(1000, http.StatusServiceUnavailable)
+	err = m.load(context.Background(), time.Minute)
+	retries := cmn.GCO.Get().Downloader.MaxRetryCount()
+	tassert.Errorf(t, err != nil && int(cnt.Load()) == retries+1, "expected %d attempts, got %d (%v)", retries+1, cnt.Load(), err)
+
+	// canceled
+	m = newManifest(1000, http.StatusServiceUnavailable)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = m.load(ctx, time.Minute)
+	tassert.Errorf(t, err != nil && cnt.Load() <= 1, "expected canceled, got %v (%d)", err, cnt.Load())
+}
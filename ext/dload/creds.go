@@ -0,0 +1,374 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Per-origin credentials (see `DownloaderConf.Credentials`).
+//
+// The credentials file is local to each target and is never part of the request body;
+// it is a JSON object keyed by origin host (optionally, "*.<domain>" to match all subdomains):
+//
+//	{
+//	  "s3.us-west-2.amazonaws.com": {
+//	    "type": "sigv4", "access_key": "...", "secret_key": "...", "region": "us-west-2", "service": "s3"
+//	  },
+//	  "legacy-origin": {
+//	    "type": "basic", "username": "...", "password": "..."
+//	  }
+//	}
+//
+// Unlike the rest, "basic" entries are keyed by name rather than host: a job references
+// its entry explicitly (see `Base.BasicAuth`), and the entry applies to that job's links only.
+//
+// Secrets are never logged: neither the credentials themselves nor (parsing) errors that may contain them.
+
+const (
+	CredsSigV4 = "sigv4"
+	CredsBasic = "basic"
+)
+
+const (
+	sigV4Algorithm = "AWS4-HMAC-SHA256"
+	sigV4DateFmt   = "20060102T150405Z"
+	sigV4Request   = "aws4_request"
+
+	// (all downloader requests are GET or HEAD with no body)
+	emptyPayloadSha256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type (
+	// CredsProvider signs (or otherwise augments) outgoing requests to a given origin
+	CredsProvider interface {
+		Sign(req *http.Request, now time.Time) error
+	}
+
+	// credentials file entry
+	credsEntry struct {
+		Type         string `json:"type"`
+		AccessKey    secret `json:"access_key"`
+		SecretKey    secret `json:"secret_key"`
+		SessionToken secret `json:"session_token,omitempty"`
+		Region       string `json:"region,omitempty"`
+		Service      string `json:"service,omitempty"`
+		Username     secret `json:"username,omitempty"`
+		Password     secret `json:"password,omitempty"`
+	}
+
+	// origin host => provider
+	credsRegistry struct {
+		exact  map[string]CredsProvider
+		suffix map[string]CredsProvider // "*.example.com" => ".example.com"
+		named  map[string]CredsProvider // "basic" entries (referenced by name)
+	}
+
+	// AWS Signature Version 4
+	sigV4 struct {
+		accessKey, secretKey, token secret
+		region, service             string
+	}
+
+	// HTTP Basic authentication
+	basicAuth struct {
+		username, password secret
+	}
+
+	// redacted when printed
+	secret string
+)
+
+// interface guard
+var (
+	_ CredsProvider = (*sigV4)(nil)
+	_ fmt.Stringer  = (*sigV4)(nil)
+	_ CredsProvider = (*basicAuth)(nil)
+	_ fmt.Stringer  = (*basicAuth)(nil)
+)
+
+var credsFactories = map[string]func(*credsEntry) (CredsProvider, error){
+	CredsSigV4: newSigV4Entry,
+	CredsBasic: newBasicAuthEntry,
+}
+
+func (secret) String() string   { return "****" }
+func (secret) GoString() string { return "****" }
+
+func loadCreds(fqn string) (*credsRegistry, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %q: %w", fqn, err)
+	}
+	var entries map[string]*credsEntry
+	if err := jsoniter.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %q (invalid JSON)", fqn) // (not including err)
+	}
+	cr := &credsRegistry{
+		exact:  make(map[string]CredsProvider, len(entries)),
+		suffix: make(map[string]CredsProvider, 2),
+		named:  make(map[string]CredsProvider, 2),
+	}
+	for host, entry := range entries {
+		if host == "" || entry == nil {
+			return nil, fmt.Errorf("credentials file %q: invalid entry for host %q", fqn, host)
+		}
+		factory, ok := credsFactories[entry.Type]
+		if !ok {
+			return nil, fmt.Errorf("credentials file %q: host %q: unsupported type %q", fqn, host, entry.Type)
+		}
+		p, err := factory(entry)
+		if err != nil {
+			return nil, fmt.Errorf("credentials file %q: host %q: %v", fqn, host, err)
+		}
+		if entry.Type == CredsBasic {
+			cr.named[host] = p
+			continue
+		}
+		host = strings.ToLower(host)
+		if strings.HasPrefix(host, "*.") {
+			cr.suffix[host[1:]] = p
+		} else {
+			cr.exact[host] = p
+		}
+	}
+	return cr, nil
+}
+
+func (cr *credsRegistry) lookup(host string) CredsProvider {
+	host = strings.ToLower(host)
+	if p, ok := cr.exact[host]; ok {
+		return p
+	}
+	for {
+		i := strings.IndexByte(host, '.')
+		if i < 0 {
+			return nil
+		}
+		host = host[i:] // ".example.com"
+		if p, ok := cr.suffix[host]; ok {
+			return p
+		}
+		host = host[1:]
+	}
+}
+
+// sign the request if there's a provider for its origin (no-op otherwise)
+func signReq(req *http.Request) error {
+	cr := g.creds.Load()
+	if cr == nil {
+		return nil
+	}
+	p := cr.lookup(req.URL.Host)
+	if p == nil {
+		return nil
+	}
+	if err := p.Sign(req, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request to %q: %v", req.URL.Host, err)
+	}
+	return nil
+}
+
+// resolve job's credentials by name (see `Base.BasicAuth`)
+func credsRef(name string) (CredsProvider, error) {
+	if name == "" {
+		return nil, nil
+	}
+	cr := g.creds.Load()
+	if cr == nil {
+		return nil, fmt.Errorf("credentials %q not found (see 'downloader.credentials')", name)
+	}
+	p, ok := cr.named[name]
+	if !ok {
+		return nil, fmt.Errorf("credentials %q not found in %q", name, cmn.GCO.Get().Downloader.Credentials)
+	}
+	return p, nil
+}
+
+// job's own credentials (`auth`), if any, take precedence over the origin's
+func authReq(req *http.Request, auth CredsProvider) error {
+	if auth == nil {
+		return signReq(req)
+	}
+	return auth.Sign(req, time.Now())
+}
+
+///////////////
+// basicAuth //
+///////////////
+
+func NewBasicAuth(username, password string) (CredsProvider, error) {
+	if username == "" {
+		return nil, errors.New("missing username")
+	}
+	return &basicAuth{username: secret(username), password: secret(password)}, nil
+}
+
+func newBasicAuthEntry(e *credsEntry) (CredsProvider, error) {
+	return NewBasicAuth(string(e.Username), string(e.Password))
+}
+
+func (*basicAuth) String() string { return CredsBasic }
+
+func (p *basicAuth) Sign(req *http.Request, _ time.Time) error {
+	req.SetBasicAuth(string(p.username), string(p.password))
+	return nil
+}
+
+///////////
+// sigV4 //
+///////////
+
+func NewSigV4(accessKey, secretKey, token, region, service string) (CredsProvider, error) {
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("missing access and/or secret key")
+	}
+	if region == "" {
+		return nil, errors.New("missing region")
+	}
+	if service == "" {
+		service = "s3"
+	}
+	return &sigV4{
+		accessKey: secret(accessKey),
+		secretKey: secret(secretKey),
+		token:     secret(token),
+		region:    region,
+		service:   service,
+	}, nil
+}
+
+// (unexported fields are printed as is - hence, explicitly)
+func (p *sigV4) String() string { return CredsSigV4 + "[" + p.region + ", " + p.service + "]" }
+
+func newSigV4Entry(e *credsEntry) (CredsProvider, error) {
+	return NewSigV4(string(e.AccessKey), string(e.SecretKey), string(e.SessionToken), e.Region, e.Service)
+}
+
+func (p *sigV4) Sign(req *http.Request, now time.Time) error {
+	var (
+		amzDate = now.UTC().Format(sigV4DateFmt)
+		date    = amzDate[:8]
+		host    = req.Host
+	)
+	if host == "" {
+		host = req.URL.Host
+	}
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadSha256)
+	if p.token != "" {
+		req.Header.Set("X-Amz-Security-Token", string(p.token))
+	}
+
+	// canonical (and signed) headers: host, range, and x-amz-*
+	hdrs := map[string]string{"host": host}
+	for name, values := range req.Header {
+		lname := strings.ToLower(name)
+		if lname == "range" || strings.HasPrefix(lname, "x-amz-") {
+			hdrs[lname] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(hdrs))
+	for name := range hdrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(name)
+		sb.WriteByte(':')
+		sb.WriteString(hdrs[name])
+		sb.WriteByte('\n')
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	creq := strings.Join([]string{
+		req.Method,
+		sigV4Path(req.URL.Path),
+		sigV4Query(req.URL.Query()),
+		sb.String(),
+		signedHeaders,
+		emptyPayloadSha256,
+	}, "\n")
+	scope := date + "/" + p.region + "/" + p.service + "/" + sigV4Request
+	digest := sha256.Sum256(cos.UnsafeB(creq))
+	sts := sigV4Algorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(digest[:])
+
+	key := _hmac([]byte("AWS4"+string(p.secretKey)), date)
+	key = _hmac(key, p.region)
+	key = _hmac(key, p.service)
+	key = _hmac(key, sigV4Request)
+	signature := hex.EncodeToString(_hmac(key, sts))
+
+	req.Header.Set(apc.HdrAuthorization, sigV4Algorithm+" Credential="+string(p.accessKey)+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+	return nil
+}
+
+func _hmac(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(cos.UnsafeB(data))
+	return h.Sum(nil)
+}
+
+// URI-encode each path segment (RFC 3986 unreserved characters only)
+func sigV4Path(p string) string {
+	if p == "" {
+		return "/"
+	}
+	segs := strings.Split(p, "/")
+	for i, seg := range segs {
+		segs[i] = sigV4Escape(seg)
+	}
+	return strings.Join(segs, "/")
+}
+
+func sigV4Query(q map[string][]string) string {
+	if len(q) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(q))
+	for k, values := range q {
+		ek := sigV4Escape(k)
+		for _, v := range values {
+			pairs = append(pairs, ek+"="+sigV4Escape(v))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func sigV4Escape(s string) string {
+	const hexdigits = "0123456789ABCDEF"
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := range len(s) {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hexdigits[c>>4])
+		sb.WriteByte(hexdigits[c&0xf])
+	}
+	return sb.String()
+}
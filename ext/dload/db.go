@@ -7,18 +7,30 @@ package dload
 import (
 	"errors"
 	"path"
+	"strings"
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
 )
 
 const (
 	downloaderErrors     = "errors"
 	downloaderTasks      = "tasks"
+	downloaderSpill      = "spill"   // see spill.go
+	downloaderPartial    = "partial" // see resume.go
+	downloaderObjects    = "objects" // per-object state: objects/<job-id>/<obj-name>
+	downloaderVersion    = "version" // schema version (see `migrate`)
 	downloaderCollection = "downloads"
 
+	// current schema version:
+	// 0 (or none) - job's finished tasks and errors only;
+	// 1           - added per-object state (see `ObjState`)
+	dbVersion = 1
+
 	// Number of errors stored in memory. When the number of errors exceeds
 	// this number, then all errors will be flushed to disk
 	errCacheSize = 100
@@ -39,11 +51,67 @@ type downloaderDB struct {
 }
 
 func newDownloadDB(driver kvdb.Driver) *downloaderDB {
-	return &downloaderDB{
+	db := &downloaderDB{
 		driver:        driver,
 		errCache:      make(map[string][]TaskErrInfo, 10),
 		taskInfoCache: make(map[string][]TaskDlInfo, 10),
 	}
+	if err := db.migrate(); err != nil {
+		nlog.Errorln("failed to migrate downloader DB:", err)
+	}
+	return db
+}
+
+// upgrade the existing DB contents to the current schema (`dbVersion`):
+// prior to v1, the outcome of a job was recorded only as the lists of its finished
+// tasks and errors - the per-object state is derived from those (and the lists remain)
+func (db *downloaderDB) migrate() error {
+	var version int
+	if _, err := db.driver.Get(downloaderCollection, downloaderVersion, &version); err != nil && !cos.IsErrNotFound(err) {
+		return err
+	}
+	if version >= dbVersion {
+		return nil
+	}
+	keys, _, err := db.driver.List(downloaderCollection, downloaderTasks+"/")
+	if err != nil && !cos.IsErrNotFound(err) {
+		return err
+	}
+	for _, key := range keys {
+		var tasks []TaskDlInfo
+		if _, err := db.driver.Get(downloaderCollection, key, &tasks); err != nil {
+			return err
+		}
+		id := path.Base(key)
+		for i := range tasks {
+			st := &ObjState{State: ObjFinished, Size: tasks[i].Downloaded, EndTime: tasks[i].EndTime}
+			if _, err := db.driver.Set(downloaderCollection, objStateKey(id, tasks[i].Name), st); err != nil {
+				return err
+			}
+		}
+	}
+	keys, _, err = db.driver.List(downloaderCollection, downloaderErrors+"/")
+	if err != nil && !cos.IsErrNotFound(err) {
+		return err
+	}
+	for _, key := range keys {
+		var errs []TaskErrInfo
+		if _, err := db.driver.Get(downloaderCollection, key, &errs); err != nil {
+			return err
+		}
+		id := path.Base(key)
+		for i := range errs {
+			st := &ObjState{State: ObjFailed, Err: errs[i].Err}
+			if _, err := db.driver.Set(downloaderCollection, objStateKey(id, errs[i].Name), st); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := db.driver.Set(downloaderCollection, downloaderVersion, dbVersion); err != nil {
+		return err
+	}
+	nlog.Infoln("downloader DB: migrated from version", version, "to", dbVersion)
+	return nil
 }
 
 func (db *downloaderDB) errors(id string) (errs []TaskErrInfo, _ error) {
@@ -67,11 +135,10 @@ func (db *downloaderDB) getErrors(id string) ([]TaskErrInfo, error) {
 	return db.errors(id)
 }
 
-func (db *downloaderDB) persistError(id, objName, errMsg string) {
+func (db *downloaderDB) persistError(id string, errInfo TaskErrInfo) {
 	db.mtx.Lock()
 	defer db.mtx.Unlock()
 
-	errInfo := TaskErrInfo{Name: objName, Err: errMsg}
 	if len(db.errCache[id]) < errCacheSize { // if possible store error in cache
 		db.errCache[id] = append(db.errCache[id], errInfo)
 		return
@@ -177,11 +244,113 @@ func (db *downloaderDB) flush(id string) error {
 	return nil
 }
 
+func (db *downloaderDB) spillTasks(key string, tasks []spilledTask) error {
+	db.mtx.Lock()
+	_, err := db.driver.Set(downloaderCollection, key, tasks)
+	db.mtx.Unlock()
+	return err
+}
+
+// load and delete
+func (db *downloaderDB) unspillTasks(key string) (tasks []spilledTask, _ error) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+	if _, err := db.driver.Get(downloaderCollection, key, &tasks); err != nil {
+		return nil, err
+	}
+	db.driver.Delete(downloaderCollection, key)
+	return tasks, nil
+}
+
+func (db *downloaderDB) deleteSpilled(key string) {
+	db.mtx.Lock()
+	db.driver.Delete(downloaderCollection, key)
+	db.mtx.Unlock()
+}
+
 func (db *downloaderDB) delete(id string) {
 	db.mtx.Lock()
 	key := path.Join(downloaderErrors, id)
 	db.driver.Delete(downloaderCollection, key)
 	key = path.Join(downloaderTasks, id)
 	db.driver.Delete(downloaderCollection, key)
+	keys, _, _ := db.driver.List(downloaderCollection, objStateKey(id, ""))
+	for _, key := range keys {
+		db.driver.Delete(downloaderCollection, key)
+	}
+	db.mtx.Unlock()
+}
+
+//
+// per-object state
+//
+
+func objStateKey(id, objName string) string { return downloaderObjects + "/" + id + "/" + objName }
+
+func (db *downloaderDB) setObjState(id, objName string, st *ObjState) {
+	db.mtx.Lock()
+	code, err := db.driver.Set(downloaderCollection, objStateKey(id, objName), st)
+	db.mtx.Unlock()
+	if err != nil {
+		nlog.Errorln(err, code)
+	}
+}
+
+// all persisted object states of a given job: objName => state
+func (db *downloaderDB) objStates(id string) (map[string]*ObjState, error) {
+	prefix := objStateKey(id, "")
+	db.mtx.RLock()
+	all, code, err := db.driver.GetAll(downloaderCollection, prefix)
+	db.mtx.RUnlock()
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			return nil, nil
+		}
+		nlog.Errorln(err, code)
+		return nil, err
+	}
+	states := make(map[string]*ObjState, len(all))
+	for key, val := range all {
+		st := &ObjState{}
+		if err := jsoniter.UnmarshalFromString(val, st); err != nil {
+			nlog.Errorln("invalid object state", key+":", err)
+			continue
+		}
+		states[strings.TrimPrefix(key, prefix)] = st
+	}
+	return states, nil
+}
+
+// (see resume.go)
+func (db *downloaderDB) partial(uname string) *partialRec {
+	var (
+		rec = &partialRec{}
+		key = path.Join(downloaderPartial, uname)
+	)
+	db.mtx.RLock()
+	code, err := db.driver.Get(downloaderCollection, key, rec)
+	db.mtx.RUnlock()
+	if err != nil {
+		if !cos.IsErrNotFound(err) {
+			nlog.Errorln(err, code)
+		}
+		return nil
+	}
+	return rec
+}
+
+func (db *downloaderDB) setPartial(uname string, rec *partialRec) {
+	key := path.Join(downloaderPartial, uname)
+	db.mtx.Lock()
+	code, err := db.driver.Set(downloaderCollection, key, rec)
+	db.mtx.Unlock()
+	if err != nil {
+		nlog.Errorln(err, code)
+	}
+}
+
+func (db *downloaderDB) deletePartial(uname string) {
+	db.mtx.Lock()
+	db.driver.Delete(downloaderCollection, path.Join(downloaderPartial, uname))
 	db.mtx.Unlock()
 }
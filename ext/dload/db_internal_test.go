@@ -0,0 +1,57 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"path"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjStates(t *testing.T) {
+	driver, err := kvdb.NewBuntDB(":memory:")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { driver.Close() })
+
+	// legacy (pre-v1) contents: finished tasks and errors only
+	tasks := []TaskDlInfo{{Name: "a", Downloaded: 10}, {Name: "dir/b", Downloaded: 20}}
+	errs := []TaskErrInfo{{Name: "c", Err: "404"}}
+	_, err = driver.Set(downloaderCollection, path.Join(downloaderTasks, "job1"), tasks)
+	tassert.CheckFatal(t, err)
+	_, err = driver.Set(downloaderCollection, path.Join(downloaderErrors, "job1"), errs)
+	tassert.CheckFatal(t, err)
+
+	db := newDownloadDB(driver)
+	states, err := db.objStates("job1")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(states) == 3, "expected 3 migrated states, got %d", len(states))
+	tassert.Errorf(t, states["dir/b"] != nil && states["dir/b"].State == ObjFinished && states["dir/b"].Size == 20,
+		"unexpected state of 'dir/b': %+v", states["dir/b"])
+	tassert.Errorf(t, states["c"] != nil && states["c"].State == ObjFailed && states["c"].Err == "404",
+		"unexpected state of 'c': %+v", states["c"])
+
+	// migrated once
+	var version int
+	_, err = driver.Get(downloaderCollection, downloaderVersion, &version)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, version == dbVersion, "expected version %d, got %d", dbVersion, version)
+
+	// updates (the latest wins), and no cross-talk between jobs with common prefix
+	db.setObjState("job1", "c", &ObjState{State: ObjRunning})
+	db.setObjState("job10", "x", &ObjState{State: ObjPending})
+	states, err = db.objStates("job1")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(states) == 3 && states["c"].State == ObjRunning, "unexpected states: %v", states)
+
+	db.delete("job1")
+	states, err = db.objStates("job1")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(states) == 0, "expected no states after delete, got %v", states)
+	states, err = db.objStates("job10")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(states) == 1, "expected job10 intact, got %v", states)
+}
@@ -0,0 +1,94 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Content-Encoding (see `Base.DecompressEncoding`): by default, the downloader stores the content
+// exactly as received - e.g., a pre-gzipped archive stays gzipped. With decoding enabled, gzip- and
+// deflate-encoded responses get decoded on the fly, and the object stores the decoded content.
+// Expected checksums, if any, apply to the content as received (that is, prior to decoding),
+// while validation, progress, and post-processing apply to the decoded one.
+
+const (
+	encGzip     = "gzip"
+	encXGzip    = "x-gzip"
+	encDeflate  = "deflate"
+	encIdentity = "identity"
+)
+
+type decoder struct {
+	dec io.ReadCloser
+	br  *bufio.Reader // (buffers the encoded content)
+	src io.ReadCloser
+}
+
+// interface guard
+var _ io.ReadCloser = (*decoder)(nil)
+
+// the response's content encoding to decode, if any (empty when the content is to be stored as is)
+func (task *singleTask) encoding(resp *http.Response) string {
+	if !task.job.decompress() {
+		return ""
+	}
+	enc := strings.ToLower(strings.TrimSpace(resp.Header.Get(cos.HdrContentEncoding)))
+	if enc == encIdentity {
+		return ""
+	}
+	return enc
+}
+
+func decodable(enc string) bool { return enc == encGzip || enc == encXGzip || enc == encDeflate }
+
+func newDecoder(src io.ReadCloser, enc string) (io.ReadCloser, error) {
+	var (
+		err error
+		d   = &decoder{br: bufio.NewReader(src), src: src}
+	)
+	switch enc {
+	case encDeflate:
+		// "deflate" means zlib-wrapped (RFC 9110), but some servers send raw deflate instead
+		if b, errP := d.br.Peek(2); errP == nil && isZlib(b) {
+			d.dec, err = zlib.NewReader(d.br)
+		} else {
+			d.dec = flate.NewReader(d.br)
+		}
+	default:
+		d.dec, err = gzip.NewReader(d.br)
+	}
+	return d, err
+}
+
+// zlib header: compression method 8 (deflate), and the check bits
+func isZlib(b []byte) bool { return b[0]&0x0f == 8 && (uint16(b[0])<<8|uint16(b[1]))%31 == 0 }
+
+func (d *decoder) Read(b []byte) (n int, err error) {
+	n, err = d.dec.Read(b)
+	if err == io.EOF {
+		// drain the rest of the encoded content, if any, so that checksum verification (see `cksumReader`)
+		// sees it in full
+		if _, errD := io.Copy(io.Discard, d.br); errD != nil {
+			err = errD
+		}
+	}
+	return n, err
+}
+
+func (d *decoder) Close() error {
+	if d.dec != nil {
+		d.dec.Close()
+	}
+	return d.src.Close()
+}
@@ -0,0 +1,76 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestDecoder(t *testing.T) {
+	content := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog\n", 1000))
+	encode := map[string]func(w io.Writer) io.WriteCloser{
+		encGzip:    func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		encDeflate: func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+		encDeflate + " (raw)": func(w io.Writer) io.WriteCloser {
+			fw, _ := flate.NewWriter(w, flate.DefaultCompression)
+			return fw
+		},
+	}
+	for name, newWriter := range encode {
+		var buf bytes.Buffer
+		w := newWriter(&buf)
+		_, err := w.Write(content)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, w.Close())
+		encoded := buf.Bytes()
+
+		enc := strings.Fields(name)[0]
+		r, err := newDecoder(io.NopCloser(bytes.NewReader(encoded)), enc)
+		tassert.CheckFatal(t, err)
+		decoded, err := io.ReadAll(r)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(decoded, content), "%s: decoded content differs (%d vs %d bytes)", name, len(decoded), len(content))
+
+		// checksums apply to the content as received
+		cksum := cos.NewCksum(cos.ChecksumMD5, cos.ChecksumB2S(encoded, cos.ChecksumMD5))
+		r, err = newDecoder(newCksumReader(io.NopCloser(bytes.NewReader(encoded)), cksum, "x"), enc)
+		tassert.CheckFatal(t, err)
+		_, err = io.ReadAll(r)
+		tassert.Errorf(t, err == nil, "%s: expected checksum to match, got %v", name, err)
+
+		bad := cos.NewCksum(cos.ChecksumMD5, cos.ChecksumB2S(content, cos.ChecksumMD5))
+		r, err = newDecoder(newCksumReader(io.NopCloser(bytes.NewReader(encoded)), bad, "x"), enc)
+		tassert.CheckFatal(t, err)
+		_, err = io.ReadAll(r)
+		tassert.Errorf(t, isErrCksum(err), "%s: expected checksum mismatch, got %v", name, err)
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	var (
+		off  = &singleTask{job: &sliceDlJob{}}
+		on   = &singleTask{job: &sliceDlJob{baseDlJob: baseDlJob{decode: true}}}
+		resp = &http.Response{Header: http.Header{}}
+	)
+	resp.Header.Set(cos.HdrContentEncoding, "GZIP")
+	tassert.Errorf(t, off.encoding(resp) == "", "expected the content as is when decoding is disabled")
+	tassert.Errorf(t, on.encoding(resp) == encGzip, "expected %q, got %q", encGzip, on.encoding(resp))
+
+	resp.Header.Set(cos.HdrContentEncoding, encIdentity)
+	tassert.Errorf(t, on.encoding(resp) == "", "expected no decoding for %q", encIdentity)
+
+	resp.Header.Set(cos.HdrContentEncoding, "br")
+	tassert.Errorf(t, on.encoding(resp) == "br" && !decodable("br"), "expected %q to be unsupported", "br")
+}
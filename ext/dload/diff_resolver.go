@@ -10,6 +10,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 )
 
@@ -28,7 +29,10 @@ type (
 		IsObjFromRemote(*core.LOM) (bool, error)
 	}
 
-	defaultDiffResolverCtx struct{}
+	defaultDiffResolverCtx struct {
+		headOnly bool // see HeadModeOnly
+		backfill bool // see BackendBody.Backfill
+	}
 
 	// DiffResolver is entity that computes difference between two streams
 	// of objects. The streams are expected to be in sorted order.
@@ -42,18 +46,23 @@ type (
 	}
 
 	BackendResource struct {
+		Bck     *meta.Bck // multi-bucket job only (nil: job's bucket)
 		ObjName string
+		Force   bool
 	}
 
 	WebResource struct {
 		ObjName string
 		Link    string
+		Force   bool
 	}
 
 	DstElement struct {
+		Bck     *meta.Bck // see BackendResource
 		ObjName string
 		Version string
 		Link    string
+		Force   bool // (re)download even if exists
 	}
 
 	DiffResolverResult struct {
@@ -127,6 +136,16 @@ func (dr *DiffResolver) Start() {
 			src, srcOk = <-dr.srcCh
 		default:
 			// src.ObjName == dst.ObjName
+			if dst.Force {
+				// per-object override takes precedence over comparing (and skipping)
+				dr.resultCh <- DiffResolverResult{
+					Action: DiffResolverRecv,
+					Dst:    dst,
+				}
+				src, srcOk = <-dr.srcCh
+				dst, dstOk = <-dr.dstCh
+				continue
+			}
 			equal, err := dr.ctx.CompareObjects(src, dst)
 			if err != nil {
 				dr.resultCh <- DiffResolverResult{
@@ -171,12 +190,15 @@ func (dr *DiffResolver) PushDst(v any) {
 	switch x := v.(type) {
 	case *BackendResource:
 		d = &DstElement{
+			Bck:     x.Bck,
 			ObjName: x.ObjName,
+			Force:   x.Force,
 		}
 	case *WebResource:
 		d = &DstElement{
 			ObjName: x.ObjName,
 			Link:    x.Link,
+			Force:   x.Force,
 		}
 	default:
 		debug.FailTypeCast(v)
@@ -231,9 +253,10 @@ func (dr *DiffResolver) cb(fqn string, job jobif) error {
 }
 
 func (dr *DiffResolver) push(job jobif, d *dispatcher) {
+	walking := job.Sync() || job.backfill()
 	defer func() {
 		dr.CloseDst()
-		if !job.Sync() {
+		if !walking {
 			dr.CloseSrc()
 		}
 	}()
@@ -257,24 +280,32 @@ func (dr *DiffResolver) push(job jobif, d *dispatcher) {
 				dr.Stop()
 				return
 			}
-			if !job.Sync() {
-				// When it is not a sync job, push LOM for a given object
-				// because we need to check if it exists.
+			if !walking {
+				// When not walking the bucket (see `walk` above), push LOM
+				// for a given object because we need to check if it exists.
 				lom := &core.LOM{ObjName: obj.objName}
-				if err := lom.InitBck(job.Bck()); err != nil {
+				bck := job.Bck()
+				if obj.bck != nil {
+					bck = obj.bck.Bucket()
+				}
+				if err := lom.InitBck(bck); err != nil {
 					dr.Abort(err)
 					return
 				}
 				dr.PushSrc(lom)
 			}
+			obj.force = obj.force || job.forceOverwrite(obj.objName)
 			if obj.link != "" {
 				dr.PushDst(&WebResource{
 					ObjName: obj.objName,
 					Link:    obj.link,
+					Force:   obj.force,
 				})
 			} else {
 				dr.PushDst(&BackendResource{
+					Bck:     obj.bck,
 					ObjName: obj.objName,
+					Force:   obj.force,
 				})
 			}
 		}
@@ -285,7 +316,13 @@ func (dr *DiffResolver) push(job jobif, d *dispatcher) {
 // defaultDiffResolverCtx //
 ////////////////////////////
 
-func (*defaultDiffResolverCtx) CompareObjects(src *core.LOM, dst *DstElement) (bool, error) {
+func (ctx *defaultDiffResolverCtx) CompareObjects(src *core.LOM, dst *DstElement) (bool, error) {
+	if ctx.headOnly {
+		return false, nil // pre-flight all links, existing or not
+	}
+	if ctx.backfill {
+		return true, nil // found by walking the bucket; not comparing
+	}
 	src.Lock(false)
 	defer src.Unlock(false)
 	if err := src.Load(true /*cache it*/, true /*locked*/); err != nil {
@@ -297,7 +334,10 @@ func (*defaultDiffResolverCtx) CompareObjects(src *core.LOM, dst *DstElement) (b
 	return CompareObjects(src, dst)
 }
 
-func (*defaultDiffResolverCtx) IsObjFromRemote(src *core.LOM) (bool, error) {
+func (ctx *defaultDiffResolverCtx) IsObjFromRemote(src *core.LOM) (bool, error) {
+	if ctx.backfill {
+		return false, nil // keep in-cluster objects that are no longer present remotely
+	}
 	if err := src.Load(true /*cache it*/, false /*locked*/); err != nil {
 		if cos.IsNotExist(err, 0) {
 			return false, nil
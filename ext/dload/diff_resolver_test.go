@@ -23,6 +23,7 @@ type (
 	obj struct {
 		name   string
 		remote bool
+		force  bool
 	}
 
 	testCase struct {
@@ -143,6 +144,17 @@ func TestDiffResolver(t *testing.T) {
 				{Action: dload.DiffResolverEOF},
 			},
 		},
+		{
+			name: "mixed_skip_force_overwrite",
+			src:  []obj{{name: "a", remote: true}, {name: "b", remote: true}, {name: "c", remote: true}},
+			dst:  []obj{{name: "a"}, {name: "b", force: true}, {name: "c"}},
+			expected: []dload.DiffResolverResult{
+				{Action: dload.DiffResolverSkip},
+				{Action: dload.DiffResolverRecv},
+				{Action: dload.DiffResolverSkip},
+				{Action: dload.DiffResolverEOF},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -159,7 +171,7 @@ func TestDiffResolver(t *testing.T) {
 			}
 			dr.CloseSrc()
 			for _, d := range test.dst {
-				dr.PushDst(&dload.BackendResource{ObjName: d.name})
+				dr.PushDst(&dload.BackendResource{ObjName: d.name, Force: d.force})
 			}
 			dr.CloseDst()
 
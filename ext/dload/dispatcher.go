@@ -6,21 +6,28 @@ package dload
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
 	"sync"
+	ratomic "sync/atomic"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact/xreg"
 
 	"golang.org/x/sync/errgroup"
@@ -29,22 +36,63 @@ import (
 // Dispatcher serves as middle layer between receiving download requests
 // and serving them to joggers which actually download objects from a remote location.
 
+// how often to check for rebalance (see `DownloaderConf.PauseOnRebalance`)
+const rebCheckIval = 2 * time.Second
+
 type (
 	dispatcher struct {
 		xdl         *Xact
 		startupSema startupSema            // Semaphore which synchronizes goroutines at dispatcher startup.
 		joggers     map[string]*jogger     // mpath -> jogger
+		jmu         sync.RWMutex           // protects joggers (see `enableMpath`, `disableMpath`)
+		mpathReqCh  chan mpathReq          // mountpath enabled or disabled (see `Xact.ReqEnableMountpath`)
 		mtx         sync.RWMutex           // Protects map defined below.
 		abortJob    map[string]*cos.StopCh // jobID -> abort job chan
+		sched       map[string]*schedJob   // jobID -> job waiting for its start time (see `Base.StartAfter`)
+		open        map[string]*multiDlJob // jobID -> job open for appending (see `MultiBody.Append`)
 		workCh      chan jobif
 		stopCh      *cos.StopCh
 		config      *cmn.Config
+		statusCache statusCache // computed job statuses, to serve repeated polls
+		flights     *flights    // identical downloads in progress (see flight.go)
+		inflight    *inflight   // nil when unlimited
+		bckq        *bckQueue   // ditto
+		adapt       *adaptive   // ditto (see `DownloaderConf.AdaptiveMax`)
+		brk         *breakers   // ditto (see `DownloaderConf.BreakerErrs`)
+		bw          *bandwidth  // ditto (see `DownloaderConf.MaxBandwidth`)
 	}
 
 	startupSema struct {
 		started atomic.Bool
 	}
 
+	// joggers do not dequeue (start) new tasks while paused
+	rebGate struct {
+		resume chan struct{} // closed upon resume; nil when not paused
+		mu     sync.Mutex
+	}
+
+	schedJob struct {
+		d   *dispatcher
+		job jobif
+	}
+
+	mpathReq struct {
+		action string // apc.ActMountpathEnable or apc.ActMountpathDisable
+		mpath  string
+	}
+
+	// caches computed status for a (short, configurable) time-to-live;
+	// an entry is also invalidated upon any change in the job's state (counters)
+	statusCache struct {
+		m  map[string]*statusEntry // (job ID, onlyActive) => status
+		mu sync.Mutex
+	}
+	statusEntry struct {
+		resp    *StatusResp
+		expires int64 // mono time
+	}
+
 	global struct {
 		db    kvdb.Driver
 		store *infoStore
@@ -54,14 +102,20 @@ type (
 		clientH   *http.Client
 		clientTLS *http.Client
 
+		// per-origin credentials (see `DownloaderConf.Credentials`), if configured
+		creds ratomic.Pointer[credsRegistry]
+
+		// paused while rebalance is running (see `DownloaderConf.PauseOnRebalance`)
+		reb rebGate
+
 		once sync.Once // newInfoStore upon the first execution
 	}
 )
 
 var g global
 
-func Init(db kvdb.Driver, clientConf *cmn.ClientConf) {
-	g.clientH, g.clientTLS = cmn.NewDefaultClients(clientConf.TimeoutLong.D())
+func Init(db kvdb.Driver) {
+	g.clientH, g.clientTLS = newClients(&cmn.GCO.Get().Downloader)
 
 	if db == nil { // unit tests only
 		return
@@ -75,6 +129,15 @@ func Init(db kvdb.Driver, clientConf *cmn.ClientConf) {
 ////////////////
 
 func newDispatcher(xdl *Xact) *dispatcher {
+	config := cmn.GCO.Get()
+	g.creds.Store(nil)
+	if fqn := config.Downloader.Credentials; fqn != "" {
+		if cr, err := loadCreds(fqn); err != nil {
+			nlog.Errorln(err) // (requests to the respective origins will be sent unsigned)
+		} else {
+			g.creds.Store(cr)
+		}
+	}
 	return &dispatcher{
 		xdl:         xdl,
 		startupSema: startupSema{},
@@ -82,7 +145,17 @@ func newDispatcher(xdl *Xact) *dispatcher {
 		workCh:      make(chan jobif),
 		stopCh:      cos.NewStopCh(),
 		abortJob:    make(map[string]*cos.StopCh, 100),
-		config:      cmn.GCO.Get(),
+		sched:       make(map[string]*schedJob, 4),
+		open:        make(map[string]*multiDlJob, 4),
+		config:      config,
+		statusCache: statusCache{m: make(map[string]*statusEntry, 16)},
+		mpathReqCh:  make(chan mpathReq, 4),
+		flights:     newFlights(),
+		inflight:    newInflight(int64(config.Downloader.MaxInflight)),
+		bckq:        newBckQueue(config.Downloader.MaxJobsPerBck),
+		adapt:       newAdaptive(config.Downloader.AdaptiveMin, config.Downloader.AdaptiveMax),
+		brk:         newBreakers(&config.Downloader),
+		bw:          newBandwidth(int64(config.Downloader.MaxBandwidth)),
 	}
 }
 
@@ -91,6 +164,7 @@ func (d *dispatcher) run() (err error) {
 		// limit the number of concurrent job dispatches (goroutines)
 		sema       = cos.NewSemaphore(5 * fs.NumAvail())
 		group, ctx = errgroup.WithContext(context.Background())
+		stopCause  = CauseShutdown
 	)
 	avail := fs.GetAvail()
 	for mpath := range avail {
@@ -100,14 +174,39 @@ func (d *dispatcher) run() (err error) {
 	d.startupSema.markStarted()
 
 	nlog.Infoln(d.xdl.Name(), "started, cnt:", len(avail))
+
+	var rebTick <-chan time.Time // nil (never fires) unless configured
+	if d.config.Downloader.PauseOnRebalance {
+		ticker := time.NewTicker(rebCheckIval)
+		defer ticker.Stop()
+		rebTick = ticker.C
+		d.checkReb()
+	}
+	var adaptTick <-chan time.Time // ditto
+	if d.adapt != nil {
+		ticker := time.NewTicker(adaptIval)
+		defer ticker.Stop()
+		adaptTick = ticker.C
+	}
 mloop:
 	for {
 		select {
+		case <-rebTick:
+			d.checkReb()
+		case <-adaptTick:
+			d.checkLoad()
+		case req := <-d.mpathReqCh:
+			if req.action == apc.ActMountpathEnable {
+				d.enableMpath(req.mpath)
+			} else {
+				d.disableMpath(req.mpath)
+			}
 		case <-d.xdl.IdleTimer():
 			nlog.Infoln(d.xdl.Name(), "idle timeout")
 			break mloop
 		case errCause := <-d.xdl.ChanAbort():
 			nlog.Infoln(d.xdl.Name(), "aborted:", errCause)
+			stopCause = abortCause(errCause)
 			break mloop
 		case <-ctx.Done():
 			break mloop
@@ -125,12 +224,14 @@ mloop:
 				break mloop
 			case errCause := <-d.xdl.ChanAbort():
 				nlog.Infoln(d.xdl.Name(), "aborted:", errCause)
+				stopCause = abortCause(errCause)
 				break mloop
 			case <-ctx.Done():
 				break mloop
 			case <-sema.TryAcquire():
 				group.Go(func() error {
 					defer sema.Release()
+					defer d.release(job)
 					if !d.dispatchDownload(job) {
 						return cmn.NewErrAborted(job.String(), "download", nil)
 					}
@@ -140,16 +241,247 @@ mloop:
 		}
 	}
 
-	d.stop()
+	d.stop(stopCause)
 	return group.Wait()
 }
 
+// downloader (xaction) abort: user-stopped vs all the rest (shutdown, maintenance, etc.)
+func abortCause(errCause error) string {
+	if errors.Is(errCause, cmn.ErrXactUserAbort) {
+		return CauseClientCancel
+	}
+	return CauseShutdown
+}
+
 // stop running joggers
 // no need to cleanup maps, dispatcher should not be used after stop()
-func (d *dispatcher) stop() {
+func (d *dispatcher) stop(cause string) {
 	d.stopCh.Close()
-	for _, jogger := range d.joggers {
-		jogger.stop()
+	if g.reb.set(false) {
+		nlog.Infoln(d.xdl.Name(), "resumed (stopping)")
+	}
+	for _, jogger := range d.getJoggers() {
+		jogger.stop(cause)
+	}
+	// scheduled jobs won't start
+	d.mtx.Lock()
+	ids := make([]string, 0, len(d.sched))
+	for id := range d.sched {
+		ids = append(ids, id)
+	}
+	d.mtx.Unlock()
+	for _, id := range ids {
+		d.unschedule(id)
+	}
+	// ditto queued
+	for _, job := range d.bckq.drain() {
+		d.abortQueued(job)
+	}
+}
+
+//
+// pause on rebalance (see `DownloaderConf.PauseOnRebalance`)
+// NOTE: polling the local xaction registry (rather than subscribing to notifications)
+// as that's where this target's rebalance is started and tracked; since rebalance does not
+// depend on downloads in any way (and in-flight downloads keep going), pausing can't deadlock it
+//
+
+func (d *dispatcher) checkReb() {
+	marked := xreg.GetRebMarked()
+	if running := marked.Xact != nil; g.reb.set(running) {
+		if running {
+			nlog.Infoln(d.xdl.Name(), "paused: rebalance in progress", marked.Xact.String())
+		} else {
+			nlog.Infoln(d.xdl.Name(), "resumed: rebalance done")
+		}
+	}
+}
+
+// adaptive ingest (see `DownloaderConf.AdaptiveMax`)
+func (d *dispatcher) checkLoad() {
+	pct, util, load := fs.ThrottlePct()
+	prev := d.adapt.get()
+	if level := d.adapt.adjust(pct); level != prev && cmn.Rom.FastV(4, cos.SmoduleDload) {
+		nlog.Infoln(d.xdl.Name(), "adaptive level:", prev, "=>", level, "[ util", util, "load", load, "]")
+	}
+}
+
+func (rg *rebGate) set(paused bool) (changed bool) {
+	rg.mu.Lock()
+	switch {
+	case paused && rg.resume == nil:
+		rg.resume = make(chan struct{})
+		changed = true
+	case !paused && rg.resume != nil:
+		close(rg.resume)
+		rg.resume = nil
+		changed = true
+	}
+	rg.mu.Unlock()
+	return changed
+}
+
+// returns nil when not paused
+func (rg *rebGate) wait() <-chan struct{} {
+	rg.mu.Lock()
+	ch := rg.resume
+	rg.mu.Unlock()
+	return ch
+}
+
+func (rg *rebGate) paused() bool { return rg.wait() != nil }
+
+//
+// scheduled jobs (see `Base.StartAfter`)
+//
+
+func _schedName(jobID string) string { return "dl-sched-" + jobID }
+
+// hold the job until its start time, at which point the housekeeper hands it over
+// to the dispatcher's queue; meanwhile, keep the xaction from idling out
+func (d *dispatcher) schedule(job jobif) {
+	sj := &schedJob{d: d, job: job}
+	d.xdl.IncPending()
+	d.mtx.Lock()
+	d.sched[job.ID()] = sj
+	d.mtx.Unlock()
+
+	nlog.Infoln(job.String(), "scheduled to start at", job.startAfter().Format(time.RFC3339))
+	hk.Reg(_schedName(job.ID()), sj.hkcb, max(time.Until(job.startAfter()), time.Millisecond))
+}
+
+func (d *dispatcher) startScheduled(jobID string) {
+	sj := d.popScheduled(jobID)
+	if sj == nil {
+		return // canceled
+	}
+	defer d.xdl.DecPending()
+
+	job := sj.job
+
+	g.store.setStarted(jobID)
+	d.statusCache.del(jobID)
+
+	dljob, err := g.store.getJob(jobID)
+	debug.AssertNoErr(err)
+	if !d.admit(job, dljob) {
+		return
+	}
+	select {
+	case d.workCh <- job:
+	case <-d.stopCh.Listen():
+		g.store.setAborted(jobID)
+		job.cleanup()
+	}
+}
+
+// remove not-yet-started job and finish it as aborted; returns false if there's no such job
+func (d *dispatcher) unschedule(jobID string) bool {
+	sj := d.popScheduled(jobID)
+	if sj == nil {
+		return false
+	}
+	hk.UnregIf(_schedName(jobID), sj.hkcb) // (may have already fired)
+	g.store.setStarted(jobID)
+	g.store.setAborted(jobID)
+	d.statusCache.del(jobID)
+	sj.job.cleanup()
+	d.xdl.DecPending()
+	return true
+}
+
+func (d *dispatcher) popScheduled(jobID string) (sj *schedJob) {
+	d.mtx.Lock()
+	if sj = d.sched[jobID]; sj != nil {
+		delete(d.sched, jobID)
+	}
+	d.mtx.Unlock()
+	return sj
+}
+
+// (not to block housekeeper)
+func (sj *schedJob) hkcb(int64) time.Duration {
+	go sj.d.startScheduled(sj.job.ID())
+	return hk.UnregInterval
+}
+
+//
+// per-bucket cap on the number of running jobs (see `DownloaderConf.MaxJobsPerBck`)
+// NOTE: scheduled jobs (see above) always wait in line, even when configured to reject
+//
+
+// takes a slot or puts the job in line (in which case it'll be started upon `release`)
+func (d *dispatcher) admit(job jobif, dljob *dljob) bool {
+	if d.bckq == nil {
+		return true
+	}
+	d.xdl.IncPending() // waiting jobs keep the xaction from idling out
+	if d.bckq.acquire(job, dljob) {
+		d.xdl.DecPending()
+		return true
+	}
+	d.statusCache.del(job.ID())
+	nlog.Infoln(job.String(), "queued: max running jobs per bucket", d.bckq.limit)
+	return false
+}
+
+// frees the job's slot or passes it on to the next job in line
+func (d *dispatcher) release(job jobif) {
+	if next := d.bckq.release(job); next != nil {
+		go d.startQueued(next)
+	}
+}
+
+func (d *dispatcher) startQueued(job jobif) {
+	defer d.xdl.DecPending()
+	d.statusCache.del(job.ID())
+	select {
+	case d.workCh <- job:
+	case <-d.stopCh.Listen():
+		g.store.setAborted(job.ID())
+		job.cleanup()
+	}
+}
+
+// remove job that's waiting in line and finish it as aborted; returns false if there's no such job
+func (d *dispatcher) dequeue(jobID string) bool {
+	job := d.bckq.remove(jobID)
+	if job == nil {
+		return false
+	}
+	d.abortQueued(job)
+	return true
+}
+
+func (d *dispatcher) abortQueued(job jobif) {
+	g.store.setAborted(job.ID())
+	d.statusCache.del(job.ID())
+	job.cleanup()
+	d.xdl.DecPending()
+}
+
+// open job keeps the xaction from idling out while waiting for more objects
+func (d *dispatcher) openJob(j *multiDlJob) {
+	d.xdl.IncPending()
+	d.mtx.Lock()
+	d.open[j.ID()] = j
+	d.mtx.Unlock()
+}
+
+func (d *dispatcher) openedJob(jobID string) *multiDlJob {
+	d.mtx.RLock()
+	j := d.open[jobID]
+	d.mtx.RUnlock()
+	return j
+}
+
+func (d *dispatcher) closeJob(jobID string) {
+	d.mtx.Lock()
+	_, ok := d.open[jobID]
+	delete(d.open, jobID)
+	d.mtx.Unlock()
+	if ok {
+		d.xdl.DecPending()
 	}
 }
 
@@ -161,6 +493,64 @@ func (d *dispatcher) addJogger(mpath string) {
 	d.joggers[mpath] = j
 }
 
+func (d *dispatcher) getJoggers() []*jogger {
+	d.jmu.RLock()
+	joggers := make([]*jogger, 0, len(d.joggers))
+	for _, j := range d.joggers {
+		joggers = append(joggers, j)
+	}
+	d.jmu.RUnlock()
+	return joggers
+}
+
+//
+// mountpath changes at runtime (see `Xact.ReqEnableMountpath` and `Xact.ReqDisableMountpath`)
+//
+
+func (d *dispatcher) mpathReq(action, mpath string) {
+	select {
+	case d.mpathReqCh <- mpathReq{action: action, mpath: mpath}:
+	case <-d.stopCh.Listen():
+	}
+}
+
+func (d *dispatcher) enableMpath(mpath string) {
+	d.jmu.Lock()
+	if _, ok := d.joggers[mpath]; !ok {
+		d.addJogger(mpath)
+		nlog.Infoln(d.xdl.Name(), "added jogger for", mpath)
+	}
+	d.jmu.Unlock()
+}
+
+// stop the mountpath's jogger: its running tasks get aborted and, together with
+// the pending ones, re-dispatched - HRW no longer selects the mountpath that is
+// being disabled (or detached), and so the objects land elsewhere (see `requeue`)
+func (d *dispatcher) disableMpath(mpath string) {
+	d.jmu.Lock()
+	j, ok := d.joggers[mpath]
+	delete(d.joggers, mpath)
+	d.jmu.Unlock()
+	if !ok {
+		return
+	}
+	j.stop(CauseMpathDisabled)
+	nlog.Infoln(d.xdl.Name(), "stopped jogger for", mpath)
+}
+
+// re-dispatch the task of the disabled mountpath's jogger;
+// fail it if there's no place to go (e.g., no mountpaths)
+func (d *dispatcher) requeue(task *singleTask) {
+	task.rewind()
+	ok, err := d.doSingle(context.Background(), task)
+	switch {
+	case err != nil:
+		task.markFailed(err.Error(), CauseMpathDisabled)
+	case !ok:
+		task.markFailed(internalErrorMsg, CauseShutdown)
+	}
+}
+
 func (d *dispatcher) cleanupJob(jobID string) {
 	d.mtx.Lock()
 	if ch, exists := d.abortJob[jobID]; exists {
@@ -191,25 +581,66 @@ func (d *dispatcher) finish(job jobif) {
 
 // forward request to designated jogger
 func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
+	if dl := job.deadline(); !dl.IsZero() {
+		// NOTE: stays armed until all pending tasks are done (see `finish` below)
+		timer := time.AfterFunc(time.Until(dl), func() { d.expire(job) })
+		defer timer.Stop()
+	}
 	defer d.finish(job)
 
 	if aborted := d.checkAborted(); aborted || d.checkAbortedJob(job) {
 		return !aborted
 	}
+	nl.OnStarted(job.Notif())
+
+	if m := job.manifest(); m != nil {
+		if err := d.loadManifest(job, m); err != nil {
+			// fail the job up front: none of its objects can be verified
+			nlog.Errorln(job.String(), err)
+			core.T.StatsUpdater().Inc(stats.ErrDloadCount)
+			g.store.persistError(job.ID(), TaskErrInfo{Name: m.conf.URL, Err: err.Error(), Cause: CauseManifest, Code: errStatus(err)})
+			g.store.incErrorCnt(job.ID())
+			g.store.setAborted(job.ID())
+			return true
+		}
+	}
 
-	diffResolver := NewDiffResolver(&defaultDiffResolverCtx{})
+	diffResolver := NewDiffResolver(&defaultDiffResolverCtx{
+		headOnly: job.headOnly(),
+		backfill: job.backfill(),
+	})
 	go diffResolver.Start()
 
 	// In case of `!job.Sync()` we don't want to traverse entire bucket.
 	// We just want to download requested objects and to find out which
 	// objects must be checked (latest version-wise). Therefore, "walk"
-	// bucket only when we need to sync the objects.
-	if job.Sync() {
+	// bucket only when we need to sync the objects - or to backfill the
+	// missing ones, in which case both sorted streams are diffed as they go.
+	if job.Sync() || job.backfill() {
 		go diffResolver.walk(job)
 	}
 
 	go diffResolver.push(job, d)
 
+	// redirects (see `Base.ResolveRedirects`) get resolved concurrently, `maxCanonResolvers` at a time
+	var (
+		cr        = job.canonical()
+		resolvers *errgroup.Group
+		rctx      = context.Background()
+		fatal     ratomic.Bool
+	)
+	if cr != nil {
+		var cancel context.CancelFunc
+		rctx, cancel = context.WithCancel(rctx)
+		resolvers, rctx = errgroup.WithContext(rctx)
+		resolvers.SetLimit(maxCanonResolvers)
+		// whichever way this returns: stop the resolvers and wait for them (prior to `finish`)
+		defer func() {
+			cancel()
+			_ = resolvers.Wait()
+		}()
+	}
+
 	for {
 		result, err := diffResolver.Next()
 		if err != nil {
@@ -223,6 +654,8 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 					objName:    dst.ObjName,
 					link:       dst.Link,
 					fromRemote: dst.Link == "",
+					force:      dst.Force,
+					bck:        dst.Bck,
 				}
 			} else {
 				src := result.Src
@@ -235,15 +668,28 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 			}
 
 			g.store.incScheduled(job.ID())
+			g.store.incBck(job.ID(), obj.bck, bckScheduled)
 
 			if result.Action == DiffResolverSkip {
-				g.store.incSkipped(job.ID())
-				continue
+				if !job.VerifyExisting() {
+					g.store.incExisting(job.ID())
+					g.store.incBck(job.ID(), obj.bck, bckFinished)
+					g.store.setObjState(job.ID(), obj.objName, &ObjState{State: ObjFinished, EndTime: time.Now()})
+					continue
+				}
+				err := verifyExisting(result.Src)
+				if err == nil {
+					g.store.incExisting(job.ID())
+					g.store.incBck(job.ID(), obj.bck, bckFinished)
+					g.store.setObjState(job.ID(), obj.objName, &ObjState{State: ObjFinished, EndTime: time.Now()})
+					continue
+				}
+				nlog.Warningln(job.String(), "existing", obj.objName, "failed validation, re-downloading:", err)
 			}
 
 			task := &singleTask{xdl: d.xdl, obj: obj, job: job}
 			if result.Action == DiffResolverErr {
-				task.markFailed(result.Err.Error())
+				task.markFailed(result.Err.Error(), CauseOriginError)
 				continue
 			}
 
@@ -251,33 +697,103 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 				requiresSync := job.Sync()
 				debug.Assert(requiresSync)
 				if _, err := core.T.EvictObject(result.Src); err != nil {
-					task.markFailed(err.Error())
+					task.markFailed(err.Error(), "" /*cause*/)
 				} else {
 					g.store.incFinished(job.ID())
+					task.setState(ObjFinished, "")
 				}
 				continue
 			}
 
-			ok, err := d.doSingle(task)
-			if err != nil {
-				nlog.Errorln(job.String(), "failed to download", obj.objName+":", err)
-				g.store.setAborted(job.ID()) // TODO -- FIXME: pass (report, handle) error, here and elsewhere
-				return ok
+			if cr != nil && !obj.fromRemote {
+				if rctx.Err() != nil {
+					_ = resolvers.Wait()
+					return !fatal.Load()
+				}
+				resolvers.Go(func() error {
+					if cont, ok := d.dispatchCanon(rctx, job, cr, task); !cont {
+						fatal.Store(!ok)
+						return errStopDispatch
+					}
+					return nil
+				})
+				continue
 			}
-			if !ok {
-				g.store.setAborted(job.ID())
-				return false
+
+			if cont, ok := d.dispatchTask(rctx, job, task); !cont {
+				return ok
 			}
 		case DiffResolverSend:
-			requiresSync := job.Sync()
-			debug.Assert(requiresSync)
+			// in-cluster only: nothing to do
+			debug.Assert(job.Sync() || job.backfill())
 		case DiffResolverEOF:
+			if resolvers != nil && resolvers.Wait() != nil {
+				return !fatal.Load()
+			}
 			g.store.setAllDispatched(job.ID(), true)
 			return true
 		}
 	}
 }
 
+// returns false to stop dispatching the job (see `dispatchDownload` for the `ok` semantics)
+func (d *dispatcher) dispatchTask(ctx context.Context, job jobif, task *singleTask) (cont, ok bool) {
+	ok, err := d.doSingle(ctx, task)
+	if err != nil {
+		nlog.Errorln(job.String(), "failed to download", task.obj.objName+":", err)
+		g.store.setAborted(job.ID()) // TODO -- FIXME: pass (report, handle) error, here and elsewhere
+		return false, ok
+	}
+	if !ok {
+		g.store.setAborted(job.ID())
+		return false, false
+	}
+	return true, true
+}
+
+// resolve the canonical link (with the job's headers and credentials) and dispatch -
+// unless the same location is already scheduled by this job
+func (d *dispatcher) dispatchCanon(ctx context.Context, job jobif, cr *canonResolver, task *singleTask) (cont, ok bool) {
+	link, dup := cr.resolve(ctx, task.obj.link, mergeHeaders(job.Headers(), task.obj.headers), job.auth())
+	if dup {
+		g.store.incSkipped(job.ID())
+		task.setState(ObjFinished, "")
+		return true, true
+	}
+	task.obj.link = link
+	return d.dispatchTask(ctx, job, task)
+}
+
+// verifyExisting re-reads an existing object and validates its content checksum;
+// returns non-nil error when the object is unreadable or damaged
+func verifyExisting(lom *core.LOM) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	return lom.ValidateContentChecksum(true /*locked*/)
+}
+
+// (aborting the job or stopping the downloader interrupts)
+func (d *dispatcher) loadManifest(job jobif, m *cksumManifest) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-d.jobAbortedCh(job.ID()).Listen():
+		case <-d.stopCh.Listen():
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+	timeout := job.Timeout()
+	if timeout == 0 {
+		timeout = cmn.GCO.Get().Downloader.Timeout.D()
+	}
+	return m.load(ctx, timeout)
+}
+
 func (d *dispatcher) jobAbortedCh(jobID string) *cos.StopCh {
 	d.mtx.RLock()
 	defer d.mtx.RUnlock()
@@ -309,9 +825,10 @@ func (d *dispatcher) checkAborted() bool {
 	}
 }
 
-// returns false if dispatcher encountered hard error, true otherwise
-func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
-	bck := meta.CloneBck(task.job.Bck())
+// returns false if dispatcher encountered hard error, true otherwise;
+// canceling the context (e.g., when the job stops being dispatched) interrupts the wait
+func (d *dispatcher) doSingle(ctx context.Context, task *singleTask) (ok bool, err error) {
+	bck := meta.CloneBck(task.bck())
 	if err := bck.Init(core.T.Bowner()); err != nil {
 		return true, err
 	}
@@ -320,11 +837,6 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 	if err != nil {
 		return false, err
 	}
-	jogger, ok := d.joggers[mi.Path]
-	if !ok {
-		err := fmt.Errorf("no jogger for mpath %s exists", mi.Path)
-		return false, err
-	}
 
 	// NOTE: Throttle job before making jogger busy - we don't want to clog the
 	//  jogger as other tasks from other jobs can be already ready to download.
@@ -333,16 +845,44 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 		break
 	case <-d.jobAbortedCh(task.job.ID()).Listen():
 		return true, nil
+	case <-ctx.Done():
+		return true, nil
 	}
 
-	// Secondly, try to push the new task into queue.
+	// Secondly, try to push the new task into queue
+	// (not holding `jmu` while waiting for a slot - the jogger may get stopped meanwhile, see below).
+	d.jmu.RLock()
+	jogger, ok := d.joggers[mi.Path]
+	d.jmu.RUnlock()
+	if !ok {
+		task.job.throttler().release()
+		err := fmt.Errorf("no jogger for mpath %s exists", mi.Path)
+		return false, err
+	}
+	task.setState(ObjPending, "")
+	put, slotCh := jogger.putCh(task)
 	select {
 	// TODO -- FIXME: currently, dispatcher halts if any given jogger is "full" but others available
-	case jogger.putCh(task) <- task:
-		return true, nil
+	case slotCh <- struct{}{}:
+		if put && jogger.put(task) {
+			return true, nil
+		}
+		if !put && !jogger.stopped() {
+			return true, nil // (already queued)
+		}
+		// the jogger's been stopped (e.g., its mountpath disabled - see `disableMpath`):
+		// dispatch the task anew unless the dispatcher itself is stopping
+		task.job.throttler().release()
+		if d.checkAborted() {
+			return false, nil
+		}
+		return d.doSingle(ctx, task)
 	case <-d.jobAbortedCh(task.job.ID()).Listen():
 		task.job.throttler().release()
 		return true, nil
+	case <-ctx.Done():
+		task.job.throttler().release()
+		return true, nil
 	case <-d.stopCh.Listen():
 		task.job.throttler().release()
 		return false, nil
@@ -371,9 +911,14 @@ func (d *dispatcher) adminReq(req *request) (resp any, statusCode int, err error
 	return r.value, r.statusCode, r.err
 }
 
-func (*dispatcher) handleRemove(req *request) {
+func (d *dispatcher) handleRemove(req *request) {
 	dljob, err := g.store.checkExists(req)
 	if err != nil {
+		// (e.g., after restart) the job may still have its per-object state persisted
+		if states, _ := g.store.objStates(req.id); len(states) > 0 {
+			g.store.delete(req.id)
+			req.okRsp(nil)
+		}
 		return
 	}
 	job := dljob.clone()
@@ -382,21 +927,64 @@ func (*dispatcher) handleRemove(req *request) {
 		return
 	}
 	g.store.delJob(req.id)
+	d.statusCache.del(req.id)
 	req.okRsp(nil)
 }
 
 func (d *dispatcher) handleAbort(req *request) {
-	if _, err := g.store.checkExists(req); err != nil {
+	dljob, err := g.store.checkExists(req)
+	if err != nil {
+		return
+	}
+	if req.objName != "" {
+		d.abortObj(req, dljob)
+		return
+	}
+	if d.unschedule(req.id) || d.dequeue(req.id) {
+		req.okRsp(nil)
 		return
 	}
 	d.jobAbortedCh(req.id).Close()
-	for _, j := range d.joggers {
+	for _, j := range d.getJoggers() {
 		j.abortJob(req.id)
 	}
 	g.store.setAborted(req.id)
+	d.statusCache.del(req.id)
+	req.okRsp(nil)
+}
+
+// cancel a single object: if running, abort its download; if pending (or not yet
+// dispatched), fail it when dequeued (see `jogger.work`); either way, the object
+// counts as an error with `CauseObjCancel`, and the rest of the job continues
+func (d *dispatcher) abortObj(req *request, dljob *dljob) {
+	job := dljob.clone()
+	if !job.JobRunning() {
+		req.errRsp(fmt.Errorf("job %q is not running", req.id), http.StatusBadRequest)
+		return
+	}
+	g.store.cancelObj(req.id, req.objName)
+	for _, j := range d.getJoggers() {
+		j.cancelObj(req.id, req.objName)
+	}
+	d.statusCache.del(req.id)
 	req.okRsp(nil)
 }
 
+// upon exceeding `Base.Deadline`: stop dispatching, cancel the running tasks,
+// and fail the pending ones (tasks that have already finished remain)
+func (d *dispatcher) expire(job jobif) {
+	if !job.expire() {
+		return
+	}
+	nlog.Warningln(job.String(), "deadline exceeded - aborting")
+	d.jobAbortedCh(job.ID()).Close()
+	for _, j := range d.getJoggers() {
+		j.cancelTask(job.ID(), CauseJobTimeout)
+	}
+	g.store.setTimedOut(job.ID())
+	d.statusCache.del(job.ID())
+}
+
 func (d *dispatcher) handleStatus(req *request) {
 	var (
 		finishedTasks []TaskDlInfo
@@ -404,6 +992,15 @@ func (d *dispatcher) handleStatus(req *request) {
 	)
 	dljob, err := g.store.checkExists(req)
 	if err != nil {
+		d.persistedStatus(req)
+		return
+	}
+	job := dljob.clone()
+	if job.Queued {
+		job.JobsAhead, job.QueuedBck = d.bckq.ahead(req.id)
+	}
+	if resp := d.statusCache.get(req, &job); resp != nil {
+		req.okRsp(resp)
 		return
 	}
 
@@ -423,19 +1020,86 @@ func (d *dispatcher) handleStatus(req *request) {
 		sort.Sort(TaskErrByName(dlErrors))
 	}
 
-	req.okRsp(&StatusResp{
-		Job:           dljob.clone(),
+	resp := &StatusResp{
+		Job:           job,
 		CurrentTasks:  currentTasks,
 		FinishedTasks: finishedTasks,
 		Errs:          dlErrors,
-	})
+		Bytes:         dljob.size.Load(),
+		ExpectedBytes: dljob.expected.Load(),
+		Throughput:    dljob.rate.rate(),
+	}
+	for i := range currentTasks {
+		// (when ended, the size is already accounted for)
+		if cos.IsTimeZero(currentTasks[i].EndTime) {
+			resp.Bytes += currentTasks[i].Downloaded
+		}
+	}
+	if !req.onlyActive {
+		resp.Request = dljob.req
+	}
+	if level := d.adapt.get(); level > 0 {
+		resp.Adaptive = map[string]int{core.T.SID(): level}
+	}
+	if states := d.brk.states(); len(states) > 0 {
+		resp.Breakers = map[string]cos.StrKVs{core.T.SID(): states}
+	}
+	d.statusCache.put(req, resp)
+	req.okRsp(resp)
+}
+
+// the job is unknown (e.g., the target restarted) - reconstruct its status from
+// the per-object state persisted in the DB, if any; objects that were still pending
+// or running when the job (or the target) went down are reported as errors
+func (d *dispatcher) persistedStatus(req *request) {
+	states, err := g.store.objStates(req.id)
+	if err != nil || len(states) == 0 {
+		return // (not found)
+	}
+	resp := &StatusResp{Job: Job{ID: req.id, Total: len(states), AllDispatched: true}, Persisted: true}
+	for name, st := range states {
+		if st.EndTime.After(resp.FinishedTime) {
+			resp.FinishedTime = st.EndTime
+		}
+		switch st.State {
+		case ObjFinished:
+			resp.FinishedCnt++
+			resp.Bytes += st.Size
+			if !req.onlyActive {
+				resp.FinishedTasks = append(resp.FinishedTasks, TaskDlInfo{
+					Name:       name,
+					Downloaded: st.Size,
+					EndTime:    st.EndTime,
+					Mirror:     st.Mirror,
+					Headers:    st.Headers,
+				})
+			}
+			continue
+		case ObjPending, ObjRunning:
+			resp.Aborted = true
+			st.Err = "interrupted while " + st.State
+		case ObjAborted:
+			resp.Aborted = true
+		}
+		resp.ErrorCnt++
+		if !req.onlyActive {
+			resp.Errs = append(resp.Errs, TaskErrInfo{Name: name, Err: st.Err})
+		}
+	}
+	resp.ScheduledCnt = resp.Total
+	if cos.IsTimeZero(resp.FinishedTime) {
+		resp.FinishedTime = time.Now() // (unknown; in any case, not running)
+	}
+	sort.Sort(TaskInfoByName(resp.FinishedTasks))
+	sort.Sort(TaskErrByName(resp.Errs))
+	req.okRsp(resp)
 }
 
 func (d *dispatcher) activeTasks(reqID string) []TaskDlInfo {
-	currentTasks := make([]TaskDlInfo, 0, len(d.joggers))
-	for _, j := range d.joggers {
-		task := j.getTask(reqID)
-		if task != nil {
+	joggers := d.getJoggers()
+	currentTasks := make([]TaskDlInfo, 0, len(joggers))
+	for _, j := range joggers {
+		for _, task := range j.getTasks(reqID) {
 			currentTasks = append(currentTasks, task.ToTaskDlInfo())
 		}
 	}
@@ -447,7 +1111,7 @@ func (d *dispatcher) activeTasks(reqID string) []TaskDlInfo {
 // pending returns `true` if any joggers has pending tasks for a given `reqID`,
 // `false` otherwise.
 func (d *dispatcher) pending(jobID string) bool {
-	for _, j := range d.joggers {
+	for _, j := range d.getJoggers() {
 		if j.pending(jobID) {
 			return true
 		}
@@ -464,6 +1128,58 @@ func (d *dispatcher) waitFor(jobID string) {
 	}
 }
 
+/////////////////
+// statusCache //
+/////////////////
+
+func _skey(req *request) string {
+	if req.onlyActive {
+		return req.id + "|a"
+	}
+	return req.id
+}
+
+// returns cached status iff not expired and the job hasn't changed since
+func (sc *statusCache) get(req *request, job *Job) *StatusResp {
+	key := _skey(req)
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	entry, ok := sc.m[key]
+	if !ok {
+		return nil
+	}
+	if mono.NanoTime() > entry.expires || !entry.resp.Job.equal(job) {
+		delete(sc.m, key)
+		return nil
+	}
+	return entry.resp
+}
+
+func (sc *statusCache) put(req *request, resp *StatusResp) {
+	var (
+		now = mono.NanoTime()
+		ttl = cmn.GCO.Get().Downloader.StatusCacheTTL()
+	)
+	sc.mu.Lock()
+	// cleanup
+	if len(sc.m) >= 64 {
+		for key, entry := range sc.m {
+			if now > entry.expires {
+				delete(sc.m, key)
+			}
+		}
+	}
+	sc.m[_skey(req)] = &statusEntry{resp: resp, expires: now + int64(ttl)}
+	sc.mu.Unlock()
+}
+
+func (sc *statusCache) del(jobID string) {
+	sc.mu.Lock()
+	delete(sc.m, jobID)
+	delete(sc.m, jobID+"|a")
+	sc.mu.Unlock()
+}
+
 /////////////////
 // startupSema //
 /////////////////
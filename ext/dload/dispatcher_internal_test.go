@@ -0,0 +1,134 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRebGate(t *testing.T) {
+	var rg rebGate
+	tassert.Errorf(t, !rg.paused() && rg.wait() == nil, "expected not paused")
+	tassert.Errorf(t, !rg.set(false), "expected no change")
+
+	tassert.Fatalf(t, rg.set(true), "expected paused")
+	tassert.Errorf(t, !rg.set(true), "expected no change")
+	tassert.Errorf(t, rg.paused(), "expected paused")
+
+	var (
+		resume = rg.wait()
+		done   = make(chan struct{})
+	)
+	go func() {
+		<-resume
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("resumed while paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	tassert.Fatalf(t, rg.set(false), "expected resumed")
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("not resumed")
+	}
+	tassert.Errorf(t, !rg.paused() && rg.wait() == nil, "expected not paused")
+}
+
+func TestBckQueue(t *testing.T) {
+	tassert.Errorf(t, newBckQueue(0) == nil, "expected unlimited")
+
+	var (
+		q    = newBckQueue(2)
+		bck1 = meta.NewBck("bck1", apc.AIS, cmn.NsGlobal)
+		bck2 = meta.NewBck("bck2", apc.AIS, cmn.NsGlobal)
+		jobs = make([]jobif, 6)
+		dls  = make([]*dljob, 6)
+	)
+	for i := range jobs {
+		bck := bck1
+		if i == 5 {
+			bck = bck2
+		}
+		jobs[i] = &sliceDlJob{baseDlJob: baseDlJob{id: "job" + strconv.Itoa(i), bck: bck}}
+		dls[i] = &dljob{id: jobs[i].ID()}
+	}
+
+	// two running, three waiting (bck1); other buckets are not affected
+	for i := range 5 {
+		admitted := q.acquire(jobs[i], dls[i])
+		tassert.Errorf(t, admitted == (i < 2), "job%d: admitted=%t", i, admitted)
+		tassert.Errorf(t, dls[i].queued.Load() == (i >= 2), "job%d: queued=%t", i, dls[i].queued.Load())
+	}
+	tassert.Errorf(t, q.acquire(jobs[5], dls[5]), "expected bck2 job admitted")
+	tassert.Errorf(t, !q.acquire(jobs[4], nil), "expected rejected")
+
+	n, bck := q.ahead("job4")
+	tassert.Errorf(t, n == 2 && bck == bck1.Cname(""), "expected 2 ahead in %s, got %d in %q", bck1.Cname(""), n, bck)
+	if _, bck := q.ahead("job0"); bck != "" {
+		t.Errorf("running job0 is not expected to wait")
+	}
+
+	// abort the first in line; the slot then goes to the next one
+	tassert.Errorf(t, q.remove("job2") == jobs[2] && !dls[2].queued.Load(), "expected job2 removed")
+	tassert.Errorf(t, q.remove("job2") == nil, "expected job2 gone")
+	next := q.release(jobs[0])
+	tassert.Fatalf(t, next == jobs[3], "expected job3, got %v", next)
+	tassert.Errorf(t, !dls[3].queued.Load(), "job3 is not expected to wait")
+	n, _ = q.ahead("job4")
+	tassert.Errorf(t, n == 0, "expected job4 next in line, got %d ahead", n)
+
+	tassert.Errorf(t, q.release(jobs[5]) == nil, "expected no bck2 jobs in line")
+	drained := q.drain()
+	tassert.Errorf(t, len(drained) == 1 && drained[0] == jobs[4], "expected job4 drained, got %v", drained)
+
+	// job1 and job3 are still running
+	q.release(jobs[1])
+	tassert.Errorf(t, q.acquire(jobs[4], nil), "expected a free slot")
+	tassert.Errorf(t, !q.acquire(jobs[2], nil), "expected no free slots")
+}
+
+func TestStatusProgress(t *testing.T) {
+	known := TaskDlInfo{Name: "a", Downloaded: 25, Total: 100}
+	pct, ok := known.Pct()
+	tassert.Errorf(t, ok && pct == 25, "expected 25%%, got %v (%t)", pct, ok)
+	unknown := TaskDlInfo{Name: "b", Downloaded: 10}
+	_, ok = unknown.Pct()
+	tassert.Errorf(t, !ok, "expected no percentage with unknown size")
+
+	// partial progress aggregated across targets
+	var resp *StatusResp
+	resp = resp.Aggregate(&StatusResp{CurrentTasks: []TaskDlInfo{known}, Bytes: 125})
+	resp = resp.Aggregate(&StatusResp{CurrentTasks: []TaskDlInfo{unknown}, Bytes: 10})
+	tassert.Errorf(t, len(resp.CurrentTasks) == 2 && resp.Bytes == 135,
+		"expected 2 in-flight objects and 135 bytes, got %d and %d", len(resp.CurrentTasks), resp.Bytes)
+}
+
+func TestJobEqual(t *testing.T) {
+	var (
+		now = time.Now()
+		bck = cmn.Bck{Name: "bck", Provider: apc.AWS}
+		j1  = &Job{ID: "job1", StartedTime: now, FinishedCnt: 1, Buckets: []BckProgress{{Bck: bck, FinishedCnt: 1}}}
+		j2  = &Job{ID: "job1", StartedTime: now.Round(0), FinishedCnt: 1, Buckets: []BckProgress{{Bck: bck, FinishedCnt: 1}}}
+	)
+	tassert.Errorf(t, j1.equal(j2), "expected equal jobs")
+	j2.Buckets[0].FinishedCnt++
+	tassert.Errorf(t, !j1.equal(j2), "expected per-bucket progress to differ")
+	j2.Buckets = nil
+	tassert.Errorf(t, !j1.equal(j2), "expected missing per-bucket progress to differ")
+	j1.Buckets = nil
+	j2.Aborted = true
+	tassert.Errorf(t, !j1.equal(j2), "expected aborted job to differ")
+}
@@ -0,0 +1,244 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Server-side extraction of downloaded archives (see `Base.Extract`).
+// An archive is downloaded only once - by the target that owns its name (HRW), as any other
+// object - which then stores the members that it owns and sends the rest to their respective
+// (HRW) owners; members that fail are individually reported via job errors. Whether a given
+// download is an archive gets decided only once the response arrives (see `extractMime`).
+
+const (
+	maxExtractMembers    = 1024 * 1024 // max number of archived files (all types) per archive
+	maxExtractMemberSize = 64 * cos.GiB
+
+	workfileDlZip = "dl-zip" // zip requires random access - spooled to a local workfile
+)
+
+type extractCtx struct {
+	task   *singleTask
+	bck    *meta.Bck
+	smap   *meta.Smap
+	prefix string
+	num    int // number of visited members
+	cnt    int // number of members stored (by this target or the owning ones)
+}
+
+// interface guard
+var _ archive.ArchRCB = (*extractCtx)(nil)
+
+// returns recognized archive format (by Content-Type or, if need be, the object's extension) or empty string
+func extractMime(resp *http.Response, objName string) string {
+	mime, err := archive.Mime(resp.Header.Get(cos.HdrContentType), objName)
+	if err != nil {
+		return ""
+	}
+	return mime
+}
+
+func (task *singleTask) _dextract(lom *core.LOM, mime, prefix string, resp *http.Response) (bool /*err is fatal*/, error) {
+	var (
+		ar   archive.Reader
+		err  error
+		r    = task.wrapReader(resp.Body)
+		size = resp.ContentLength
+	)
+	task.setTotalSize(size)
+
+	if mime == archive.ExtZip {
+		var fh *os.File
+		if fh, size, err = task.spool(lom, r); err != nil {
+			return true, err
+		}
+		defer func() {
+			fqn := fh.Name()
+			cos.Close(fh)
+			cos.RemoveFile(fqn)
+		}()
+		ar, err = archive.NewReader(mime, fh, size)
+	} else {
+		ar, err = archive.NewReader(mime, r)
+	}
+	if err != nil {
+		return true, fmt.Errorf("%s: failed to open %s archive %q: %w", task.job, mime, redactLink(task.obj.link), err)
+	}
+
+	ctx := &extractCtx{
+		task:   task,
+		bck:    lom.Bck(),
+		smap:   core.T.Sowner().Get(),
+		prefix: prefix,
+	}
+	if err := ar.ReadUntil(ctx, cos.EmptyMatchAll, ""); err != nil {
+		return true, fmt.Errorf("%s: failed to extract %q (extracted %d): %w", task.job, redactLink(task.obj.link), ctx.cnt, err)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleDload) {
+		nlog.Infoln(task.job.String(), "extracted", ctx.cnt, "of", ctx.num, "archived files from", redactLink(task.obj.link))
+	}
+	return false, nil
+}
+
+func (task *singleTask) spool(lom *core.LOM, r io.Reader) (*os.File, int64, error) {
+	fqn := fs.CSM.Gen(lom, fs.WorkfileType, workfileDlZip)
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return nil, 0, err
+	}
+	size, err := io.Copy(fh, r)
+	if err != nil {
+		cos.Close(fh)
+		cos.RemoveFile(fqn)
+		return nil, 0, err
+	}
+	return fh, size, nil
+}
+
+func (ctx *extractCtx) Call(filename string, reader cos.ReadCloseSizer, hdr any) (bool /*stop*/, error) {
+	defer reader.Close()
+
+	if th, ok := hdr.(*tar.Header); ok && th.Typeflag != tar.TypeReg {
+		return false, nil // skip directories, links, etc.
+	}
+	ctx.num++
+	if ctx.num > maxExtractMembers {
+		return true, fmt.Errorf("number of archived files exceeds the maximum (%d)", maxExtractMembers)
+	}
+
+	objName, err := ctx.objName(filename)
+	if err != nil {
+		ctx.task.markMemberFailed(filename, err)
+		return false, nil
+	}
+	si, err := ctx.smap.HrwName2T(ctx.bck.MakeUname(objName))
+	if err != nil {
+		return true, err
+	}
+	if size := reader.Size(); size > maxExtractMemberSize {
+		ctx.task.markMemberFailed(objName, fmt.Errorf("size %s exceeds the maximum (%s)",
+			cos.ToSizeIEC(size, 0), cos.ToSizeIEC(maxExtractMemberSize, 0)))
+		return false, nil
+	}
+
+	if si.ID() != core.T.SID() {
+		if err := ctx.send(si, objName, reader); err != nil {
+			ctx.task.markMemberFailed(objName, err)
+			return false, nil
+		}
+		ctx.task.xdl.OutObjsAdd(1, reader.Size())
+		ctx.cnt++
+		return false, nil
+	}
+	if err := ctx.put(objName, reader); err != nil {
+		if cos.IsErrOOS(err) {
+			return true, err
+		}
+		ctx.task.markMemberFailed(objName, err)
+		return false, nil
+	}
+	ctx.cnt++
+	return false, nil
+}
+
+func (ctx *extractCtx) objName(filename string) (string, error) {
+	name := path.Clean(strings.TrimPrefix(filename, "/"))
+	if name == "." || name == ".." || strings.HasPrefix(name, "../") {
+		return "", errors.New("invalid archived filename")
+	}
+	return path.Join(ctx.prefix, name), nil
+}
+
+func (ctx *extractCtx) put(objName string, reader cos.ReadCloseSizer) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(ctx.bck.Bucket()); err != nil {
+		return err
+	}
+	setMetadata(lom, ctx.task.job.metadata())
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = "dl"
+		params.Reader = io.NopCloser(reader) // closed by the caller
+		params.OWT = cmn.OwtPut
+		params.Atime = ctx.task.started.Load()
+		params.Size = reader.Size()
+		params.Xact = ctx.task.xdl
+	}
+	err := core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	return err
+}
+
+// PUT the member to the target that owns it (compare with ais `coi.put`)
+func (ctx *extractCtx) send(si *meta.Snode, objName string, reader cos.ReadCloseSizer) error {
+	var (
+		task = ctx.task
+		oa   = &cmn.ObjAttrs{Size: reader.Size(), Atime: task.started.Load().UnixNano()}
+		hdr  = make(http.Header, 8)
+	)
+	setMetadata(oa, task.job.metadata())
+	cmn.ToHeader(oa, hdr, oa.Size)
+	hdr.Set(apc.HdrT2TPutterID, core.T.SID())
+
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   si.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(ctx.bck.Name, objName),
+		Query:  ctx.bck.NewQuery(),
+		Header: hdr,
+		BodyR:  io.NopCloser(reader), // (closed by the caller)
+	}
+	req, _, cancel, err := reqArgs.ReqWith(cmn.GCO.Get().Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	stop := context.AfterFunc(task.downloadCtx, cancel) // (the task's canceled or aborted)
+	defer stop()
+	if oa.Size > 0 {
+		req.ContentLength = oa.Size
+	}
+
+	resp, err := core.T.DataClient().Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		cmn.HreqFree(req)
+		return cmn.NewErrFailedTo(core.T, "send "+ctx.bck.Cname(objName), si, err)
+	}
+	cos.DrainReader(resp.Body)
+	cos.Close(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		err = cmn.NewErrHTTP(req, fmt.Errorf("failed to send %s to %s: status %d", ctx.bck.Cname(objName), si, resp.StatusCode),
+			resp.StatusCode)
+	}
+	cmn.HreqFree(req)
+	return err
+}
+
+// unlike `markFailed`, does not fail the (archive-downloading) task itself
+func (task *singleTask) markMemberFailed(objName string, err error) {
+	core.T.StatsUpdater().Inc(stats.ErrDloadCount)
+	g.store.persistError(task.jobID(), TaskErrInfo{Name: objName, Err: err.Error()})
+}
@@ -0,0 +1,200 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Target-wide dedup of identical downloads: the same object (see `singleTask.uid`) may be
+// requested by more than one job or - e.g., upon mountpath changes - get dispatched to more
+// than one jogger. The first task to run (the leader) does the download, while the others
+// (followers) wait for it and then share its outcome: the same size and timing when it
+// succeeds, the same error when it fails.
+// Only identical requests share a download: same link, destination, and also the same
+// settings that affect the content or its validation (see `profile`).
+// Aborting a task detaches it from the flight; the download itself gets canceled only when
+// the last of its tasks goes away - or right away, when the downloader stops or the
+// mountpath is being disabled.
+
+type (
+	flights struct {
+		m  map[string]*flight // flight key => flight in progress
+		mu sync.Mutex
+	}
+	flight struct {
+		leader  *singleTask
+		waiters map[*singleTask]struct{} // tasks (including the leader) that still want the outcome
+		done    chan struct{}
+		// outcome (once done)
+		errInfo  *TaskErrInfo // nil when succeeded
+		requeued bool         // the leader's mountpath got disabled (see `dispatcher.requeue`)
+		mu       sync.Mutex
+	}
+)
+
+// content-affecting settings of the job: different profiles never share downloads
+func profile(b *Base) string {
+	p := struct {
+		Headers        any    `json:"h,omitempty"`
+		CksumManifest  any    `json:"m,omitempty"`
+		Metadata       any    `json:"md,omitempty"`
+		BasicAuth      string `json:"a,omitempty"`
+		ExtractPrefix  string `json:"xp,omitempty"`
+		Validator      string `json:"v,omitempty"`
+		PostProcess    string `json:"pp,omitempty"`
+		Extract        bool   `json:"x,omitempty"`
+		HeadOnly       bool   `json:"ho,omitempty"`
+		VerifyOrigin   bool   `json:"vo,omitempty"`
+		CaptureHeaders bool   `json:"ch,omitempty"`
+		Decompress     bool   `json:"d,omitempty"`
+	}{
+		BasicAuth:      b.BasicAuth,
+		ExtractPrefix:  b.ExtractPrefix,
+		Validator:      b.Validator,
+		PostProcess:    b.PostProcess,
+		Extract:        b.Extract,
+		HeadOnly:       b.HeadMode == HeadModeOnly,
+		VerifyOrigin:   b.VerifyOrigin,
+		CaptureHeaders: b.CaptureHeaders,
+		Decompress:     b.DecompressEncoding,
+	}
+	if len(b.Headers) > 0 {
+		p.Headers = b.Headers
+	}
+	if b.CksumManifest != nil {
+		p.CksumManifest = b.CksumManifest
+	}
+	if len(b.Metadata) > 0 {
+		p.Metadata = b.Metadata
+	}
+	return strconv.FormatUint(onexxh.Checksum64S(cos.MustMarshal(p), cos.MLCG32), 36)
+}
+
+func newFlights() *flights { return &flights{m: make(map[string]*flight, 16)} }
+
+// returns the flight of the task's object and whether the task is its leader
+func (f *flights) join(t *singleTask) (fl *flight, leader bool) {
+	key := t.flightKey()
+	f.mu.Lock()
+	fl, ok := f.m[key]
+	if !ok {
+		fl = &flight{leader: t, waiters: make(map[*singleTask]struct{}, 2), done: make(chan struct{})}
+		f.m[key] = fl
+	}
+	fl.mu.Lock()
+	fl.waiters[t] = struct{}{}
+	fl.mu.Unlock()
+	f.mu.Unlock()
+	t.fl = fl
+	return fl, !ok
+}
+
+// (the leader has run) record the outcome and release the followers
+func (f *flights) finish(fl *flight) {
+	t := fl.leader
+	f.mu.Lock()
+	delete(f.m, t.flightKey())
+	f.mu.Unlock()
+
+	fl.requeued = t.requeued
+	fl.errInfo = t.errInfo
+	close(fl.done)
+}
+
+// returns true when the task was the last one to wait for the outcome
+func (fl *flight) leave(t *singleTask) (last bool) {
+	fl.mu.Lock()
+	if _, ok := fl.waiters[t]; ok {
+		delete(fl.waiters, t)
+		last = len(fl.waiters) == 0
+	}
+	fl.mu.Unlock()
+	return last
+}
+
+////////////////
+// singleTask //
+////////////////
+
+func (task *singleTask) flightKey() string {
+	var sb strings.Builder
+	sb.WriteString(task.uid())
+	sb.WriteByte('|')
+	sb.WriteString(task.obj.link)
+	sb.WriteByte('|')
+	sb.WriteString(task.job.profile())
+	if task.obj.cksum != nil || len(task.obj.headers) > 0 || len(task.obj.mirrors) > 0 {
+		p := struct {
+			Headers any       `json:"h,omitempty"`
+			Mirrors []string  `json:"m,omitempty"`
+			Cksum   [2]string `json:"c"`
+		}{Mirrors: task.obj.mirrors}
+		if len(task.obj.headers) > 0 {
+			p.Headers = task.obj.headers
+		}
+		if task.obj.cksum != nil {
+			p.Cksum[0], p.Cksum[1] = task.obj.cksum.Get()
+		}
+		sb.WriteByte('|')
+		sb.WriteString(strconv.FormatUint(onexxh.Checksum64S(cos.MustMarshal(p), cos.MLCG32), 36))
+	}
+	return sb.String()
+}
+
+// wait for the leader to finish and share its outcome
+func (task *singleTask) follow(fl *flight) {
+	select {
+	case <-fl.done:
+	case <-task.downloadCtx.Done():
+	}
+	if cause := task.abortCause.Load(); cause != nil {
+		if *cause == CauseMpathDisabled {
+			task.requeued = true
+		} else {
+			task.markFailed(abortErrMsg(*cause), *cause)
+		}
+		return
+	}
+	switch {
+	case fl.requeued:
+		task.requeued = true // ditto
+	case fl.errInfo != nil:
+		task._markFailed(*fl.errInfo)
+	default:
+		leader := fl.leader
+		task.started.Store(leader.started.Load())
+		task.ended.Store(leader.ended.Load())
+		task.currentSize.Store(leader.currentSize.Load())
+		task.totalSize.Store(leader.totalSize.Load())
+		leader.mu.Lock()
+		hdrs, finalURL, mirror := leader.headers, leader.finalURL, leader.mirror
+		leader.mu.Unlock()
+		task.mu.Lock()
+		task.headers, task.finalURL, task.mirror = hdrs, finalURL, mirror
+		task.mu.Unlock()
+
+		g.store.incFinished(task.jobID())
+		g.store.incBck(task.jobID(), task.obj.bck, bckFinished)
+		g.store.addSize(task.jobID(), task.currentSize.Load())
+	}
+}
+
+func abortErrMsg(cause string) string {
+	switch cause {
+	case CauseClientCancel, CauseObjCancel:
+		return cancelErrorMsg
+	case CauseJobTimeout:
+		return deadlineErrorMsg
+	default:
+		return internalErrorMsg
+	}
+}
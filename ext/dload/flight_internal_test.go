@@ -0,0 +1,101 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFlights(t *testing.T) {
+	var (
+		bck    = meta.NewBck("bck", apc.AIS, cmn.NsGlobal)
+		obj    = dlObj{objName: "obj", link: "http://example.com/obj"}
+		hdrs   = http.Header{"X-Custom": []string{"value"}}
+		f      = newFlights()
+		newJob = func(id string, base *Base) *sliceDlJob {
+			return &sliceDlJob{baseDlJob: baseDlJob{id: id, bck: bck, prof: profile(base)}}
+		}
+		newTask = func(job jobif) *singleTask {
+			task := &singleTask{job: job, obj: obj}
+			task.init()
+			return task
+		}
+		job1 = newJob("job1", &Base{})
+		job2 = newJob("job2", &Base{})
+		job3 = newJob("job3", &Base{Headers: hdrs})
+	)
+
+	// same object, same settings: one download
+	t1, t2, t3 := newTask(job1), newTask(job2), newTask(job3)
+	fl1, leader := f.join(t1)
+	tassert.Errorf(t, leader, "expected the first task to lead")
+	fl2, leader := f.join(t2)
+	tassert.Errorf(t, !leader && fl2 == fl1, "expected the second task to follow the first")
+	tassert.Errorf(t, t1.key() != t2.key(), "expected distinct (job, uid) keys")
+
+	// different settings: separate download
+	fl3, leader := f.join(t3)
+	tassert.Errorf(t, leader && fl3 != fl1, "expected different headers to never share a download")
+
+	// the leader aborts while followed: detached, keeps downloading
+	t1.abort(CauseClientCancel)
+	tassert.Errorf(t, t1.detached.Load() && t1.downloadCtx.Err() == nil, "expected the leader to keep downloading")
+	tassert.Errorf(t, t1.failCause(&core.LOM{}, true) == CauseOriginError, "expected detached leader not to fail on its own")
+
+	// the last follower aborts: the download gets canceled
+	t2.abort(CauseObjCancel)
+	tassert.Errorf(t, t2.downloadCtx.Err() != nil, "expected the follower to stop waiting")
+	tassert.Errorf(t, t1.downloadCtx.Err() != nil, "expected the download to be canceled")
+	tassert.Errorf(t, t1.failCause(&core.LOM{}, true) == CauseClientCancel, "expected the leader's own cause, got %q",
+		t1.failCause(&core.LOM{}, true))
+
+	// outcome, and no more joining a finished flight
+	t1.errInfo = &TaskErrInfo{Name: obj.objName, Err: cancelErrorMsg, Cause: CauseClientCancel}
+	f.finish(fl1)
+	<-fl1.done
+	tassert.Errorf(t, fl1.errInfo != nil && fl1.errInfo.Cause == CauseClientCancel, "unexpected outcome: %+v", fl1.errInfo)
+	t4 := newTask(job2)
+	fl4, leader := f.join(t4)
+	tassert.Errorf(t, leader && fl4 != fl1, "expected a new flight once the previous one is done")
+
+	// shutdown cancels the leader right away
+	f.join(newTask(job1))
+	t4.abort(CauseShutdown)
+	tassert.Errorf(t, t4.downloadCtx.Err() != nil, "expected shutdown to cancel the download")
+}
+
+// response info gets written by the worker while status requests read it (run with -race)
+func TestTaskDlInfoConcurrent(t *testing.T) {
+	var (
+		task = &singleTask{obj: dlObj{objName: "obj"}}
+		hdr  = http.Header{"Etag": []string{"abc"}}
+		done = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+		for range 100 {
+			task.ToTaskDlInfo()
+		}
+	}()
+	for range 100 {
+		task.mu.Lock()
+		task.headers = nil
+		task.mu.Unlock()
+		task.captureHeaders(hdr)
+		task.mu.Lock()
+		task.finalURL, task.mirror = "http://example.com/final", "http://example.com/mirror"
+		task.mu.Unlock()
+	}
+	<-done
+	info := task.ToTaskDlInfo()
+	tassert.Errorf(t, info.Headers["ETag"] == "abc" && info.Mirror != "" && info.FinalURL != "", "unexpected info: %+v", info)
+}
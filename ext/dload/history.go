@@ -0,0 +1,133 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Job history: upon completion, each job leaves behind a compact summary (`HistEntry`) that
+// outlives the job itself - stored in its own collection and retained subject to
+// `DownloaderConf.HistoryMax` (count) and `DownloaderConf.HistoryTTL` (age).
+// Keys are prefixed with the (fixed-width, hex) finishing time, so that the keys
+// sort oldest-first and eviction doesn't need to read the values.
+
+const downloaderHistory = "download-history"
+
+var histMu sync.Mutex // serializes eviction
+
+func histKey(finished time.Time, id string) string {
+	return fmt.Sprintf("%016x-%s", finished.UnixNano(), id)
+}
+
+// keys below this one have expired
+func histCutoff(ttl time.Duration) string {
+	return fmt.Sprintf("%016x", time.Now().Add(-ttl).UnixNano())
+}
+
+// record the summary of a completed job
+func (is *infoStore) addHistory(id string, bck *cmn.Bck, labels cos.StrKVs) {
+	dljob, err := is.getJob(id)
+	if err != nil {
+		return
+	}
+	job := dljob.clone()
+	entry := &HistEntry{
+		ID:           job.ID,
+		Description:  job.Description,
+		Bck:          *bck,
+		Labels:       labels,
+		StartedTime:  job.StartedTime,
+		FinishedTime: job.FinishedTime,
+		Total:        job.Total,
+		FinishedCnt:  job.FinishedCnt,
+		SkippedCnt:   job.SkippedCnt,
+		ErrorCnt:     job.ErrorCnt,
+		Size:         dljob.size.Load(),
+		Aborted:      job.Aborted,
+		TimedOut:     job.TimedOut,
+	}
+	if err := putHistory(is.driver, entry, &cmn.GCO.Get().Downloader); err != nil {
+		nlog.Errorln("failed to record", id, "history:", err)
+	}
+}
+
+func putHistory(driver kvdb.Driver, entry *HistEntry, config *cmn.DownloaderConf) error {
+	histMu.Lock()
+	defer histMu.Unlock()
+	if _, err := driver.Set(downloaderHistory, histKey(entry.FinishedTime, entry.ID), entry); err != nil {
+		return err
+	}
+	keys, _, err := driver.List(downloaderHistory, "")
+	if err != nil {
+		return err
+	}
+	sort.Strings(keys)
+	var (
+		cutoff = histCutoff(config.HistoryMaxAge())
+		excess = len(keys) - config.HistoryMaxCount()
+	)
+	for i, key := range keys {
+		if i >= excess && key >= cutoff {
+			break
+		}
+		driver.Delete(downloaderHistory, key)
+	}
+	return nil
+}
+
+// returns ID => entry (compare w/ ListJobs)
+func getHistory(driver kvdb.Driver, regex *regexp.Regexp, config *cmn.DownloaderConf) (map[string]*HistEntry, error) {
+	histMu.Lock()
+	values, _, err := driver.GetAll(downloaderHistory, "")
+	histMu.Unlock()
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	var (
+		cutoff = histCutoff(config.HistoryMaxAge())
+		out    = make(map[string]*HistEntry, len(values))
+	)
+	for key, val := range values {
+		if key < cutoff {
+			continue // expired (to be evicted upon the next write)
+		}
+		entry := &HistEntry{}
+		if err := jsoniter.UnmarshalFromString(val, entry); err != nil {
+			nlog.Errorln("failed to unmarshal", key, "history:", err)
+			continue
+		}
+		if regex == nil || regex.MatchString(entry.Description) {
+			out[entry.ID] = entry
+		}
+	}
+	return out, nil
+}
+
+func ListHistory(regex *regexp.Regexp) (any, int, error) {
+	if g.db == nil {
+		return nil, http.StatusOK, nil
+	}
+	hist, err := getHistory(g.db, regex, &cmn.GCO.Get().Downloader)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return hist, http.StatusOK, nil
+}
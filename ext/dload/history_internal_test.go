@@ -0,0 +1,78 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHistory(t *testing.T) {
+	driver, err := kvdb.NewBuntDB(":memory:")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { driver.Close() })
+
+	config := &cmn.DownloaderConf{HistoryMax: 3, HistoryTTL: cos.Duration(time.Hour)}
+	hist, err := getHistory(driver, nil, config)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(hist) == 0, "expected empty history, got %d", len(hist))
+
+	// count-based eviction: oldest first
+	now := time.Now()
+	for i := range 5 {
+		entry := &HistEntry{
+			ID:           fmt.Sprintf("job%d", i),
+			Description:  fmt.Sprintf("daily-%d", i%2),
+			StartedTime:  now.Add(time.Duration(i-10) * time.Minute),
+			FinishedTime: now.Add(time.Duration(i-5) * time.Minute),
+			FinishedCnt:  10,
+			Size:         1000,
+		}
+		tassert.CheckFatal(t, putHistory(driver, entry, config))
+	}
+	hist, err = getHistory(driver, nil, config)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(hist) == 3, "expected 3 entries, got %d", len(hist))
+	for _, id := range []string{"job2", "job3", "job4"} {
+		tassert.Errorf(t, hist[id] != nil, "expected %s retained", id)
+	}
+
+	// filtered by description
+	hist, err = getHistory(driver, regexp.MustCompile("daily-0"), config)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(hist) == 2 && hist["job2"] != nil && hist["job4"] != nil, "expected job2 and job4, got %v", hist)
+
+	// age-based: expired entries are not listed and get evicted upon the next write
+	config.HistoryTTL = cos.Duration(150 * time.Second)
+	hist, err = getHistory(driver, nil, config)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(hist) == 2 && hist["job2"] == nil, "expected job2 expired, got %v", hist)
+
+	tassert.CheckFatal(t, putHistory(driver, &HistEntry{ID: "job5", FinishedTime: now}, config))
+	keys, _, err := driver.List(downloaderHistory, "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 3, "expected 3 keys, got %v", keys)
+
+	// aggregated across targets, most recently finished first
+	var (
+		lhs = &HistEntry{ID: "job", StartedTime: now, FinishedTime: now.Add(time.Minute), FinishedCnt: 1, Size: 10}
+		rhs = &HistEntry{ID: "job", StartedTime: now.Add(-time.Minute), FinishedTime: now, ErrorCnt: 1, Size: 20}
+	)
+	lhs.Aggregate(rhs)
+	tassert.Errorf(t, lhs.Size == 30 && lhs.FinishedCnt == 1 && lhs.ErrorCnt == 1, "unexpected %+v", lhs)
+	tassert.Errorf(t, lhs.Duration() == 2*time.Minute, "expected 2m, got %v", lhs.Duration())
+
+	entries := HistEntries{rhs, lhs}
+	sort.Sort(entries)
+	tassert.Errorf(t, entries[0] == lhs, "expected most recent first")
+}
@@ -0,0 +1,122 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Timeouts. The downloader's clients limit the time to connect, to complete the TLS handshake, and
+// (optionally) to receive the response headers - each separately (see `DownloaderConf.ConnectTimeout`
+// et al.). The clients themselves have no overall timeout: each request carries its own deadline
+// (see `attemptCtx`), so that the settings apply at runtime. With `DownloaderConf.ReadIdleTimeout`,
+// there is no limit on the duration of the entire transfer: the download timeout bounds the wait for
+// the response, after which a download fails only when no content arrives for the configured time.
+// Otherwise, the timeout bounds the entire attempt, as it always did.
+
+type (
+	// fails reads that wait for the content longer than allowed (only the time spent within `Read`
+	// counts: throttling, bandwidth pacing, and such, do not)
+	idleReader struct {
+		r     io.ReadCloser
+		timer *time.Timer // cancels the request
+		idle  time.Duration
+	}
+
+	// no content for `DownloaderConf.ReadIdleTimeout`
+	errReadIdle struct {
+		idle time.Duration
+	}
+)
+
+// (with the idle-read deadline) no response within the download timeout
+var errNoResponse = fmt.Errorf("no response: %w", context.DeadlineExceeded)
+
+// interface guard
+var _ io.ReadCloser = (*idleReader)(nil)
+
+// the downloader's clients (see above)
+func newClients(conf *cmn.DownloaderConf) (clientH, clientTLS *http.Client) {
+	cargs := cmn.TransportArgs{DialTimeout: conf.ConnectTimeoutDur()}
+	clientH = cmn.NewClient(cargs)
+	clientTLS = cmn.NewClientTLS(cargs, cmn.TLSArgs{SkipVerify: true}, false /*intra-cluster*/)
+	for _, client := range []*http.Client{clientH, clientTLS} {
+		transport := client.Transport.(*http.Transport)
+		transport.TLSHandshakeTimeout = conf.TLSHandshakeTimeoutDur()
+		transport.ResponseHeaderTimeout = conf.ResponseHeaderTimeout.D()
+		client.CheckRedirect = checkRedirect
+	}
+	return clientH, clientTLS
+}
+
+// returns the context of a single attempt, and the function to call once the response arrives
+func attemptCtx(parent context.Context, timeout, idle time.Duration) (context.Context, func(*http.Response), context.CancelFunc) {
+	if idle == 0 {
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		return ctx, func(*http.Response) {}, cancel
+	}
+	ctx, cancel := context.WithCancelCause(parent)
+	deadline := time.AfterFunc(timeout, func() { cancel(errNoResponse) })
+	received := func(resp *http.Response) {
+		deadline.Stop()
+		timer := time.AfterFunc(idle, func() { cancel(&errReadIdle{idle}) })
+		timer.Stop() // (armed upon read)
+		resp.Body = &idleReader{r: resp.Body, timer: timer, idle: idle}
+	}
+	return ctx, received, func() {
+		deadline.Stop()
+		cancel(nil)
+	}
+}
+
+// the attempt's failure, as caused by one of the idle-mode deadlines (if that's the case)
+func attemptErr(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	var (
+		cause   = context.Cause(ctx)
+		errIdle *errReadIdle
+	)
+	if errors.As(cause, &errIdle) || errors.Is(cause, errNoResponse) {
+		return cause
+	}
+	return err
+}
+
+////////////////
+// idleReader //
+////////////////
+
+func (ir *idleReader) Read(b []byte) (n int, err error) {
+	ir.timer.Reset(ir.idle)
+	n, err = ir.r.Read(b)
+	ir.timer.Stop()
+	return n, err
+}
+
+func (ir *idleReader) Close() error {
+	ir.timer.Stop()
+	return ir.r.Close()
+}
+
+/////////////////
+// errReadIdle //
+/////////////////
+
+func (e *errReadIdle) Error() string {
+	return fmt.Sprintf("no content received for %v (downloader.read_idle_timeout)", e.idle)
+}
+
+// (a timeout - see `classifyLinkErr`)
+func (*errReadIdle) Timeout() bool   { return true }
+func (*errReadIdle) Temporary() bool { return true }
@@ -0,0 +1,71 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReadIdle(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/steady": // takes longer than the timeout, with no long pauses
+			for range 10 {
+				w.Write([]byte("0123456789"))
+				w.(http.Flusher).Flush()
+				time.Sleep(30 * time.Millisecond)
+			}
+		case "/stalled":
+			w.Write([]byte("0123456789"))
+			w.(http.Flusher).Flush()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		case "/late":
+			time.Sleep(300 * time.Millisecond)
+		}
+	}))
+	defer origin.Close()
+
+	get := func(path string, timeout, idle time.Duration) (int, error) {
+		ctx, received, cancel := attemptCtx(context.Background(), timeout, idle)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin.URL+path, http.NoBody)
+		tassert.CheckFatal(t, err)
+		resp, err := origin.Client().Do(req)
+		if err != nil {
+			return 0, attemptErr(ctx, err)
+		}
+		defer resp.Body.Close()
+		received(resp)
+		b, err := io.ReadAll(resp.Body)
+		return len(b), attemptErr(ctx, err)
+	}
+
+	// wall-clock limit (the default)
+	_, err := get("/steady", 100*time.Millisecond, 0)
+	tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expected the timeout to cut the transfer short, got %v", err)
+
+	// idle-read deadline
+	n, err := get("/steady", 100*time.Millisecond, 200*time.Millisecond)
+	tassert.Errorf(t, err == nil && n == 100, "expected the entire content (got %d bytes, err %v)", n, err)
+
+	var errIdle *errReadIdle
+	_, err = get("/stalled", time.Second, 100*time.Millisecond)
+	tassert.Errorf(t, errors.As(err, &errIdle), "expected %T, got %v", errIdle, err)
+	tassert.Errorf(t, classifyLinkErr(err) == ErrClassTimeout, "expected %q class, got %q", ErrClassTimeout, classifyLinkErr(err))
+
+	_, err = get("/late", 100*time.Millisecond, time.Second)
+	tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expected no response in time, got %v", err)
+}
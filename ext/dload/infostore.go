@@ -10,17 +10,36 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
 )
 
-// TODO: stored only in memory, should be persisted at some point (powercycle)
+// Per-job metrics (`stats.DloadJob*`) are labeled with job ID; to bound the cardinality,
+// at most `maxLabeledJobs` jobs (in the store) get their own series at any given time -
+// all others share `otherJobVlabs`.
+// Labeled series are retired when the job is removed from the store.
+const (
+	maxLabeledJobs = 32
+	otherJobLabel  = "other"
+)
+
+var (
+	otherJobVlabs = map[string]string{stats.VlabJob: otherJobLabel}
+	jobMetrics    = [...]string{stats.DloadJobSize, stats.DloadJobCount, stats.DloadJobInflight, stats.ErrDloadJobCount}
+)
+
+// TODO: jobs are stored only in memory (powercycle); what survives is the per-object
+// state (see `ObjState`) that the status falls back to (see `dispatcher.persistedStatus`)
 type infoStore struct {
 	*downloaderDB
-	dljobs map[string]*dljob
+	dljobs  map[string]*dljob
+	labeled atomic.Int32 // number of jobs with their own labeled metrics
 	sync.RWMutex
 }
 
@@ -62,9 +81,30 @@ func (is *infoStore) setJob(job jobif) (njob *dljob) {
 	njob = &dljob{
 		id:          job.ID(),
 		xid:         job.XactID(),
-		total:       job.Len(),
 		description: job.Description(),
 		startedTime: time.Now(),
+		req:         job.request(),
+	}
+	njob.total.Store(int32(job.Len()))
+	njob.expected.Store(job.expectedSize())
+	njob.rate = job.meter()
+	if at := job.startAfter(); time.Until(at) > 0 {
+		njob.startAt = at
+		njob.startedTime = at // (planned)
+		njob.scheduled.Store(true)
+	}
+	if bcks := job.buckets(); len(bcks) > 0 {
+		njob.bcks = make([]*bckCnt, len(bcks))
+		for i, bck := range bcks {
+			njob.bcks[i] = &bckCnt{bck: *bck.Bucket()}
+		}
+	}
+	njob.vlabs = otherJobVlabs
+	if is.labeled.Inc() <= maxLabeledJobs {
+		njob.vlabs = map[string]string{stats.VlabJob: njob.id}
+		njob.labeled = true
+	} else {
+		is.labeled.Dec()
 	}
 	is.Lock()
 	is.dljobs[job.ID()] = njob
@@ -76,6 +116,7 @@ func (is *infoStore) incFinished(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.finishedCnt.Inc()
+	core.T.StatsUpdater().IncWith(stats.DloadJobCount, dljob.vlabs)
 }
 
 func (is *infoStore) incSkipped(id string) {
@@ -83,6 +124,17 @@ func (is *infoStore) incSkipped(id string) {
 	debug.AssertNoErr(err)
 	dljob.skippedCnt.Inc()
 	dljob.finishedCnt.Inc()
+	core.T.StatsUpdater().IncWith(stats.DloadJobCount, dljob.vlabs)
+}
+
+// skipped because already exists (and, optionally, verified)
+func (is *infoStore) incExisting(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.existingCnt.Inc()
+	dljob.skippedCnt.Inc()
+	dljob.finishedCnt.Inc()
+	core.T.StatsUpdater().IncWith(stats.DloadJobCount, dljob.vlabs)
 }
 
 func (is *infoStore) incScheduled(id string) {
@@ -91,10 +143,78 @@ func (is *infoStore) incScheduled(id string) {
 	dljob.scheduledCnt.Inc()
 }
 
+// open job: objects appended (see `MultiBody.Append`)
+func (is *infoStore) addExpected(id string, size int64) {
+	if size == 0 {
+		return
+	}
+	if dljob, err := is.getJob(id); err == nil {
+		dljob.expected.Add(size)
+	}
+}
+
+func (is *infoStore) growTotal(id string, total int) {
+	dljob, err := is.getJob(id)
+	if err != nil {
+		return
+	}
+	for n := int32(total); ; {
+		prev := dljob.total.Load()
+		if prev >= n || dljob.total.CAS(prev, n) {
+			return
+		}
+	}
+}
+
+func (is *infoStore) addSize(id string, size int64) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.size.Add(size)
+}
+
 func (is *infoStore) incErrorCnt(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
 	dljob.errorCnt.Inc()
+	core.T.StatsUpdater().IncWith(stats.ErrDloadJobCount, dljob.vlabs)
+}
+
+// per-job metrics: labels to use (see `maxLabeledJobs`)
+func (is *infoStore) jobVlabs(id string) map[string]string {
+	dljob, err := is.getJob(id)
+	if err != nil {
+		return otherJobVlabs
+	}
+	return dljob.vlabs
+}
+
+// multi-bucket job: per-bucket counters (no-op for single-bucket jobs)
+const (
+	bckScheduled = iota
+	bckFinished
+	bckError
+)
+
+func (is *infoStore) incBck(id string, bck *meta.Bck, what int) {
+	if bck == nil {
+		return
+	}
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	for _, c := range dljob.bcks {
+		if !c.bck.Equal(bck.Bucket()) {
+			continue
+		}
+		switch what {
+		case bckScheduled:
+			c.scheduledCnt.Inc()
+		case bckFinished:
+			c.finishedCnt.Inc()
+		case bckError:
+			c.errorCnt.Inc()
+		}
+		return
+	}
 }
 
 func (is *infoStore) setAllDispatched(id string, dispatched bool) {
@@ -113,6 +233,13 @@ func (is *infoStore) markFinished(id string) (error, bool /*aborted*/) {
 	return dljob.valid(), dljob.aborted.Load()
 }
 
+// scheduled job: time to start (see `dispatcher.schedule`)
+func (is *infoStore) setStarted(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.scheduled.Store(false)
+}
+
 func (is *infoStore) setAborted(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
@@ -122,7 +249,37 @@ func (is *infoStore) setAborted(id string) {
 	//       that all tasks have been stopped and all resources were freed.
 }
 
+// cancel a single object (see `AdminBody.ObjName`)
+func (is *infoStore) cancelObj(id, objName string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.cancelled.Store(objName, struct{}{})
+}
+
+func (is *infoStore) objCancelled(id, objName string) bool {
+	dljob, err := is.getJob(id)
+	if err != nil {
+		return false
+	}
+	_, ok := dljob.cancelled.Load(objName)
+	return ok
+}
+
+func (is *infoStore) setTimedOut(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.timedOut.Store(true)
+	dljob.aborted.Store(true) // (ditto)
+}
+
 func (is *infoStore) delJob(id string) {
+	if dljob, ok := is.dljobs[id]; ok && dljob.labeled {
+		tstats := core.T.StatsUpdater()
+		for _, name := range jobMetrics {
+			tstats.DelWith(name, dljob.vlabs)
+		}
+		is.labeled.Dec()
+	}
 	delete(is.dljobs, id)
 	is.downloaderDB.delete(id)
 }
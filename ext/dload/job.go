@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -21,11 +22,16 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/nl"
+
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	// Determines the size of single batch size generated in `genNext`.
 	downloadBatchSize = 10_000
+
+	// multi-bucket backend job: max number of buckets listed concurrently
+	maxParallelLists = 4
 )
 
 // interface guard
@@ -40,6 +46,12 @@ type (
 		objName    string
 		link       string
 		fromRemote bool
+		force      bool          // overrides "already exists" skip (see `Base.ForceOverwrite`)
+		timeout    time.Duration // overrides the job's timeout for this object only (see `MultiBody.ObjTimeouts`)
+		bck        *meta.Bck     // multi-bucket job or per-object provider (nil: job's bucket)
+		mirrors    []string      // fallback links, in order (see `MultiBody.ObjMirrors`)
+		cksum      *cos.Cksum    // expected checksum (see `ObjChecksum`)
+		headers    http.Header   // override the job's headers for this object only (see `MultiBody.ObjHeaders`)
 	}
 
 	jobif interface {
@@ -54,12 +66,32 @@ type (
 		AddNotif(n core.Notif, job jobif)
 		Headers() http.Header
 
+		// Determines if existing objects must be validated prior to skipping.
+		VerifyExisting() bool
+
+		// Determines if a given object must be downloaded even if it already exists.
+		forceOverwrite(objName string) bool
+
+		// delayed start (zero if unspecified; see `Base.StartAfter`)
+		startAfter() time.Time
+
+		// the request (as submitted)
+		request() *Base
+
+		// job deadline (zero if unspecified) and whether it's been exceeded (see `Base.Deadline`)
+		deadline() time.Time
+		expire() bool
+		expired() bool
+
 		// If total length (size) of download job is not known, -1 should be returned.
 		Len() int
 
 		// Determines if it requires also syncing.
 		Sync() bool
 
+		// download only the objects missing in-cluster (see `BackendBody.Backfill`)
+		backfill() bool
+
 		// Checks if object name matches the request.
 		checkObj(objName string) bool
 
@@ -70,19 +102,94 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// non-nil iff the job limits its own download rate (see `Limits.BytesPerSecond`)
+		bandwidth() *bandwidth
+
+		// non-nil iff redirects must be resolved (see `Base.ResolveRedirects`)
+		canonical() *canonResolver
+
+		// whether to extract downloaded archives and, if so, the destination prefix
+		extractTo() (prefix string, ok bool)
+
+		// non-nil iff downloaded objects must be verified (see `Base.CksumManifest`)
+		manifest() *cksumManifest
+
+		// whether to verify downloaded objects against origin-provided MD5 (see `Base.VerifyOrigin`)
+		verifyOrigin() bool
+
+		// whether to record selected response headers (see `Base.CaptureHeaders`)
+		captureHeaders() bool
+
+		// non-nil iff the job has its own credentials (see `Base.BasicAuth`)
+		auth() CredsProvider
+
+		// total size reported by the origins at submission (see `MultiBody.ValidateLinks`)
+		expectedSize() int64
+
+		// content-affecting settings: jobs with different profiles never share downloads (see flight.go)
+		profile() string
+
+		// bytes received over the trailing window (see `StatusResp.Throughput`)
+		meter() *rateMeter
+
+		// non-nil iff downloaded content must be validated (see `Base.Validator`)
+		validator() validator
+
+		// non-nil iff downloaded content must be transformed prior to storing (see `Base.PostProcess`)
+		postProcessor() postProcessor
+
+		// whether to decode gzip- or deflate-encoded responses (see `Base.DecompressEncoding`)
+		decompress() bool
+
+		// pending tasks of higher-priority jobs come first (see `Base.Priority`)
+		priority() int
+
+		// user-defined custom metadata to store with each downloaded object (see `Base.Metadata`)
+		metadata() cos.StrKVs
+
+		// HEAD only, i.e., do not download (see `Base.HeadMode`)
+		headOnly() bool
+
+		// multi-bucket job: all buckets (nil otherwise)
+		buckets() []*meta.Bck
+
 		// job cleanup
 		cleanup()
 	}
 
 	baseDlJob struct {
 		bck         *meta.Bck
+		req         *Base // as submitted (see `StatusResp.Request`)
 		notif       *NotifDownload
 		xdl         *Xact
 		id          string
 		description string
 		timeout     time.Duration
 		headers     http.Header
+		creds       CredsProvider // see `Base.BasicAuth`
+		namer       *namer
+		canon       *canonResolver
+		force       cos.StrSet // see `Base.ForceOverwrite`
+		dline       time.Time  // see `Base.Deadline`
+		startAt     time.Time  // see `Base.StartAfter`
+		expiredX    atomic.Bool
+		cksums      *cksumManifest
 		throt       throttler
+		bw          *bandwidth    // see `Limits.BytesPerSecond`
+		prefix      string        // destination prefix for extracted archive members
+		verify      bool          // validate existing objects before skipping (see `Base.VerifyExisting`)
+		vorigin     bool          // see `Base.VerifyOrigin`
+		extract     bool          // store archive members rather than archives (see `Base.Extract`)
+		onlyHead    bool          // HeadModeOnly
+		capHdrs     bool          // see `Base.CaptureHeaders`
+		valid       validator     // see `Base.Validator`
+		post        postProcessor // see `Base.PostProcess`
+		decode      bool          // see `Base.DecompressEncoding`
+		prio        int           // see `Base.Priority`
+		md          cos.StrKVs    // see `Base.Metadata`
+		expected    int64         // see `MultiBody.ValidateLinks`
+		prof        string        // see `profile`
+		rate        *rateMeter    // see `StatusResp.Throughput`
 	}
 
 	sliceDlJob struct {
@@ -92,6 +199,13 @@ type (
 	}
 	multiDlJob struct {
 		sliceDlJob
+		app *appender // non-nil: open for appending (see `MultiBody.Append`)
+	}
+	// open multi-object job: appended objects and the (final) seal
+	appender struct {
+		more   chan struct{} // signals (cap 1) appended objects or seal
+		mu     sync.Mutex    // protects `objs` and `current` of the (embedded) sliceDlJob
+		sealed bool
 	}
 	singleDlJob struct {
 		sliceDlJob
@@ -108,11 +222,20 @@ type (
 
 	backendDlJob struct {
 		baseDlJob
+		prefix string
+		suffix string
+		srcs   []*bckSrc // the job's bucket followed by `BackendBody.Buckets`, if any
+		objs   []dlObj   // objects' metas which are ready to be downloaded
+		sync   bool
+		fill   bool // see `BackendBody.Backfill`
+		done   bool
+	}
+	// remote bucket to list and its listing state
+	bckSrc struct {
+		bck               *meta.Bck
 		prefix            string
 		suffix            string
 		continuationToken string
-		objs              []dlObj // objects' metas which are ready to be downloaded
-		sync              bool
 		done              bool
 	}
 
@@ -125,10 +248,30 @@ type (
 		finishedCnt   atomic.Int32
 		scheduledCnt  atomic.Int32
 		skippedCnt    atomic.Int32
+		existingCnt   atomic.Int32
 		errorCnt      atomic.Int32
-		total         int
+		total         atomic.Int32 // grows as objects get appended (see `MultiBody.Append`)
+		size          atomic.Int64 // total size of the downloaded objects (see `HistEntry`)
+		expected      atomic.Int64 // (see `StatusResp.ExpectedBytes`)
+		rate          *rateMeter   // (see `StatusResp.Throughput`)
 		aborted       atomic.Bool
+		timedOut      atomic.Bool
+		scheduled     atomic.Bool // waiting for `startAt` (see `Base.StartAfter`)
+		queued        atomic.Bool // waiting for a per-bucket slot (see `DownloaderConf.MaxJobsPerBck`)
+		startAt       time.Time
+		req           *Base             // (see `StatusResp.Request`)
+		bcks          []*bckCnt         // multi-bucket job
+		vlabs         map[string]string // per-job metrics (see `maxLabeledJobs`)
+		labeled       bool              // vlabs are this job's own (rather than shared `otherJobVlabs`)
 		allDispatched atomic.Bool
+		cancelled     sync.Map // objName => struct{}: objects cancelled individually (see `AdminBody.ObjName`)
+	}
+	// per-bucket counters (see `BckProgress`)
+	bckCnt struct {
+		bck          cmn.Bck
+		scheduledCnt atomic.Int32
+		finishedCnt  atomic.Int32
+		errorCnt     atomic.Int32
 	}
 )
 
@@ -136,22 +279,55 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits Limits, headers http.Header, xdl *Xact) {
+func (j *baseDlJob) init(id string, bck *meta.Bck, base *Base, desc string, xdl *Xact) (err error) {
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
+	limits := base.Limits
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= core.T.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
+	req := *base
+	req.Headers = redactHeaders(base.Headers) // (reported with the job's status)
 	{
 		j.id = id
 		j.bck = bck
+		j.req = &req
 		j.timeout = td
 		j.description = desc
-		j.headers = headers
+		j.headers = base.Headers
+		j.namer = base.namer()
+		if base.ResolveRedirects {
+			j.canon = newCanonResolver()
+		}
+		j.verify = base.VerifyExisting
+		now := time.Now()
+		j.dline, _ = ParseDeadline(base.Deadline, now) // validated
+		j.startAt, _ = ParseStartAfter(base.StartAfter, now)
+		if len(base.ForceOverwrite) > 0 {
+			j.force = cos.NewStrSet(base.ForceOverwrite...)
+		}
+		j.extract = base.Extract
+		if base.CksumManifest != nil {
+			j.cksums = newCksumManifest(base.CksumManifest)
+		}
+		j.vorigin = base.VerifyOrigin
+		j.capHdrs = base.CaptureHeaders
+		j.valid = newValidator(base.Validator)
+		j.post = newPostProcessor(base.PostProcess)
+		j.decode = base.DecompressEncoding
+		j.prio = base.Priority
+		j.md = base.Metadata
+		j.prof = profile(base)
+		j.rate = newRateMeter(cmn.GCO.Get().Downloader.RateWindowDur())
+		j.onlyHead = base.HeadMode == HeadModeOnly
+		j.prefix = base.ExtractPrefix
 		j.throt.init(limits)
+		j.bw = newBandwidth(int64(base.Limits.BytesPerSecond))
 		j.xdl = xdl
 	}
+	j.creds, err = credsRef(base.BasicAuth)
+	return err
 }
 
 func (j *baseDlJob) ID() string             { return j.id }
@@ -160,7 +336,21 @@ func (j *baseDlJob) Bck() *cmn.Bck          { return j.bck.Bucket() }
 func (j *baseDlJob) Timeout() time.Duration { return j.timeout }
 func (j *baseDlJob) Description() string    { return j.description }
 func (j *baseDlJob) Headers() http.Header   { return j.headers }
+func (j *baseDlJob) auth() CredsProvider    { return j.creds }
+func (j *baseDlJob) expectedSize() int64    { return j.expected }
+func (j *baseDlJob) profile() string        { return j.prof }
+func (j *baseDlJob) meter() *rateMeter      { return j.rate }
+func (j *baseDlJob) VerifyExisting() bool   { return j.verify }
 func (*baseDlJob) Sync() bool               { return false }
+func (*baseDlJob) backfill() bool           { return false }
+
+func (j *baseDlJob) forceOverwrite(objName string) bool { return j.force.Contains(objName) }
+
+func (j *baseDlJob) startAfter() time.Time { return j.startAt }
+func (j *baseDlJob) request() *Base        { return j.req }
+func (j *baseDlJob) deadline() time.Time   { return j.dline }
+func (j *baseDlJob) expire() bool          { return j.expiredX.CAS(false, true) }
+func (j *baseDlJob) expired() bool         { return j.expiredX.Load() }
 
 func (j *baseDlJob) String() (s string) {
 	s = fmt.Sprintf("dl-job[%s]-%s", j.ID(), j.Bck())
@@ -192,8 +382,22 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 	return resp.(*StatusResp), nil
 }
 
-func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
-func (j *baseDlJob) throttler() *throttler { return &j.throt }
+func (*baseDlJob) checkObj(string) bool        { debug.Assert(false); return false }
+func (j *baseDlJob) throttler() *throttler     { return &j.throt }
+func (j *baseDlJob) bandwidth() *bandwidth     { return j.bw }
+func (j *baseDlJob) canonical() *canonResolver { return j.canon }
+
+func (j *baseDlJob) extractTo() (string, bool)    { return j.prefix, j.extract }
+func (j *baseDlJob) manifest() *cksumManifest     { return j.cksums }
+func (j *baseDlJob) verifyOrigin() bool           { return j.vorigin }
+func (j *baseDlJob) captureHeaders() bool         { return j.capHdrs }
+func (j *baseDlJob) validator() validator         { return j.valid }
+func (j *baseDlJob) postProcessor() postProcessor { return j.post }
+func (j *baseDlJob) decompress() bool             { return j.decode }
+func (j *baseDlJob) priority() int                { return j.prio }
+func (j *baseDlJob) metadata() cos.StrKVs         { return j.md }
+func (j *baseDlJob) headOnly() bool               { return j.onlyHead }
+func (*baseDlJob) buckets() []*meta.Bck           { return nil }
 
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
@@ -203,6 +407,7 @@ func (j *baseDlJob) cleanup() {
 		nlog.Errorln(j.String()+":", err, aborted)
 	}
 	g.store.flush(j.ID())
+	g.store.addHistory(j.ID(), j.Bck(), j.md)
 	nl.OnFinished(j.Notif(), err, aborted)
 }
 
@@ -210,8 +415,8 @@ func (j *baseDlJob) cleanup() {
 // sliceDlJob -- multiDlJob -- singleDlJob
 //
 
-func (j *sliceDlJob) init(bck *meta.Bck, objects cos.StrKVs) error {
-	objs, err := buildDlObjs(bck, objects)
+func (j *sliceDlJob) init(bck *meta.Bck, objects cos.StrKVs, bcks map[string]*meta.Bck) error {
+	objs, err := buildDlObjs(bck, objects, bcks)
 	if err != nil {
 		return err
 	}
@@ -219,6 +424,111 @@ func (j *sliceDlJob) init(bck *meta.Bck, objects cos.StrKVs) error {
 	return nil
 }
 
+// per-object timeouts (validated by `MultiBody.Validate`)
+func (j *sliceDlJob) setTimeouts(timeouts cos.StrKVs) error { return setTimeouts(j.objs, timeouts) }
+
+func setTimeouts(objs []dlObj, timeouts cos.StrKVs) error {
+	tmap := make(map[string]time.Duration, len(timeouts))
+	for name, s := range timeouts {
+		objName, err := NormalizeObjName(name)
+		if err != nil {
+			return err
+		}
+		if tmap[objName], err = time.ParseDuration(s); err != nil {
+			return err
+		}
+	}
+	for i := range objs {
+		objs[i].timeout = tmap[objs[i].objName]
+	}
+	return nil
+}
+
+// per-object fallback links (validated by `MultiBody.Validate`)
+func setMirrors(objs []dlObj, mirrors map[string][]string) error {
+	mmap := make(map[string][]string, len(mirrors))
+	for name, links := range mirrors {
+		objName, err := NormalizeObjName(name)
+		if err != nil {
+			return err
+		}
+		mmap[objName] = make([]string, 0, len(links))
+		for _, link := range links {
+			mmap[objName] = append(mmap[objName], cmn.PrependProtocol(link))
+		}
+	}
+	for i := range objs {
+		if !objs[i].fromRemote {
+			objs[i].mirrors = mmap[objs[i].objName]
+		}
+	}
+	return nil
+}
+
+// per-object expected checksums (validated by `MultiBody.Validate`)
+func setCksums(objs []dlObj, cksums map[string]ObjChecksum) error {
+	cmap := make(map[string]*cos.Cksum, len(cksums))
+	for name, ck := range cksums {
+		objName, err := NormalizeObjName(name)
+		if err != nil {
+			return err
+		}
+		cmap[objName] = ck.cksum()
+	}
+	for i := range objs {
+		objs[i].cksum = cmap[objs[i].objName]
+	}
+	return nil
+}
+
+// per-object headers (validated by `MultiBody.Validate`)
+func setHeaders(objs []dlObj, headers map[string]http.Header) error {
+	hmap := make(map[string]http.Header, len(headers))
+	for name, hdr := range headers {
+		objName, err := NormalizeObjName(name)
+		if err != nil {
+			return err
+		}
+		hmap[objName] = hdr
+	}
+	for i := range objs {
+		objs[i].headers = hmap[objs[i].objName]
+	}
+	return nil
+}
+
+// per-object destination buckets, object name => bucket (validated by `MultiBody.Validate`);
+// objects with no provider or with the job's own provider are not included
+func objBcks(bck *meta.Bck, providers cos.StrKVs) (map[string]*meta.Bck, error) {
+	if len(providers) == 0 {
+		return nil, nil
+	}
+	var (
+		bcks  = make(map[string]*meta.Bck, len(providers))
+		provs = make(map[string]*meta.Bck, 2)
+	)
+	for name, p := range providers {
+		objName, err := NormalizeObjName(name)
+		if err != nil {
+			return nil, err
+		}
+		p = apc.NormalizeProvider(p)
+		if p == bck.Provider {
+			continue
+		}
+		b, ok := provs[p]
+		if !ok {
+			b = meta.NewBck(bck.Name, p, bck.Ns)
+			if err := b.Init(core.T.Bowner()); err != nil {
+				return nil, err
+			}
+			provs[p] = b
+		}
+		bcks[objName] = b
+	}
+	return bcks, nil
+}
+
 func (j *sliceDlJob) Len() int { return len(j.objs) }
 
 func (j *sliceDlJob) genNext() (objs []dlObj, ok bool, err error) {
@@ -237,30 +547,142 @@ func (j *sliceDlJob) genNext() (objs []dlObj, ok bool, err error) {
 }
 
 func newMultiDlJob(id string, bck *meta.Bck, payload *MultiBody, xdl *Xact) (mj *multiDlJob, err error) {
-	var objs cos.StrKVs
+	var (
+		objs cos.StrKVs
+		bcks map[string]*meta.Bck
+	)
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, payload.Headers, xdl)
+	if err = mj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
 	}
-	err = mj.sliceDlJob.init(bck, objs)
+	if bcks, err = objBcks(bck, payload.ObjProviders); err != nil {
+		return nil, err
+	}
+	if err = mj.sliceDlJob.init(bck, objs, bcks); err != nil {
+		return nil, err
+	}
+	if len(payload.ObjTimeouts) > 0 {
+		if err = mj.sliceDlJob.setTimeouts(payload.ObjTimeouts); err != nil {
+			return nil, err
+		}
+	}
+	if len(payload.ObjMirrors) > 0 {
+		if err = setMirrors(mj.objs, payload.ObjMirrors); err != nil {
+			return nil, err
+		}
+	}
+	if len(payload.ObjChecksums) > 0 {
+		if err = setCksums(mj.objs, payload.ObjChecksums); err != nil {
+			return nil, err
+		}
+	}
+	if len(payload.ObjHeaders) > 0 {
+		if err = setHeaders(mj.objs, payload.ObjHeaders); err != nil {
+			return nil, err
+		}
+	}
+	if payload.ValidateLinks {
+		if mj.expected, err = mj.preflight(mj.objs); err != nil {
+			return nil, err
+		}
+	}
+	if payload.Append {
+		debug.Assert(payload.JobID == "") // (ParseAppendRequest)
+		mj.app = &appender{more: make(chan struct{}, 1)}
+	}
 	return
 }
 
 func (j *multiDlJob) String() (s string) { return "multi-" + j.baseDlJob.String() }
 
+func (j *multiDlJob) Len() int {
+	if j.app == nil {
+		return j.sliceDlJob.Len()
+	}
+	j.app.mu.Lock()
+	l := len(j.objs)
+	j.app.mu.Unlock()
+	return l
+}
+
+// open job: wait for more objects until sealed (or aborted)
+func (j *multiDlJob) genNext() (objs []dlObj, ok bool, err error) {
+	if j.app == nil {
+		return j.sliceDlJob.genNext()
+	}
+	d := j.xdl.dispatcher
+	for {
+		j.app.mu.Lock()
+		objs, ok, err = j.sliceDlJob.genNext()
+		sealed := j.app.sealed
+		j.app.mu.Unlock()
+		if ok || sealed {
+			return objs, ok, err
+		}
+		select {
+		case <-j.app.more:
+		case <-d.jobAbortedCh(j.id).Listen():
+			return nil, false, nil
+		case <-d.stopCh.Listen():
+			return nil, false, nil
+		}
+	}
+}
+
+// returns the resulting total number of objects (this target)
+func (j *multiDlJob) append(objs []dlObj, seal bool) (int, error) {
+	j.app.mu.Lock()
+	if j.app.sealed {
+		j.app.mu.Unlock()
+		return 0, fmt.Errorf("%s is sealed (no more additions)", j)
+	}
+	j.objs = append(j.objs, objs...)
+	j.app.sealed = seal
+	l := len(j.objs)
+	j.app.mu.Unlock()
+
+	select {
+	case j.app.more <- struct{}{}:
+	default:
+	}
+	return l, nil
+}
+
+func (j *multiDlJob) cleanup() {
+	if j.app != nil {
+		j.xdl.dispatcher.closeJob(j.id)
+	}
+	j.baseDlJob.cleanup()
+}
+
 func newSingleDlJob(id string, bck *meta.Bck, payload *SingleBody, xdl *Xact) (sj *singleDlJob, err error) {
 	var objs cos.StrKVs
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, payload.Headers, xdl)
+	if err = sj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
 	}
-	err = sj.sliceDlJob.init(bck, objs)
+	if err = sj.sliceDlJob.init(bck, objs, nil); err != nil {
+		return nil, err
+	}
+	if payload.Checksum != "" {
+		ck := ObjChecksum{Type: payload.ChecksumType, Value: payload.Checksum}
+		if err = setCksums(sj.objs, map[string]ObjChecksum{payload.ObjName: ck}); err != nil {
+			return nil, err
+		}
+	}
+	if len(payload.Mirrors) > 0 {
+		err = setMirrors(sj.objs, map[string][]string{payload.ObjName: payload.Mirrors})
+	}
 	return
 }
 
@@ -275,12 +697,15 @@ func (j *singleDlJob) String() (s string) {
 // NOTE: the sizes of objects to be downloaded will be unknown.
 func newRangeDlJob(id string, bck *meta.Bck, payload *RangeBody, xdl *Xact) (rj *rangeDlJob, err error) {
 	rj = &rangeDlJob{}
-	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
+	config := cmn.GCO.Get()
+	if rj.pt, err = payload.ParseTemplate(config.Downloader.MaxRangeCount()); err != nil {
+		return nil, err
+	}
+	if err = rj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, payload.Headers, xdl)
 
-	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck); err != nil {
+	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck, rj.namer); err != nil {
 		return nil, err
 	}
 	rj.pt.InitIter()
@@ -317,7 +742,10 @@ func (j *rangeDlJob) getNextObjs() error {
 			j.done = true
 			break
 		}
-		name := path.Join(j.dir, path.Base(link))
+		name, err := j.namer.derive(path.Base(link), j.dir)
+		if err != nil {
+			return err
+		}
 		obj, err := makeDlObj(smap, sid, j.bck, name, link)
 		if err != nil {
 			if err == errInvalidTarget {
@@ -341,17 +769,49 @@ func newBackendDlJob(id string, bck *meta.Bck, payload *BackendBody, xdl *Xact)
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, nil, xdl)
+	if err = bj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl); err != nil {
+		return nil, err
+	}
 	{
+		bj.headers = nil // n/a: remote objects are fetched via backend API
 		bj.sync = payload.Sync
+		bj.fill = payload.Backfill
 		bj.prefix = payload.Prefix
 		bj.suffix = payload.Suffix
 	}
+	bj.srcs = make([]*bckSrc, 0, len(payload.Buckets)+1)
+	bj.srcs = append(bj.srcs, &bckSrc{bck: bck, prefix: payload.Prefix, suffix: payload.Suffix})
+	for i := range payload.Buckets {
+		spec := &payload.Buckets[i]
+		src := &bckSrc{bck: meta.CloneBck(&spec.Bck), prefix: spec.Prefix, suffix: spec.Suffix}
+		if err := src.bck.Init(core.T.Bowner()); err != nil {
+			return nil, err
+		}
+		if !src.bck.IsRemote() || src.bck.IsHT() {
+			return nil, fmt.Errorf("bucket download requires a remote (non-HTTP) bucket, got %s", src.bck.Cname(""))
+		}
+		if src.prefix == "" && src.suffix == "" {
+			src.prefix, src.suffix = payload.Prefix, payload.Suffix
+		}
+		bj.srcs = append(bj.srcs, src)
+	}
 	return
 }
 
-func (*backendDlJob) Len() int     { return -1 }
-func (j *backendDlJob) Sync() bool { return j.sync }
+func (*backendDlJob) Len() int         { return -1 }
+func (j *backendDlJob) Sync() bool     { return j.sync }
+func (j *backendDlJob) backfill() bool { return j.fill }
+
+func (j *backendDlJob) buckets() (bcks []*meta.Bck) {
+	if len(j.srcs) < 2 {
+		return nil
+	}
+	bcks = make([]*meta.Bck, len(j.srcs))
+	for i, src := range j.srcs {
+		bcks[i] = src.bck
+	}
+	return bcks
+}
 
 func (j *backendDlJob) String() (s string) {
 	return fmt.Sprintf("backend-%s-%s-%s", &j.baseDlJob, j.prefix, j.suffix)
@@ -371,66 +831,136 @@ func (j *backendDlJob) genNext() (objs []dlObj, ok bool, err error) {
 	return j.objs, true, nil
 }
 
-// Reads the content of a remote bucket page by page until any objects to
-// download found or the bucket list is over.
+// Reads the content of remote bucket(s) page by page until any objects to
+// download found or the bucket list is over. Multiple buckets are listed
+// concurrently, at most `maxParallelLists` at a time.
 func (j *backendDlJob) getNextObjs() error {
 	var (
-		sid     = core.T.SID()
-		smap    = core.T.Sowner().Get()
-		backend = core.T.Backend(j.bck)
+		sid   = core.T.SID()
+		smap  = core.T.Sowner().Get()
+		multi = len(j.srcs) > 1
+		srcs  = make([]*bckSrc, 0, maxParallelLists)
+		pages = make([][]string, maxParallelLists)
 	)
 	j.objs = j.objs[:0]
 	for len(j.objs) < downloadBatchSize {
-		var (
-			lst = &cmn.LsoRes{}
-			msg = &apc.LsoMsg{Prefix: j.prefix, ContinuationToken: j.continuationToken, PageSize: j.bck.MaxPageSize()}
-		)
-		_, err := backend.ListObjects(j.bck, msg, lst)
-		if err != nil {
-			return err
-		}
-		j.continuationToken = lst.ContinuationToken
-
-		for _, entry := range lst.Entries {
-			if !j.checkObj(entry.Name) {
-				continue
+		srcs = srcs[:0]
+		for _, src := range j.srcs {
+			if !src.done && len(srcs) < maxParallelLists {
+				srcs = append(srcs, src)
 			}
-			obj, err := makeDlObj(smap, sid, j.bck, entry.Name, "")
+		}
+		if len(srcs) == 0 {
+			j.done = true
+			break
+		}
+		if len(srcs) == 1 {
+			names, err := srcs[0].nextPage()
 			if err != nil {
-				if err == errInvalidTarget {
-					continue
-				}
 				return err
 			}
-			j.objs = append(j.objs, obj)
+			pages[0] = names
+		} else {
+			group := &errgroup.Group{}
+			for i, src := range srcs {
+				group.Go(func() (err error) {
+					pages[i], err = src.nextPage()
+					return err
+				})
+			}
+			if err := group.Wait(); err != nil {
+				return err
+			}
 		}
-		if j.continuationToken == "" {
-			j.done = true
-			break
+
+		for i, src := range srcs {
+			for _, name := range pages[i] {
+				obj, err := makeDlObj(smap, sid, src.bck, name, "")
+				if err != nil {
+					if err == errInvalidTarget {
+						continue
+					}
+					return err
+				}
+				if multi {
+					obj.bck = src.bck
+				}
+				j.objs = append(j.objs, obj)
+			}
+			pages[i] = nil
 		}
 	}
 	return nil
 }
 
+////////////
+// bckSrc //
+////////////
+
+// returns (prefix, suffix)-matching names from the next page
+func (src *bckSrc) nextPage() ([]string, error) {
+	var (
+		lst = &cmn.LsoRes{}
+		msg = &apc.LsoMsg{Prefix: src.prefix, ContinuationToken: src.continuationToken, PageSize: src.bck.MaxPageSize()}
+	)
+	if _, err := core.T.Backend(src.bck).ListObjects(src.bck, msg, lst); err != nil {
+		return nil, err
+	}
+	src.continuationToken = lst.ContinuationToken
+	src.done = src.continuationToken == ""
+
+	names := make([]string, 0, len(lst.Entries))
+	for _, entry := range lst.Entries {
+		if strings.HasPrefix(entry.Name, src.prefix) && strings.HasSuffix(entry.Name, src.suffix) {
+			names = append(names, entry.Name)
+		}
+	}
+	return names, nil
+}
+
 ///////////
 // dljob //
 ///////////
 
 func (j *dljob) clone() Job {
-	return Job{
+	job := Job{
 		ID:            j.id,
 		XactID:        j.xid,
 		Description:   j.description,
 		FinishedCnt:   int(j.finishedCnt.Load()),
 		ScheduledCnt:  int(j.scheduledCnt.Load()),
 		SkippedCnt:    int(j.skippedCnt.Load()),
+		ExistingCnt:   int(j.existingCnt.Load()),
 		ErrorCnt:      int(j.errorCnt.Load()),
-		Total:         j.total,
+		Total:         int(j.total.Load()),
 		AllDispatched: j.allDispatched.Load(),
 		Aborted:       j.aborted.Load(),
+		TimedOut:      j.timedOut.Load(),
+		Scheduled:     j.scheduled.Load(),
+		Queued:        j.queued.Load(),
+		StartAt:       j.startAt,
 		StartedTime:   j.startedTime,
 		FinishedTime:  j.finishedTime.Load(),
+		Buckets:       j.bckProgress(),
+	}
+	job.Paused = job.JobRunning() && !job.Scheduled && !job.Queued && g.reb.paused()
+	return job
+}
+
+func (j *dljob) bckProgress() []BckProgress {
+	if len(j.bcks) == 0 {
+		return nil
+	}
+	progress := make([]BckProgress, len(j.bcks))
+	for i, c := range j.bcks {
+		progress[i] = BckProgress{
+			Bck:          c.bck,
+			ScheduledCnt: int(c.scheduledCnt.Load()),
+			FinishedCnt:  int(c.finishedCnt.Load()),
+			ErrorCnt:     int(c.errorCnt.Load()),
+		}
 	}
+	return progress
 }
 
 // Used for debugging purposes to ensure integrity of the struct.
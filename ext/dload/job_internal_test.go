@@ -0,0 +1,198 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjTimeoutsValidate(t *testing.T) {
+	b := &MultiBody{ObjectsPayload: map[string]any{"small": "https://example.com/small", "huge": "https://example.com/huge"}}
+	b.Bck.Name = "bck"
+	tassert.CheckError(t, b.Validate())
+
+	for _, tmo := range []cos.StrKVs{{"huge": "forever"}, {"huge": "-1h"}, {"huge": "0s"}, {"": "1h"}} {
+		b.ObjTimeouts = tmo
+		tassert.Errorf(t, b.Validate() != nil, "expected %v to fail validation", tmo)
+	}
+	b.ObjTimeouts = cos.StrKVs{"huge": "2h"}
+	tassert.CheckError(t, b.Validate())
+}
+
+func TestObjTimeouts(t *testing.T) {
+	job := &sliceDlJob{
+		baseDlJob: baseDlJob{id: "job1", timeout: time.Minute},
+		objs: []dlObj{
+			{objName: "small-1"}, {objName: "huge-1"}, {objName: "small-2"}, {objName: "dir/huge-2"}, {objName: "tiny"},
+		},
+	}
+	err := job.setTimeouts(cos.StrKVs{"huge-1": "2h", "dir%2Fhuge-2": "30m", "tiny": "5s"})
+	tassert.CheckFatal(t, err)
+
+	expected := map[string]time.Duration{
+		"small-1":    time.Minute, // job default
+		"huge-1":     2 * time.Hour,
+		"small-2":    time.Minute,
+		"dir/huge-2": 30 * time.Minute, // (normalized name)
+		"tiny":       5 * time.Second,
+	}
+	for _, obj := range job.objs {
+		task := &singleTask{job: job, obj: obj}
+		tmo := task.initialTimeout()
+		tassert.Errorf(t, tmo == expected[obj.objName], "%s: expected %v, got %v", obj.objName, expected[obj.objName], tmo)
+	}
+}
+
+func TestObjProvidersValidate(t *testing.T) {
+	b := &MultiBody{ObjectsPayload: map[string]any{"a": "https://example.com/a", "b": "https://example.com/b"}}
+	b.Bck.Name = "bck"
+	for _, provs := range []cos.StrKVs{{"": "aws"}, {"a": ""}, {"a": "nfs"}, {"a": "ht"}} {
+		b.ObjProviders = provs
+		tassert.Errorf(t, b.Validate() != nil, "expected %v to fail validation", provs)
+	}
+	b.ObjProviders = cos.StrKVs{"a": "s3", "b": "aws", "c": "ais"}
+	tassert.CheckError(t, b.Validate())
+
+	bcks := b.ProviderBcks()
+	tassert.Fatalf(t, len(bcks) == 1, "expected a single (aws) bucket, got %v", bcks)
+	tassert.Errorf(t, bcks[0].Name == "bck" && bcks[0].Provider == "aws", "unexpected %s", bcks[0].String())
+
+	b.Extract = true
+	tassert.Errorf(t, b.Validate() != nil, "expected 'extract' to fail validation")
+}
+
+func TestObjMirrorsValidate(t *testing.T) {
+	b := &MultiBody{ObjectsPayload: map[string]any{"a": "https://example.com/a"}}
+	b.Bck.Name = "bck"
+	tooMany := make([]string, maxObjMirrors+1)
+	for i := range tooMany {
+		tooMany[i] = "https://mirror" + strconv.Itoa(i) + ".example.com/a"
+	}
+	for _, mirrors := range []map[string][]string{{"": {"https://m.example.com/a"}}, {"a": {""}}, {"a": {"https://m.example.com/%zz"}}, {"a": tooMany}} {
+		b.ObjMirrors = mirrors
+		tassert.Errorf(t, b.Validate() != nil, "expected %v to fail validation", mirrors)
+	}
+	b.ObjMirrors = map[string][]string{"a": {"https://m1.example.com/a", "m2.example.com/a"}}
+	tassert.CheckError(t, b.Validate())
+
+	// single object
+	for _, obj := range []SingleObj{
+		{Link: "https://example.com/a", Mirrors: tooMany},
+		{Link: "https://example.com/a", Mirrors: []string{""}},
+		{ObjName: "a", FromRemote: true, Mirrors: []string{"https://m.example.com/a"}},
+	} {
+		tassert.Errorf(t, obj.Validate() != nil, "expected %+v to fail validation", obj)
+	}
+	obj := SingleObj{Link: "https://example.com/a", Mirrors: []string{"https://m1.example.com/a"}}
+	tassert.CheckError(t, obj.Validate())
+}
+
+func TestObjMirrors(t *testing.T) {
+	objs := []dlObj{
+		{objName: "a", link: "https://example.com/a"},
+		{objName: "dir/b", link: "https://example.com/dir/b"},
+		{objName: "c", link: "https://example.com/c"},
+		{objName: "d", fromRemote: true},
+	}
+	err := setMirrors(objs, map[string][]string{
+		"a":       {"https://m1.example.com/a", "m2.example.com/a"},
+		"dir%2Fb": {"https://m1.example.com/dir/b"},
+		"d":       {"https://m1.example.com/d"},
+	})
+	tassert.CheckFatal(t, err)
+
+	tassert.Errorf(t, len(objs[0].mirrors) == 2 && objs[0].mirrors[1] == "http://m2.example.com/a",
+		"unexpected mirrors %v (expecting order preserved and protocol prepended)", objs[0].mirrors)
+	tassert.Errorf(t, len(objs[1].mirrors) == 1, "expected mirror for the normalized name, got %v", objs[1].mirrors)
+	tassert.Errorf(t, objs[2].mirrors == nil, "expected no mirrors, got %v", objs[2].mirrors)
+	tassert.Errorf(t, objs[3].mirrors == nil, "expected no mirrors for remote object, got %v", objs[3].mirrors)
+}
+
+func TestAppendValidate(t *testing.T) {
+	cos.InitShortID(0)
+	b := &MultiBody{Seal: true}
+	b.Bck.Name = "bck"
+	tassert.Errorf(t, b.Validate() != nil, "expected seal without job ID to fail validation")
+	b.JobID = "a b"
+	tassert.Errorf(t, b.Validate() != nil, "expected invalid job ID to fail validation")
+	b.JobID = PrefixJobID + cos.GenUUID()
+	tassert.CheckError(t, b.Validate()) // sealing page (no objects)
+	objs, err := b.ExtractPayload()
+	tassert.Errorf(t, err == nil && len(objs) == 0, "expected no objects, got %v (%v)", objs, err)
+}
+
+func TestAppendJob(t *testing.T) {
+	const (
+		pages   = 8
+		perPage = 5
+	)
+	var (
+		d  = &dispatcher{abortJob: map[string]*cos.StopCh{"job": cos.NewStopCh()}, stopCh: cos.NewStopCh()}
+		mj = &multiDlJob{app: &appender{more: make(chan struct{}, 1)}}
+		wg sync.WaitGroup
+	)
+	mj.id, mj.xdl = "job", &Xact{dispatcher: d}
+	mj.objs = []dlObj{{objName: "o0"}, {objName: "o1"}}
+
+	// concurrently: consume (waiting for more) and append pages
+	got := make(chan int)
+	go func() {
+		var n int
+		for {
+			objs, ok, _ := mj.genNext()
+			if !ok {
+				break
+			}
+			n += len(objs)
+		}
+		got <- n
+	}()
+	for p := range pages {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			objs := make([]dlObj, perPage)
+			for i := range objs {
+				objs[i].objName = "p" + strconv.Itoa(p) + "-" + strconv.Itoa(i)
+			}
+			_, err := mj.append(objs, false)
+			tassert.CheckError(t, err)
+		}()
+	}
+	wg.Wait()
+	total, err := mj.append(nil, true /*seal*/)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, total == 2+pages*perPage && mj.Len() == total, "expected total %d, got %d", 2+pages*perPage, total)
+
+	select {
+	case n := <-got:
+		tassert.Errorf(t, n == total, "expected %d objects generated, got %d", total, n)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the sealed job")
+	}
+	_, err = mj.append([]dlObj{{objName: "late"}}, false)
+	tassert.Errorf(t, err != nil, "expected append to a sealed job to fail")
+
+	// aborted while waiting
+	mj = &multiDlJob{app: &appender{more: make(chan struct{}, 1)}}
+	mj.id, mj.xdl = "job", &Xact{dispatcher: d}
+	go func() {
+		_, ok, _ := mj.genNext()
+		tassert.Errorf(t, !ok, "expected nothing to generate")
+		got <- 0
+	}()
+	d.abortJob["job"].Close()
+	select {
+	case <-got:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the aborted job")
+	}
+}
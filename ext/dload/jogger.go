@@ -5,6 +5,8 @@
 package dload
 
 import (
+	"container/heap"
+	"sort"
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -13,46 +15,93 @@ import (
 	"github.com/NVIDIA/aistore/core"
 )
 
-const queueChSize = 1000
+const queueSize = 1000 // max pending (in-memory) tasks, per jogger
 
 type (
 	queueEntry = map[string]struct{}
 
+	// Pending tasks are served in the order of priority (see `Base.Priority`) and,
+	// within the same priority, in the order of arrival.
 	queue struct {
-		ch chan *singleTask      // for pending downloads
-		m  map[string]queueEntry // jobID -> set of request uid
-		mu sync.RWMutex
+		pq     taskHeap              // pending downloads
+		slots  chan struct{}         // one per in-memory pending task: putting a task waits for a slot (see `dispatcher.doSingle`)
+		cond   *sync.Cond            // (on `mu`) signals waiting `get` upon put and close
+		m      map[string]queueEntry // jobID -> set of request uid (including spilled)
+		sp     *spill                // optional (see `DownloaderConf.SpillQueue`)
+		seq    int64                 // arrival order
+		closed bool                  // no more puts (see `close`)
+		mu     sync.RWMutex
 	}
+	pendingTask struct {
+		t    *singleTask
+		seq  int64
+		prio int
+		slot bool // holds a slot (reloaded spilled tasks do not)
+	}
+	taskHeap []*pendingTask
 
 	// Each jogger corresponds to an mpath. All types of download requests
 	// corresponding to the jogger's mpath are forwarded to the jogger. Joggers
 	// exist in the Downloader's jogger member variable, and run only when there
 	// are dlTasks.
+	// A jogger runs up to `conc` tasks at a time (see `DownloaderConf.JoggerConc`),
+	// each in its own worker goroutine.
 	jogger struct {
 		mpath       string
 		terminateCh cos.StopCh // synchronizes termination
+		stopCh      cos.StopCh // unblocks (paused) jogger upon stop
 		parent      *dispatcher
 		q           *queue
-		task        *singleTask // currently running download task
+		running     map[string]*singleTask // (job ID, uid) => currently running (or following) download task
+		stopCause   string                 // (see `TaskErrInfo.Cause`)
+		conc        int                    // number of workers
 		mtx         sync.Mutex
 		stopAgent   bool
 	}
 )
 
 func newJogger(d *dispatcher, mpath string) (j *jogger) {
-	j = &jogger{mpath: mpath, parent: d, q: newQueue()}
+	conc := d.config.Downloader.JoggerConcurrency()
+	j = &jogger{mpath: mpath, parent: d, q: newQueue(), running: make(map[string]*singleTask, conc), conc: conc}
+	if d.config.Downloader.SpillQueue {
+		j.q.sp = newSpill(g.store.downloaderDB, d.xdl, mpath)
+	}
 	j.terminateCh.Init()
+	j.stopCh.Init()
 	return
 }
 
 func (j *jogger) jog() {
+	var wg sync.WaitGroup
+	wg.Add(j.conc)
+	for range j.conc {
+		go func() {
+			j.work()
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	j.q.cleanup()
+	j.terminateCh.Close()
+}
+
+func (j *jogger) work() {
 	for {
+		// paused: do not start new tasks (see `DownloaderConf.PauseOnRebalance`)
+		if resume := g.reb.wait(); resume != nil {
+			select {
+			case <-resume:
+			case <-j.stopCh.Listen():
+			}
+		}
 		t := j.q.get()
 		if t == nil {
 			break
 		}
 
 		j.mtx.Lock()
+
 		// Check if the task exists to ensure that the job wasn't removed while
 		// we waited on the queue. We must do it under the jogger's lock to ensure that
 		// there is no race between aborting job and marking it as being handled.
@@ -67,78 +116,148 @@ func (j *jogger) jog() {
 			// `break` here because we want to drain the queue, otherwise some
 			// of the tasks may be in the queue and therefore the finished
 			// counter won't be correct.
+			// (unless the mountpath is being disabled - in which case, re-dispatch)
 			t.job.throttler().release()
-			t.markFailed(internalErrorMsg)
+			if j.stopCause == CauseMpathDisabled {
+				j.mtx.Unlock()
+				if j.q.del(t) {
+					j.parent.xdl.DecPending()
+				}
+				j.parent.requeue(t)
+				continue
+			}
+			t.markFailed(internalErrorMsg, j.stopCause)
 			j.mtx.Unlock()
 			continue
 		}
 
-		j.task = t
-		j.task.init()
-		j.mtx.Unlock()
+		if g.store.objCancelled(t.jobID(), t.obj.objName) {
+			// cancelled individually while pending (see `AdminBody.ObjName`)
+			t.job.throttler().release()
+			t.markFailed(cancelErrorMsg, CauseObjCancel)
+			j.mtx.Unlock()
+			if j.q.del(t) {
+				j.parent.xdl.DecPending()
+			}
+			continue
+		}
 
-		// do
-		lom := core.AllocLOM(t.obj.objName)
-		t.download(lom)
+		if t.job.expired() {
+			// fail (rather than run) pending tasks of the job that has exceeded its deadline
+			t.job.throttler().release()
+			t.markFailed(deadlineErrorMsg, CauseJobTimeout)
+			j.mtx.Unlock()
+			if j.q.del(t) {
+				j.parent.xdl.DecPending()
+			}
+			continue
+		}
 
-		// finish, cleanup
-		core.FreeLOM(lom)
+		t.init()
+		// The same object may be requested by more than one job (or, upon mountpath changes,
+		// dispatched to more than one jogger): never download it twice at the same time -
+		// instead, share the download in flight, if any (see flight.go)
+		fl, leader := j.parent.flights.join(t)
+		j.running[t.key()] = t
+		j.mtx.Unlock()
+		t.setState(ObjRunning, "")
+
+		if leader {
+			// do (when adaptive, wait for the load to allow - see `DownloaderConf.AdaptiveMax`)
+			adapted := j.parent.adapt.acquire(t.downloadCtx)
+			lom := core.AllocLOM(t.obj.objName)
+			t.download(lom)
+
+			// finish, cleanup
+			core.FreeLOM(lom)
+			if adapted {
+				j.parent.adapt.release()
+			}
+			j.parent.flights.finish(fl)
+		} else {
+			t.follow(fl)
+		}
 		t.cancel()
 
 		t.job.throttler().release()
 
+		requeue := t.requeued
 		j.mtx.Lock()
-		j.task.persist()
-		j.task = nil
+		if !requeue {
+			t.persist()
+		}
+		delete(j.running, t.key())
 		j.mtx.Unlock()
 		if j.q.del(t) {
 			j.parent.xdl.DecPending()
 		}
+		if requeue {
+			j.parent.requeue(t)
+		}
 	}
-
-	j.q.cleanup()
-	j.terminateCh.Close()
 }
 
 // stop terminates the jogger and waits for it to finish.
-func (j *jogger) stop() {
-	nlog.Infof("Stopping jogger for mpath: %s", j.mpath)
+func (j *jogger) stop(cause string) {
+	nlog.Infof("Stopping jogger for mpath: %s (%s)", j.mpath, cause)
 
 	j.mtx.Lock()
 	j.stopAgent = true
-	if j.task != nil {
-		j.task.cancel() // Stops running task (cancels download).
+	j.stopCause = cause
+	for _, task := range j.running {
+		task.abort(cause) // Stops running task (cancels download).
 	}
 	j.mtx.Unlock()
+	j.stopCh.Close()
 	j.q.close()
 
 	<-j.terminateCh.Listen()
 }
 
-// Returns channel which task should be put into.
-func (j *jogger) putCh(t *singleTask) chan<- *singleTask {
+// Returns channel to acquire a slot in the queue and, once acquired, whether to
+// put the task (see `queue.put`).
+func (j *jogger) putCh(t *singleTask) (ok bool, ch chan<- struct{}) {
 	j.q.mu.Lock()
-	ok, ch := j.q.putCh(t)
+	ok, ch = j.q.putCh(t)
 	j.q.mu.Unlock()
 	if ok {
 		j.parent.xdl.IncPending()
 	}
-	return ch
+	return ok, ch
 }
 
-func (j *jogger) getTask(jobID string) (task *singleTask) {
+// Puts the task that has acquired its slot; returns false if the queue's been
+// closed meanwhile, in which case the task is not counted as pending anymore.
+func (j *jogger) put(t *singleTask) bool {
+	if j.q.put(t) {
+		return true
+	}
+	j.parent.xdl.DecPending() // (see `putCh`)
+	return false
+}
+
+func (j *jogger) stopped() bool {
+	j.q.mu.RLock()
+	defer j.q.mu.RUnlock()
+	return j.q.stopped()
+}
+
+// returns the job's currently running tasks, if any
+func (j *jogger) getTasks(jobID string) (tasks []*singleTask) {
 	j.mtx.Lock()
-	if j.task != nil && j.task.jobID() == jobID {
-		task = j.task
+	for _, task := range j.running {
+		if task.jobID() == jobID {
+			tasks = append(tasks, task)
+		}
 	}
 	j.mtx.Unlock()
-	return task
+	return tasks
 }
 
 func (j *jogger) abortJob(id string) {
 	var (
-		task *singleTask
-		cnt  int
+		cnt     int
+		aborted int
 	)
 	j.mtx.Lock()
 
@@ -148,17 +267,41 @@ func (j *jogger) abortJob(id string) {
 	if cnt > 0 {
 		j.parent.xdl.SubPending(cnt)
 	}
-	if j.task != nil && j.task.jobID() == id {
-		task = j.task
+	for _, task := range j.running {
 		// iff the task belongs to the specified job
-		j.task.cancel()
+		if task.jobID() == id {
+			task.abort(CauseClientCancel)
+			aborted++
+		}
+	}
+
+	j.mtx.Unlock()
+
+	if aborted > 0 && cmn.Rom.FastV(4, cos.SmoduleDload) /*verbose*/ {
+		nlog.Infof("%s: abort-job[%s, mpath=%s], running tasks: %d", core.T.String(), id, j.mpath, aborted)
 	}
+}
 
+// cancel currently running tasks that belong to the specified job
+func (j *jogger) cancelTask(id, cause string) {
+	j.mtx.Lock()
+	for _, task := range j.running {
+		if task.jobID() == id {
+			task.abort(cause)
+		}
+	}
 	j.mtx.Unlock()
+}
 
-	if task != nil && cmn.Rom.FastV(4, cos.SmoduleDload) /*verbose*/ {
-		nlog.Infof("%s: abort-job[%s, mpath=%s], task=%s", core.T.String(), id, j.mpath, j.task.String())
+// cancel the job's object if running (if pending, it'll be failed upon dequeue - see `work`)
+func (j *jogger) cancelObj(id, objName string) {
+	j.mtx.Lock()
+	for _, task := range j.running {
+		if task.jobID() == id && task.obj.objName == objName {
+			task.abort(CauseObjCancel)
+		}
 	}
+	j.mtx.Unlock()
 }
 
 func (j *jogger) taskExists(t *singleTask) (exists bool) {
@@ -171,39 +314,92 @@ func (j *jogger) taskExists(t *singleTask) (exists bool) {
 // Returns true if there is any pending task for a given job (either running or in queue),
 // false otherwise.
 func (j *jogger) pending(id string) bool {
-	task := j.getTask(id)
-	return task != nil || j.q.pending(id)
+	return len(j.getTasks(id)) > 0 || j.q.pending(id)
 }
 
 func newQueue() *queue {
-	return &queue{
-		ch: make(chan *singleTask, queueChSize),
-		m:  make(map[string]queueEntry),
+	q := &queue{
+		slots: make(chan struct{}, queueSize),
+		m:     make(map[string]queueEntry),
 	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
 }
 
 // PRECONDITION: `q.Lock()` must be taken.
-func (q *queue) putCh(t *singleTask) (ok bool, ch chan<- *singleTask) {
+func (q *queue) putCh(t *singleTask) (ok bool, ch chan<- struct{}) {
 	if q.stopped() || q.exists(t.jobID(), t.uid()) {
 		// If task already exists or the queue was stopped we should just omit it
-		// hence return channel which immediately accepts and omits the task.
-		return false, make(chan *singleTask, 1)
+		// hence return channel which immediately accepts (and the task gets omitted).
+		return false, make(chan struct{}, 1)
+	}
+	if q.sp != nil && len(q.slots) == cap(q.slots) {
+		q.spill()
 	}
 	q.putToSet(t.jobID(), t.uid())
-	return true, q.ch
+	return true, q.slots
 }
 
-// get retrieves first task in the queue.
+// put adds the task that has acquired its slot (see `putCh`); returns false if the queue's
+// been closed meanwhile - the task is then removed from the set, for the caller to handle.
+func (q *queue) put(t *singleTask) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		<-q.slots
+		q.removeFromSet(t.jobID(), t.uid())
+		return false
+	}
+	q.seq++
+	heap.Push(&q.pq, &pendingTask{t: t, seq: q.seq, prio: t.job.priority(), slot: true})
+	q.cond.Signal()
+	return true
+}
+
+// get retrieves the first task in the queue: the highest priority, the oldest one;
+// blocks while the queue is empty, and returns nil once it's closed and drained.
 func (q *queue) get() (foundTask *singleTask) {
-	t, ok := <-q.ch
-	if !ok {
-		return nil
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if q.sp != nil {
+			q.sp.reload(q)
+		}
+		if q.pq.Len() > 0 {
+			pt := heap.Pop(&q.pq).(*pendingTask)
+			if pt.slot {
+				<-q.slots
+			}
+			// NOTE: We do not delete task here but postpone it until the task
+			//  has `Finished` to prevent situation where we put task which is
+			//  being downloaded.
+			return pt.t
+		}
+		if q.closed {
+			return nil
+		}
+		q.cond.Wait()
 	}
+}
 
-	// NOTE: We do not delete task here but postpone it until the task
-	//  has `Finished` to prevent situation where we put task which is
-	//  being downloaded.
-	return t
+// PRECONDITION: `q.Lock()` must be taken.
+// spill the least urgent tasks out of the (full) queue
+func (q *queue) spill() {
+	sort.Slice(q.pq, func(i, j int) bool { return q.pq[i].before(q.pq[j]) }) // (remains a heap)
+	var (
+		n   = len(q.pq) - min(spillBatch, len(q.pq))
+		pts = q.pq[n:]
+	)
+	if len(pts) == 0 || !q.sp.spill(pts) {
+		return
+	}
+	for i, pt := range pts {
+		if pt.slot {
+			<-q.slots
+		}
+		pts[i] = nil
+	}
+	q.pq = q.pq[:n]
 }
 
 func (q *queue) del(t *singleTask) bool {
@@ -215,14 +411,17 @@ func (q *queue) del(t *singleTask) bool {
 
 func (q *queue) cleanup() {
 	q.mu.Lock()
-	q.ch = nil
+	if q.sp != nil {
+		q.sp.cleanup()
+	}
+	q.pq = nil
 	q.m = nil
 	q.mu.Unlock()
 }
 
 // PRECONDITION: `q.RLock()` must be taken.
 func (q *queue) stopped() bool {
-	return q.m == nil || q.ch == nil
+	return q.m == nil || q.closed
 }
 
 // PRECONDITION: `q.RLock()` must be taken.
@@ -281,8 +480,40 @@ func (q *queue) removeJob(id string) int {
 	return len(jobM)
 }
 
+// no more puts; wakes up waiting `get`, to drain what's left
 func (q *queue) close() {
-	if q.ch != nil {
-		close(q.ch)
+	q.mu.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+}
+
+//////////////
+// taskHeap //
+//////////////
+
+// interface guard
+var _ heap.Interface = (*taskHeap)(nil)
+
+func (h taskHeap) Len() int           { return len(h) }
+func (h taskHeap) Less(i, j int) bool { return h[i].before(h[j]) }
+func (h taskHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *taskHeap) Push(x any) { *h = append(*h, x.(*pendingTask)) }
+
+func (h *taskHeap) Pop() any {
+	old := *h
+	n := len(old) - 1
+	pt := old[n]
+	old[n] = nil
+	*h = old[:n]
+	return pt
+}
+
+// whether to be served before the other
+func (pt *pendingTask) before(other *pendingTask) bool {
+	if pt.prio != other.prio {
+		return pt.prio > other.prio
 	}
+	return pt.seq < other.seq
 }
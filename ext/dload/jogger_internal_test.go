@@ -0,0 +1,120 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestQueuePriority(t *testing.T) {
+	var (
+		q    = newQueue()
+		bck  = meta.NewBck("bck", apc.AIS, cmn.NsGlobal)
+		jobs = []*sliceDlJob{
+			{baseDlJob: baseDlJob{id: "low", bck: bck, prio: -1}},
+			{baseDlJob: baseDlJob{id: "default", bck: bck}},
+			{baseDlJob: baseDlJob{id: "urgent", bck: bck, prio: MaxPriority}},
+		}
+		num = 10
+		// (zero timeout: without blocking)
+		put = func(task *singleTask, timeout time.Duration) bool {
+			q.mu.Lock()
+			ok, ch := q.putCh(task)
+			q.mu.Unlock()
+			select {
+			case ch <- struct{}{}:
+			default:
+				if timeout == 0 {
+					return false
+				}
+				select {
+				case ch <- struct{}{}:
+				case <-time.After(timeout):
+					return false
+				}
+			}
+			if ok {
+				q.put(task)
+			}
+			return true
+		}
+	)
+	for i := range num {
+		for _, job := range jobs {
+			task := &singleTask{job: job, obj: dlObj{objName: fmt.Sprintf("obj-%02d", i), link: "http://example.com"}}
+			tassert.Fatalf(t, put(task, 0), "failed to put %s", task.obj.objName)
+		}
+	}
+	// dedup
+	dup := &singleTask{job: jobs[0], obj: dlObj{objName: "obj-00", link: "http://example.com"}}
+	tassert.Fatalf(t, put(dup, 0), "expected duplicate to be accepted (and omitted)")
+	tassert.Errorf(t, q.pq.Len() == num*len(jobs), "expected %d pending, got %d", num*len(jobs), q.pq.Len())
+
+	// higher priority first, FIFO within priority
+	for k := len(jobs) - 1; k >= 0; k-- {
+		for i := range num {
+			task := q.get()
+			expected := fmt.Sprintf("obj-%02d", i)
+			tassert.Fatalf(t, task.job == jobs[k] && task.obj.objName == expected, "expected %s/%s, got %s/%s",
+				jobs[k].ID(), expected, task.jobID(), task.obj.objName)
+			tassert.Fatalf(t, q.del(task), "failed to delete %s", task.obj.objName)
+		}
+	}
+
+	// full queue: put waits for a slot (and gives up upon timeout)
+	for i := range queueSize {
+		task := &singleTask{job: jobs[1], obj: dlObj{objName: fmt.Sprintf("full-%04d", i), link: "http://example.com"}}
+		tassert.Fatalf(t, put(task, 0), "failed to put %s", task.obj.objName)
+	}
+	urgent := &singleTask{job: jobs[2], obj: dlObj{objName: "urgent", link: "http://example.com"}}
+	tassert.Fatalf(t, !put(urgent, 10*time.Millisecond), "expected full queue to block")
+	q.del(urgent) // (when giving up, the task gets removed - see `jogger.abortJob`)
+	task := q.get()
+	q.del(task)
+	tassert.Fatalf(t, put(urgent, time.Second), "expected freed slot")
+	task = q.get()
+	tassert.Errorf(t, task == urgent, "expected urgent task to jump the queue, got %s", task.obj.objName)
+	q.del(task)
+
+	for range queueSize - 1 {
+		q.del(q.get())
+	}
+
+	// get blocks while empty; upon close, drains what's left and returns nil
+	got := make(chan *singleTask, 1)
+	go func() { got <- q.get() }()
+	select {
+	case task := <-got:
+		t.Fatalf("unexpected task %v", task)
+	case <-time.After(10 * time.Millisecond):
+	}
+	tassert.Fatalf(t, put(urgent, 0), "failed to put")
+	tassert.Errorf(t, <-got == urgent, "expected waiting get to receive the task")
+
+	tassert.Fatalf(t, put(dup, 0), "failed to put")
+
+	// closed while waiting for a slot: the task is not put (and not in the set)
+	late := &singleTask{job: jobs[1], obj: dlObj{objName: "late", link: "http://example.com"}}
+	q.mu.Lock()
+	ok, ch := q.putCh(late)
+	q.mu.Unlock()
+	tassert.Fatalf(t, ok, "failed to put %s", late.obj.objName)
+	ch <- struct{}{}
+	q.close()
+	tassert.Errorf(t, !q.put(late), "expected put to fail upon close")
+	tassert.Errorf(t, !q.exists(late.jobID(), late.uid()) && len(q.slots) == 1, "expected %s to be removed (and its slot freed)",
+		late.obj.objName)
+
+	tassert.Errorf(t, q.get() == dup, "expected queue to be drained upon close")
+	tassert.Errorf(t, q.get() == nil, "expected nil upon close")
+	q.cleanup()
+}
@@ -0,0 +1,146 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+const (
+	maxManifestSize    = 256 * cos.MiB
+	maxManifestLineLen = 64 * cos.KiB
+)
+
+// checksum manifest (see `Base.CksumManifest`): fetched and parsed once, when the job starts -
+// prior to dispatching any of its objects (see `dispatcher.loadManifest`) - and then cached
+// for the lifetime of the job
+type cksumManifest struct {
+	conf   *CksumManifest
+	cksums cos.StrKVs // filename => checksum value (read-only once loaded)
+}
+
+func newCksumManifest(conf *CksumManifest) *cksumManifest {
+	return &cksumManifest{conf: conf}
+}
+
+// returns expected checksum or nil (when not strict and the object is not listed)
+func (m *cksumManifest) lookup(objName, link string) (*cos.Cksum, error) {
+	if m.cksums == nil {
+		return nil, fmt.Errorf("checksum manifest %q is not loaded", m.conf.URL)
+	}
+	candidates := []string{objName, path.Base(objName)}
+	if u, err := url.Parse(link); err == nil && u.Path != "" {
+		candidates = append(candidates, path.Base(u.Path))
+	}
+	for _, name := range candidates {
+		if value, ok := m.cksums[name]; ok {
+			return cos.NewCksum(m.conf.Type, value), nil
+		}
+	}
+	if m.conf.Strict {
+		return nil, fmt.Errorf("%q is not listed in the checksum manifest %q", objName, m.conf.URL)
+	}
+	return nil, nil
+}
+
+// fetch and parse, retrying transient failures (see `DownloaderConf.MaxRetries`);
+// canceling the context interrupts
+func (m *cksumManifest) load(ctx context.Context, timeout time.Duration) (err error) {
+	var (
+		conf    = &cmn.GCO.Get().Downloader
+		retries = conf.MaxRetryCount()
+		backoff = conf.RetryBackoffDur()
+	)
+	for i := 0; ; i++ {
+		if err = m._load(ctx, timeout); err == nil || i >= retries || !retriableErr(err) || ctx.Err() != nil {
+			return err
+		}
+		nlog.Warningf("checksum manifest %q [retries: %d/%d]: %v - retrying", m.conf.URL, i, retries, err)
+		if errB := waitBackoff(ctx, backoff, i); errB != nil {
+			return err
+		}
+	}
+}
+
+func (m *cksumManifest) _load(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	link := cmn.PrependProtocol(m.conf.URL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return err
+	}
+	if err := signReq(req); err != nil {
+		return err
+	}
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return fmt.Errorf("failed to fetch checksum manifest %q: %w", m.conf.URL, err)
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return cmn.NewErrHTTP(req, fmt.Errorf("failed to fetch checksum manifest %q: status %d", m.conf.URL, resp.StatusCode),
+			resp.StatusCode)
+	}
+	if resp.ContentLength > maxManifestSize {
+		return fmt.Errorf("checksum manifest %q is too large (%s)", m.conf.URL, cos.ToSizeIEC(resp.ContentLength, 0))
+	}
+	if m.cksums, err = parseCksumManifest(io.LimitReader(resp.Body, maxManifestSize)); err != nil {
+		return fmt.Errorf("invalid checksum manifest %q: %w", m.conf.URL, err)
+	}
+	nlog.Infoln("loaded checksum manifest", m.conf.URL, "entries:", len(m.cksums))
+	return nil
+}
+
+// parses `sha256sum` (and alike) output: "<checksum> [*]<filename>", one per line;
+// empty lines and comments ('#') are skipped
+func parseCksumManifest(r io.Reader) (cos.StrKVs, error) {
+	var (
+		cksums  = make(cos.StrKVs, 64)
+		scanner = bufio.NewScanner(r)
+		lno     int
+	)
+	scanner.Buffer(make([]byte, 0, 4*cos.KiB), maxManifestLineLen)
+	for scanner.Scan() {
+		lno++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		i := strings.IndexAny(line, " \t")
+		if i <= 0 {
+			return nil, fmt.Errorf("line %d: expecting \"<checksum> <filename>\", got %q", lno, line)
+		}
+		value := line[:i]
+		name := strings.TrimLeft(line[i:], " \t")
+		name = strings.TrimPrefix(name, "*") // binary mode
+		name = strings.TrimPrefix(name, "./")
+		if name == "" {
+			return nil, fmt.Errorf("line %d: missing filename", lno)
+		}
+		cksums[name] = strings.ToLower(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(cksums) == 0 {
+		return nil, errors.New("no entries")
+	}
+	return cksums, nil
+}
@@ -0,0 +1,129 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object names derived from download links (e.g., `path.Base(link)` or a range template), as
+// opposed to explicitly named objects, are checked at submission time: too long, control
+// characters, invalid UTF-8, and non-normalized paths (leading, duplicate, or trailing slashes,
+// "../", "~/") are either rejected (default) or sanitized, as per `Base.NamePolicy`.
+
+// name policies (see `Base.NamePolicy`)
+const (
+	NamePolicyReject   = "reject"   // fail the submission (default)
+	NamePolicySanitize = "sanitize" // replace control characters with '_', normalize slashes, truncate
+)
+
+const (
+	maxDerivedNameLen = 1024 // bytes
+	maxRenamedReport  = 1000 // max sanitized names reported back (see `DlPostResp.Renamed`)
+)
+
+type namer struct {
+	rule     *NameRule
+	renamed  cos.StrKVs // when non-nil: record sanitized names (derived => final)
+	sanitize bool
+}
+
+func ValidateNamePolicy(s string) error {
+	switch s {
+	case "", NamePolicyReject, NamePolicySanitize:
+		return nil
+	default:
+		return fmt.Errorf("invalid 'name_policy' %q (expecting %q or %q)", s, NamePolicyReject, NamePolicySanitize)
+	}
+}
+
+// given the link's base name (and optional virtual directory), returns the final object name
+func (n *namer) derive(base, dir string) (string, error) {
+	name, err := n.rule.Apply(base)
+	if err != nil {
+		return "", err
+	}
+	if dir != "" {
+		name = path.Join(dir, name)
+	}
+	return n.fix(name)
+}
+
+// NOTE: checking the name as it'll be stored - URL-unescaped (see `NormalizeObjName`)
+func (n *namer) fix(name string) (string, error) {
+	norm, err := NormalizeObjName(name)
+	if err == nil {
+		if err = checkDerivedName(norm); err == nil {
+			return name, nil
+		}
+	}
+	if !n.sanitize {
+		return "", err
+	}
+	if norm == "" {
+		norm = name // (unparsable, e.g. raw control characters)
+	}
+	sanitized := sanitizeName(norm)
+	if err := checkDerivedName(sanitized); err != nil {
+		return "", fmt.Errorf("failed to sanitize %q: %v", name, err)
+	}
+	if n.renamed != nil && len(n.renamed) < maxRenamedReport {
+		n.renamed[name] = sanitized
+	}
+	return sanitized, nil
+}
+
+func checkDerivedName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("empty (or unparsable) object name")
+	case len(name) > maxDerivedNameLen:
+		return fmt.Errorf("object name %.64q... is too long (%d, max %d)", name, len(name), maxDerivedNameLen)
+	case !utf8.ValidString(name):
+		return fmt.Errorf("object name %q is not valid UTF-8", name)
+	case strings.IndexFunc(name, unicode.IsControl) >= 0:
+		return fmt.Errorf("object name %q contains control characters", name)
+	case name[0] == '/' || strings.Contains(name, "//"):
+		return fmt.Errorf("object name %q contains leading or duplicate slashes", name)
+	}
+	if err := cmn.ValidateOname(name); err != nil {
+		return err
+	}
+	return nil
+}
+
+func sanitizeName(name string) string {
+	name = strings.ToValidUTF8(name, "_")
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '%' || r == '?' || r == '#' {
+			return '_' // (the latter - to stay unchanged when normalized again)
+		}
+		return r
+	}, name)
+	// normalize slashes (and resolve "..", if any)
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	name = strings.ReplaceAll(name, "~/", "_/")
+	if len(name) <= maxDerivedNameLen {
+		return name
+	}
+	// truncate, keeping the distinction between (long) names with the same prefix
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+	name = name[:maxDerivedNameLen-len(suffix)]
+	for !utf8.ValidString(name) {
+		name = name[:len(name)-1] // (not to cut a multi-byte rune)
+	}
+	return name + suffix
+}
@@ -0,0 +1,85 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestDerivedNames(t *testing.T) {
+	long := strings.Repeat("x", maxDerivedNameLen+100)
+	tests := []struct {
+		base, dir string
+		sanitized string // "" - to skip checking the exact result
+		valid     bool
+	}{
+		{base: "file.tar", valid: true},
+		{base: "file.tar", dir: "a/b", valid: true},
+		{base: "caf%C3%A9.txt", valid: true},
+		{base: "a%0Ab", sanitized: "a_b"},
+		{base: "a\x01b", sanitized: "a_b"},
+		{base: "bad%FF%FE", sanitized: "bad_"},
+		{base: "%2E%2E%2Fetc%2Fpasswd", sanitized: "etc/passwd"},
+		{base: "%2F%2Fx%2F%2Fy", sanitized: "x/y"},
+		{base: "home%2F~%2Fx", sanitized: "home/_/x"},
+		{base: long},
+		{base: "abc", dir: long},
+	}
+	for _, test := range tests {
+		reject := &namer{}
+		name, err := reject.derive(test.base, test.dir)
+		if test.valid {
+			tassert.CheckError(t, err)
+			continue
+		}
+		tassert.Errorf(t, err != nil, "expected %q to be rejected, got %q", test.base, name)
+
+		sanitize := &namer{sanitize: true, renamed: make(cos.StrKVs)}
+		name, err = sanitize.derive(test.base, test.dir)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, len(name) <= maxDerivedNameLen && utf8.ValidString(name), "invalid sanitized %.64q", name)
+		if test.sanitized != "" {
+			tassert.Errorf(t, name == test.sanitized, "%q: expected %q, got %q", test.base, test.sanitized, name)
+		}
+		tassert.Errorf(t, len(sanitize.renamed) == 1, "expected %q to be reported as renamed", test.base)
+
+		// must be stable (i.e., stored as is)
+		norm, err := NormalizeObjName(name)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, norm == name, "%q: not normalized: %q vs %q", test.base, name, norm)
+		tassert.CheckError(t, checkDerivedName(name))
+	}
+}
+
+func TestNamePolicy(t *testing.T) {
+	long := strings.Repeat("y", maxDerivedNameLen)
+	b := &MultiBody{ObjectsPayload: []any{
+		"https://example.com/ok.bin",
+		"https://example.com/" + long + "1",
+		"https://example.com/" + long + "2",
+		"https://example.com/a%0Ab",
+	}}
+	b.Bck.Name = "bck"
+	tassert.CheckError(t, b.Validate())
+	_, err := b.ExtractPayload()
+	tassert.Errorf(t, err != nil, "expected derived names to be rejected")
+
+	b.NamePolicy = "fix"
+	tassert.Errorf(t, b.Validate() != nil, "expected invalid 'name_policy' to fail validation")
+
+	b.NamePolicy = NamePolicySanitize
+	b.renamed = make(cos.StrKVs)
+	tassert.CheckError(t, b.Validate())
+	objs, err := b.ExtractPayload()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(objs) == 4, "expected 4 objects (long names must not collide), got %d", len(objs))
+	tassert.Errorf(t, len(b.renamed) == 3, "expected 3 renamed, got %v", b.renamed)
+	tassert.Errorf(t, objs["ok.bin"] != "" && objs["a_b"] != "", "unexpected %v", objs)
+}
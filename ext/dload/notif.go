@@ -7,6 +7,8 @@ package dload
 import (
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -22,6 +24,8 @@ import (
 type (
 	NotifDownloadListerner struct {
 		nl.ListenerBase
+		// objects that failed to dispatch (see `PartialAccept`)
+		DispatchErrs []TaskErrInfo `json:"dispatch_errors,omitempty"`
 	}
 	NotifDownload struct {
 		nl.Base
@@ -35,12 +39,31 @@ var (
 	_ core.Notif  = (*NotifDownload)(nil)
 )
 
-func NewDownloadNL(jobID, kind string, smap *meta.Smap, progressInterval time.Duration) *NotifDownloadListerner {
+// tmap: the targets that have started the job
+func NewDownloadNL(jobID, kind string, tmap meta.NodeMap, progressInterval time.Duration) *NotifDownloadListerner {
 	return &NotifDownloadListerner{
-		ListenerBase: *nl.NewNLB(jobID, kind, "" /*causal action*/, smap.Tmap.ActiveMap(), progressInterval),
+		ListenerBase: *nl.NewNLB(jobID, kind, "" /*causal action*/, tmap, progressInterval),
 	}
 }
 
+// add objects that failed to dispatch to the (aggregated) job status
+func (nd *NotifDownloadListerner) AddDispatchErrs(resp *StatusResp) *StatusResp {
+	n := len(nd.DispatchErrs)
+	if n == 0 {
+		return resp
+	}
+	if resp == nil {
+		resp = &StatusResp{}
+	}
+	resp.Errs = append(resp.Errs, nd.DispatchErrs...)
+	resp.ErrorCnt += n
+	resp.ScheduledCnt += n
+	if resp.Total > 0 {
+		resp.Total += n
+	}
+	return resp
+}
+
 func (*NotifDownloadListerner) UnmarshalStats(rawMsg []byte) (stats any, finished, aborted bool, err error) {
 	dlStatus := &StatusResp{}
 	if err = jsoniter.Unmarshal(rawMsg, dlStatus); err != nil {
@@ -52,6 +75,42 @@ func (*NotifDownloadListerner) UnmarshalStats(rawMsg []byte) (stats any, finishe
 	return
 }
 
+// e.g. "download: 900/1000 objects, 12 skipped, 3 errors"
+func (nd *NotifDownloadListerner) Describe() string {
+	var resp *StatusResp
+	nd.Stats.Range(func(_ string, stats any) bool {
+		st, ok := stats.(*StatusResp)
+		if !ok {
+			st = &StatusResp{}
+			if err := cos.MorphMarshal(stats, st); err != nil {
+				return true
+			}
+		}
+		resp = resp.Aggregate(st)
+		return true
+	})
+	resp = nd.AddDispatchErrs(resp)
+	if resp == nil {
+		return nd.Kind() + ": " + nl.FinDesc(&nd.ListenerBase)
+	}
+	var sb strings.Builder
+	sb.WriteString(nd.Kind())
+	sb.WriteString(": ")
+	sb.WriteString(strconv.Itoa(resp.DownloadedCnt()))
+	if total := resp.TotalCnt(); total > 0 {
+		sb.WriteByte('/')
+		sb.WriteString(strconv.Itoa(total))
+	}
+	sb.WriteString(" objects")
+	if resp.SkippedCnt > 0 {
+		sb.WriteString(", " + strconv.Itoa(resp.SkippedCnt) + " skipped")
+	}
+	if resp.ErrorCnt > 0 {
+		sb.WriteString(", " + strconv.Itoa(resp.ErrorCnt) + " error" + cos.Plural(resp.ErrorCnt))
+	}
+	return sb.String()
+}
+
 func (nd *NotifDownloadListerner) QueryArgs() cmn.HreqArgs {
 	var (
 		xid    = "nqui-" + cos.GenUUID()
@@ -0,0 +1,116 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Content post-processing (see `Base.PostProcess`): the processor wraps the (validated, throttled)
+// response body and transforms the content on the fly, as it is being stored. The size of the
+// output is not known in advance - the object's size and checksum get computed upon PUT.
+// To add a processor, implement the interface and register it in `postProcessors` below.
+
+// read (and transform) in chunks of this size
+const postProcBufSize = 32 * 1024
+
+type (
+	postProcessor interface {
+		wrap(r io.ReadCloser) io.ReadCloser
+	}
+
+	normalizeEOL struct{}
+
+	// PostProcNormalizeEOL
+	eolReader struct {
+		r   io.ReadCloser
+		raw []byte // as read
+		out []byte // as converted
+		buf []byte // converted but not yet returned
+		err error  // read error (including io.EOF) to return once `buf` is drained
+		cr  bool   // trailing '\r' of the previous chunk (pending)
+	}
+)
+
+var postProcessors = map[string]postProcessor{
+	PostProcNormalizeEOL: normalizeEOL{},
+}
+
+func postProcessorNames() []string {
+	return []string{PostProcNormalizeEOL}
+}
+
+func newPostProcessor(name string) postProcessor {
+	if name == "" {
+		return nil
+	}
+	return postProcessors[name]
+}
+
+// checksums provided by the origin describe the original content, not the transformed one
+func dropSourceCksums(lom *core.LOM) {
+	md := lom.GetCustomMD()
+	if md == nil {
+		return
+	}
+	delete(md, cmn.MD5ObjMD)
+	delete(md, cmn.CRC32CObjMD)
+	lom.SetCustomMD(md)
+}
+
+//
+// normalize-eol: CRLF => LF (a lone '\r' remains as is)
+//
+
+func (normalizeEOL) wrap(r io.ReadCloser) io.ReadCloser {
+	return &eolReader{
+		r:   r,
+		raw: make([]byte, postProcBufSize),
+		out: make([]byte, 0, postProcBufSize+1),
+	}
+}
+
+func (er *eolReader) Read(b []byte) (int, error) {
+	for len(er.buf) == 0 {
+		if er.err != nil {
+			return 0, er.err
+		}
+		er.fill()
+	}
+	n := copy(b, er.buf)
+	er.buf = er.buf[n:]
+	return n, nil
+}
+
+func (er *eolReader) fill() {
+	n, err := er.r.Read(er.raw)
+	out := er.out[:0]
+	for _, c := range er.raw[:n] {
+		if er.cr {
+			er.cr = false
+			if c != '\n' {
+				out = append(out, '\r')
+			}
+		}
+		if c == '\r' {
+			er.cr = true
+			continue
+		}
+		out = append(out, c)
+	}
+	if err != nil {
+		if er.cr {
+			er.cr = false
+			out = append(out, '\r')
+		}
+		er.err = err
+	}
+	er.buf = out
+}
+
+func (er *eolReader) Close() error { return er.r.Close() }
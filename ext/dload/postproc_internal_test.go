@@ -0,0 +1,54 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPostProcNormalizeEOL(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{
+		{"", ""},
+		{"no line endings", "no line endings"},
+		{"a\r\nb\r\nc", "a\nb\nc"},
+		{"unix\nlines\n", "unix\nlines\n"},
+		{"lone\rcr\r", "lone\rcr\r"},
+		{"\r\r\n\r\n\r", "\r\n\n\r"},
+		{strings.Repeat("line\r\n", postProcBufSize/3), strings.Repeat("line\n", postProcBufSize/3)},
+	}
+	pp := newPostProcessor(PostProcNormalizeEOL)
+	for _, test := range tests {
+		// whole, and one byte at a time (a CRLF split across reads)
+		for _, r := range []io.Reader{strings.NewReader(test.in), iotest.OneByteReader(strings.NewReader(test.in))} {
+			rc := pp.wrap(io.NopCloser(r))
+			b, err := io.ReadAll(rc)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, string(b) == test.out, "%.32q: expected %.32q, got %.32q", test.in, test.out, string(b))
+			rc.Close()
+		}
+	}
+}
+
+func TestPostProcValidate(t *testing.T) {
+	b := &Base{PostProcess: "no-such-processor"}
+	b.Bck.Name = "bck"
+	tassert.Errorf(t, b.Validate() != nil, "expected invalid post-processor")
+
+	b.PostProcess = PostProcNormalizeEOL
+	tassert.CheckError(t, b.Validate())
+
+	b.Extract = true
+	tassert.Errorf(t, b.Validate() != nil, "expected 'post_process' and 'extract' to conflict")
+	b.Extract = false
+	b.CksumManifest = &CksumManifest{URL: "https://example.com/SHA256SUMS"}
+	tassert.Errorf(t, b.Validate() != nil, "expected 'post_process' and 'cksum_manifest' to conflict")
+}
@@ -0,0 +1,89 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Pre-flight (see `MultiBody.ValidateLinks`): prior to scheduling anything, each target HEADs
+// the links of its share of the objects, a few at a time. A link that fails with 4xx is
+// definitely broken, and so the entire job gets rejected up front. Other failures (connection
+// errors, timeouts, 5xx, 429) may well be transient - those objects are scheduled as usual.
+// The sizes reported by the origins add up to the job's expected size (see `StatusResp.ExpectedBytes`).
+
+const (
+	preflightConc    = 32 // max HEAD requests in flight, per target
+	preflightMaxErrs = 8  // max broken links listed in the error
+)
+
+// returns the total (reported) size of the objects
+func (j *baseDlJob) preflight(objs []dlObj) (size int64, err error) {
+	var (
+		broken []string
+		sema   = make(chan struct{}, preflightConc)
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+	)
+	for i := range objs {
+		obj := &objs[i]
+		if obj.fromRemote {
+			continue
+		}
+		sema <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sema
+				wg.Done()
+			}()
+			n, ecode := j.preflightObj(obj)
+			mu.Lock()
+			size += n
+			// (an object with mirrors may still be downloaded)
+			if ecode != 0 && len(obj.mirrors) == 0 {
+				broken = append(broken, fmt.Sprintf("%s (%d)", redactLink(obj.link), ecode))
+			}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(broken) == 0 {
+		return size, nil
+	}
+	var (
+		l   = len(broken)
+		msg = strings.Join(broken[:min(l, preflightMaxErrs)], ", ")
+	)
+	if l > preflightMaxErrs {
+		msg += ", ..."
+	}
+	return 0, fmt.Errorf("%s: %d link%s failed validation: %s", j, l, cos.Plural(l), msg)
+}
+
+// returns the reported size, if any, and the status of a definitely broken link (zero otherwise)
+func (j *baseDlJob) preflightObj(obj *dlObj) (int64, int) {
+	resp, err := headLink(obj.link, mergeHeaders(j.Headers(), obj.headers), j.auth()) //nolint:bodyclose // cos.Close
+	if err != nil {
+		nlog.Warningln(j.String(), "pre-flight:", redactLink(obj.link), err)
+		return 0, 0
+	}
+	cos.Close(resp.Body)
+	switch code := resp.StatusCode; {
+	case code < http.StatusBadRequest:
+		return max(resp.ContentLength, 0), 0
+	case code == http.StatusMethodNotAllowed, code == http.StatusTooManyRequests, code >= http.StatusInternalServerError:
+		return 0, 0 // (not conclusive)
+	default:
+		return 0, code
+	}
+}
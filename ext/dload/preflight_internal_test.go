@@ -0,0 +1,60 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPreflight(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/a":
+			w.Header().Set("Content-Length", "100")
+		case "/b":
+			w.Header().Set("Content-Length", "20")
+		case "/no-head":
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case "/busy":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/secret":
+			if r.Header.Get("X-Api-Key") != "key" {
+				w.WriteHeader(http.StatusForbidden)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer origin.Close()
+	g.clientH = origin.Client()
+
+	j := &baseDlJob{id: "preflight", bck: meta.NewBck("bck", apc.AIS, cmn.NsGlobal)}
+	objs := []dlObj{
+		{objName: "a", link: origin.URL + "/a"},
+		{objName: "b", link: origin.URL + "/b"},
+		{objName: "c", link: origin.URL + "/no-head"},
+		{objName: "d", link: origin.URL + "/busy"},
+		{objName: "e", link: origin.URL + "/secret", headers: http.Header{"X-Api-Key": []string{"key"}}},
+		{objName: "f", link: origin.URL + "/missing", mirrors: []string{origin.URL + "/a"}},
+		{objName: "g", fromRemote: true},
+	}
+	size, err := j.preflight(objs)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, size == 120, "expected size 120, got %d", size)
+
+	objs = append(objs, dlObj{objName: "h", link: origin.URL + "/missing"}, dlObj{objName: "i", link: origin.URL + "/secret"})
+	_, err = j.preflight(objs)
+	tassert.Fatalf(t, err != nil, "expected pre-flight to fail")
+	tassert.Errorf(t, strings.Contains(err.Error(), "2 links") && strings.Contains(err.Error(), "/missing (404)") &&
+		strings.Contains(err.Error(), "/secret (403)"), "unexpected error: %v", err)
+}
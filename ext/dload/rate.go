@@ -0,0 +1,84 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Per-job download throughput (see `StatusResp.Throughput`): the bytes received (see `progressReader`)
+// within the trailing window (see `DownloaderConf.RateWindow`), counted in `rateSlots` slots that
+// rotate as time goes by.
+
+const rateSlots = 10
+
+type rateMeter struct {
+	slots   [rateSlots]int64 // bytes received, per slot
+	slot    int64            // slot duration (window / rateSlots)
+	last    int64            // mono time: start of the current slot
+	started int64            // mono time (the job may be younger than the window)
+	cur     int              // current slot
+	mu      sync.Mutex
+}
+
+func newRateMeter(window time.Duration) *rateMeter {
+	now := mono.NanoTime()
+	return &rateMeter{slot: max(int64(window)/rateSlots, 1), last: now, started: now}
+}
+
+func (m *rateMeter) add(n int64) {
+	if m == nil {
+		return
+	}
+	m.addAt(n, mono.NanoTime())
+}
+
+// bytes per second
+func (m *rateMeter) rate() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.rateAt(mono.NanoTime())
+}
+
+func (m *rateMeter) addAt(n, now int64) {
+	m.mu.Lock()
+	m.advance(now)
+	m.slots[m.cur] += n
+	m.mu.Unlock()
+}
+
+func (m *rateMeter) rateAt(now int64) int64 {
+	var total int64
+	m.mu.Lock()
+	m.advance(now)
+	for _, n := range m.slots {
+		total += n
+	}
+	// all but the current slot are full (unless the job is younger than that)
+	span := min(now-m.started, (rateSlots-1)*m.slot+now-m.last)
+	m.mu.Unlock()
+	if total == 0 {
+		return 0
+	}
+	span = max(span, m.slot)
+	return int64(float64(total) * float64(time.Second) / float64(span))
+}
+
+// rotate out the slots that fell out of the window
+func (m *rateMeter) advance(now int64) {
+	steps := (now - m.last) / m.slot
+	if steps <= 0 {
+		return
+	}
+	m.last += steps * m.slot
+	for range min(steps, rateSlots) {
+		m.cur = (m.cur + 1) % rateSlots
+		m.slots[m.cur] = 0
+	}
+}
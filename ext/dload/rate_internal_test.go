@@ -0,0 +1,44 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRateMeter(t *testing.T) {
+	var (
+		m   = newRateMeter(10 * time.Second)
+		sec = int64(time.Second)
+		t0  = m.started
+	)
+	tassert.Errorf(t, m.rateAt(t0+sec) == 0, "expected zero rate when idle")
+
+	// young job: averaged over its lifetime so far
+	m.addAt(10*cos.MiB, t0+sec)
+	m.addAt(10*cos.MiB, t0+2*sec)
+	rate := m.rateAt(t0 + 2*sec)
+	tassert.Errorf(t, rate == 10*cos.MiB, "expected 10MiB/s, got %d", rate)
+
+	// steady 1MiB/s for a while: only the trailing window counts
+	for i := int64(3); i <= 30; i++ {
+		m.addAt(cos.MiB, t0+i*sec)
+	}
+	rate = m.rateAt(t0 + 30*sec)
+	tassert.Errorf(t, rate >= cos.MiB && rate <= 2*cos.MiB, "expected about 1MiB/s, got %d", rate)
+
+	// idle for longer than the window
+	rate = m.rateAt(t0 + 60*sec)
+	tassert.Errorf(t, rate == 0, "expected zero rate after idling, got %d", rate)
+
+	// nil meter (e.g., a job that was never initialized)
+	var nm *rateMeter
+	nm.add(1)
+	tassert.Errorf(t, nm.rate() == 0, "expected zero rate")
+}
@@ -0,0 +1,305 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Resumable downloads: large objects (`resumeMinSize` and up) from origins that advertise
+// `Accept-Ranges: bytes` first land in a partial workfile, and only then get PUT (validated,
+// checksummed, post-processed) from the local copy. The number of bytes committed (fsync-ed)
+// to the workfile is periodically persisted in the downloader DB, keyed by the object's uname,
+// so that a retry - or a later job after a target restart - continues with
+// `Range: bytes=<offset>-` (and `If-Range`) rather than from zero.
+// The origin must respond with the same total size (see `Content-Range`); otherwise, or when the
+// origin ignores the range, the download starts anew. (Space cleanup removes old workfiles,
+// partial downloads included.)
+// With `DownloaderConf.ChunkSize`, the workfile gets assembled from concurrently fetched ranges
+// (chunks) instead - see chunk.go.
+
+const (
+	resumeMinSize  = 64 * cos.MiB // smaller objects are streamed directly (no partial workfile)
+	resumeCommitSz = 16 * cos.MiB // fsync and persist the offset every so often
+)
+
+type (
+	// persisted (see `downloaderDB.partial`)
+	partialRec struct {
+		Link      string `json:"link"`
+		Wfqn      string `json:"wfqn"`
+		Validator string `json:"validator,omitempty"` // strong ETag or Last-Modified (sent as If-Range)
+		Size      int64  `json:"size"`                // original Content-Length
+		Offset    int64  `json:"offset"`              // committed
+		ChunkSize int64  `json:"chunk_size,omitempty"`
+		Done      []bool `json:"done,omitempty"` // completed chunks (the committed ones, when chunked)
+	}
+	partial struct {
+		uname string
+		mu    sync.Mutex // (concurrent chunks)
+		partialRec
+	}
+
+	// the transfer got interrupted; the content received so far is committed, and the next attempt
+	// resumes at `offset` (zero: restarts)
+	errInterrupted struct {
+		err          error
+		offset, size int64
+	}
+)
+
+func (e *errInterrupted) Error() string {
+	return fmt.Sprintf("interrupted at %d/%d: %v", e.offset, e.size, e.err)
+}
+
+func (e *errInterrupted) Unwrap() error { return e.err }
+
+func (task *singleTask) canResume() bool {
+	// (with decoding, the stored content is not the one received)
+	if task.obj.fromRemote || g.store == nil || task.job.decompress() {
+		return false
+	}
+	_, extract := task.job.extractTo()
+	return !extract
+}
+
+// prior to requesting a given link: load the object's partial download, if any
+func (task *singleTask) loadPartial(lom *core.LOM, link string) {
+	if p := task.part; p != nil {
+		if p.Link == link {
+			return
+		}
+		task.dropPartial() // (failing over to a mirror)
+	}
+	if !task.canResume() {
+		return
+	}
+	rec := g.store.partial(lom.Uname())
+	if rec == nil {
+		return
+	}
+	p := &partial{uname: lom.Uname(), partialRec: *rec}
+	finfo, err := os.Stat(p.Wfqn)
+	if p.Link != link || err != nil || finfo.Size() < p.Offset || p.Offset > p.Size ||
+		(p.ChunkSize > 0 && len(p.Done) != numChunks(p.Size, p.ChunkSize)) {
+		p.discard()
+		return
+	}
+	task.part = p
+}
+
+// resume from the committed offset
+func (task *singleTask) rangeReq(req *http.Request) {
+	p := task.part
+	if p == nil {
+		return
+	}
+	off := p.resumeAt()
+	if off == 0 {
+		return
+	}
+	req.Header.Set(cos.HdrRange, cos.HdrRangeValPrefix+strconv.FormatInt(off, 10)+"-")
+	if p.Validator != "" {
+		req.Header.Set(cos.HdrIfRange, p.Validator)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleDload) {
+		nlog.Infoln(task, "resuming at", off, "of", p.Size)
+	}
+}
+
+// returns non-nil when the content is to land in the partial workfile first (see above)
+func (task *singleTask) partialFrom(lom *core.LOM, link string, resp *http.Response) (*partial, error) {
+	if resp.StatusCode == http.StatusPartialContent {
+		p := task.part
+		if p == nil {
+			return nil, fmt.Errorf("unexpected %d response from %q", resp.StatusCode, redactLink(link))
+		}
+		start, total, err := parseContentRange(resp.Header.Get(cos.HdrContentRange))
+		if off := p.resumeAt(); err == nil && (start != off || total != p.Size) {
+			err = fmt.Errorf("resumed content mismatch: (start %d, total %d) vs (offset %d, size %d)", start, total, off, p.Size)
+		}
+		if err != nil {
+			task.dropPartial() // (next attempt starts anew)
+			return nil, err
+		}
+		return p, nil
+	}
+
+	// full content: nothing to resume, or the origin ignored the range (e.g., the content has changed)
+	if task.part != nil {
+		task.dropPartial()
+	}
+	if !task.canResume() || resp.ContentLength < resumeMinSize || !strings.EqualFold(resp.Header.Get(cos.HdrAcceptRanges), "bytes") {
+		return nil, nil
+	}
+	p := &partial{uname: lom.Uname()}
+	p.Link, p.Size = link, resp.ContentLength
+	p.Wfqn = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDlPartial)
+	if etag := resp.Header.Get(cos.HdrETag); etag != "" && !strings.HasPrefix(etag, "W/") {
+		p.Validator = etag
+	} else {
+		p.Validator = resp.Header.Get(cos.HdrLastModified)
+	}
+	if csz := int64(cmn.GCO.Get().Downloader.ChunkSize); csz > 0 && p.Size > csz {
+		p.ChunkSize = csz
+		p.Done = make([]bool, numChunks(p.Size, csz))
+	}
+	task.part = p
+	return p, nil
+}
+
+// receive the (remaining) content into the partial workfile, committing as it goes
+func (p *partial) fetch(task *singleTask, body io.ReadCloser) (bool /*err is fatal*/, error) {
+	var (
+		fh  *os.File
+		err error
+	)
+	if p.Offset == 0 {
+		fh, err = cos.CreateFile(p.Wfqn)
+	} else {
+		fh, err = os.OpenFile(p.Wfqn, os.O_WRONLY, 0)
+	}
+	if err != nil {
+		return true, err
+	}
+	// (uncommitted bytes, if any, get overwritten)
+	if err = fh.Truncate(p.Offset); err == nil {
+		_, err = fh.Seek(p.Offset, io.SeekStart)
+	}
+	if err != nil {
+		cos.Close(fh)
+		return true, err
+	}
+	if p.Offset == 0 {
+		g.store.setPartial(p.uname, &p.partialRec)
+	}
+	task.currentSize.Store(p.Offset)
+
+	var (
+		r            = task.wrapProgress(body)
+		buf, slab    = core.T.PageMM().AllocSize(memsys.DefaultBufSize)
+		uncommitted  int64
+		errR, errW   error
+		fatal        bool
+		received, nw int
+	)
+	for errR == nil && errW == nil {
+		received, errR = r.Read(buf)
+		if received > 0 {
+			nw, errW = fh.Write(buf[:received])
+			uncommitted += int64(nw)
+			if errW == nil && uncommitted >= resumeCommitSz {
+				errW = p.commit(fh, uncommitted)
+				uncommitted = 0
+			}
+		}
+	}
+	slab.Free(buf)
+
+	if errC := p.commit(fh, uncommitted); errW == nil {
+		errW = errC
+	}
+	cos.Close(fh)
+
+	switch {
+	case errW != nil:
+		fatal, err = true, errW
+	case errR != io.EOF:
+		err = &errInterrupted{errR, p.Offset, p.Size}
+	case p.Offset < p.Size:
+		err = &errInterrupted{io.ErrUnexpectedEOF, p.Offset, p.Size}
+	case p.Offset > p.Size:
+		task.dropPartial()
+		err = fmt.Errorf("received %d bytes, expected %d (Content-Length)", p.Offset, p.Size)
+	}
+	return fatal, err
+}
+
+func (p *partial) commit(fh *os.File, n int64) error {
+	if n == 0 {
+		return nil
+	}
+	if err := fh.Sync(); err != nil {
+		return err
+	}
+	p.Offset += n
+	g.store.setPartial(p.uname, &p.partialRec)
+	return nil
+}
+
+func (p *partial) discard() {
+	if err := cos.RemoveFile(p.Wfqn); err != nil {
+		nlog.Warningln("failed to remove partial download", p.Wfqn, err)
+	}
+	g.store.deletePartial(p.uname)
+}
+
+func (task *singleTask) dropPartial() {
+	task.part.discard()
+	task.part = nil
+}
+
+// the offset of the next response: the committed one or, when chunked, the first chunk yet to complete
+func (p *partial) resumeAt() int64 {
+	if p.ChunkSize == 0 {
+		return p.Offset
+	}
+	for i, done := range p.Done {
+		if !done {
+			return int64(i) * p.ChunkSize
+		}
+	}
+	return p.Size
+}
+
+// "bytes 100-999/1000" => (100, 1000)
+func parseContentRange(s string) (start, total int64, err error) {
+	var end int64
+	if start, end, total, err = parseRange(s); err == nil && end != total-1 {
+		err = fmt.Errorf("invalid %s %q (expecting the range through the end)", cos.HdrContentRange, s)
+	}
+	return start, total, err
+}
+
+// "bytes 100-199/1000" => (100, 199, 1000)
+func parseRange(s string) (start, end, total int64, err error) {
+	errInval := fmt.Errorf("invalid %s %q", cos.HdrContentRange, s)
+	rng, ok := strings.CutPrefix(s, cos.HdrContentRangeValPrefix)
+	if !ok {
+		return 0, 0, 0, errInval
+	}
+	se, t, ok := strings.Cut(rng, "/")
+	if !ok {
+		return 0, 0, 0, errInval
+	}
+	st, en, ok := strings.Cut(se, "-")
+	if !ok {
+		return 0, 0, 0, errInval
+	}
+	if start, err = strconv.ParseInt(st, 10, 64); err != nil {
+		return 0, 0, 0, errInval
+	}
+	if end, err = strconv.ParseInt(en, 10, 64); err != nil {
+		return 0, 0, 0, errInval
+	}
+	// NOTE: "*" (unknown total) won't do
+	if total, err = strconv.ParseInt(t, 10, 64); err != nil || start > end || end >= total {
+		return 0, 0, 0, errInval
+	}
+	return start, end, total, nil
+}
@@ -0,0 +1,30 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseContentRange(t *testing.T) {
+	start, total, err := parseContentRange("bytes 100-999/1000")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, start == 100 && total == 1000, "expected (100, 1000), got (%d, %d)", start, total)
+
+	for _, s := range []string{
+		"",
+		"100-999/1000",
+		"bytes 100-999/*",    // unknown total
+		"bytes 100-998/1000", // not through the end
+		"bytes 999-100/1000",
+		"bytes 100/1000",
+		"bytes a-999/1000",
+	} {
+		_, _, err := parseContentRange(s)
+		tassert.Errorf(t, err != nil, "expected %q to fail", s)
+	}
+}
@@ -0,0 +1,204 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"container/heap"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Spilling (see `DownloaderConf.SpillQueue`): when the jogger's queue is full, its least
+// urgent pending tasks (see `Base.Priority`) get serialized into the downloader DB, in batches,
+// and removed from the queue. A batch is loaded back (see `queue.get`) as soon as its most
+// urgent task is due - that is, comes before the queue's first - so that the tasks are served
+// in the same order as if nothing was ever spilled.
+// Dedup and abort keep using `queue.m` (that includes spilled tasks): a spilled task
+// of an aborted job gets skipped by the jogger once reloaded, same as any other queued one.
+// A batch that fails to load gets its tasks failed (see `CauseSpill`), so that the respective
+// jobs do not wait for them forever.
+
+const spillBatch = queueSize / 2
+
+type (
+	spill struct {
+		db     *downloaderDB
+		xdl    *Xact
+		jobs   map[string]jobif // jobs that have spilled tasks
+		prefix string           // DB key prefix (one per mountpath)
+		keys   []spillKey       // spilled batches
+		seq    int64
+	}
+	spillKey struct {
+		key  string
+		top  pendingTask // the batch's most urgent task (priority and arrival order only)
+		refs []spillRef  // the batch's tasks (to fail them if need be - see `fail`)
+	}
+	spillRef struct {
+		job jobif
+		obj dlObj // (name, bucket, and link only)
+	}
+	// serialized `singleTask`
+	spilledTask struct {
+		Bck        *cmn.Bck    `json:"bck,omitempty"` // multi-bucket job only
+		JobID      string      `json:"job"`
+		ObjName    string      `json:"name"`
+		Link       string      `json:"link,omitempty"`
+		FromRemote bool        `json:"remote,omitempty"`
+		Force      bool        `json:"force,omitempty"`
+		Timeout    int64       `json:"timeout,omitempty"` // per-object (nanoseconds)
+		Mirrors    []string    `json:"mirrors,omitempty"`
+		CksumType  string      `json:"cksum_type,omitempty"`
+		CksumValue string      `json:"cksum_value,omitempty"`
+		Headers    http.Header `json:"headers,omitempty"`
+		Seq        int64       `json:"seq"` // arrival order (see `queue.seq`)
+	}
+)
+
+func newSpill(db *downloaderDB, xdl *Xact, mpath string) *spill {
+	return &spill{
+		db:     db,
+		xdl:    xdl,
+		jobs:   make(map[string]jobif, 4),
+		prefix: path.Join(downloaderSpill, xdl.ID(), mpath),
+	}
+}
+
+// PRECONDITION: `q.Lock()`
+// serialize the tasks (the most urgent first); returns false when failed to do so
+func (sp *spill) spill(pts []*pendingTask) bool {
+	sts := make([]spilledTask, 0, len(pts))
+	for _, pt := range pts {
+		t := pt.t
+		st := spilledTask{
+			JobID:      t.jobID(),
+			ObjName:    t.obj.objName,
+			Link:       t.obj.link,
+			FromRemote: t.obj.fromRemote,
+			Force:      t.obj.force,
+			Timeout:    int64(t.obj.timeout),
+			Mirrors:    t.obj.mirrors,
+			Headers:    t.obj.headers,
+			Seq:        pt.seq,
+		}
+		if t.obj.bck != nil {
+			st.Bck = t.obj.bck.Bucket()
+		}
+		if t.obj.cksum != nil {
+			st.CksumType, st.CksumValue = t.obj.cksum.Get()
+		}
+		sts = append(sts, st)
+	}
+	key := path.Join(sp.prefix, strconv.FormatInt(sp.seq, 10))
+	sp.seq++
+	if err := sp.db.spillTasks(key, sts); err != nil {
+		// keep them in memory
+		nlog.Errorln("failed to spill", len(pts), "download tasks:", err)
+		return false
+	}
+	refs := make([]spillRef, 0, len(pts))
+	for _, pt := range pts {
+		t := pt.t
+		sp.jobs[t.jobID()] = t.job
+		obj := dlObj{objName: t.obj.objName, link: t.obj.link, bck: t.obj.bck, fromRemote: t.obj.fromRemote}
+		refs = append(refs, spillRef{job: t.job, obj: obj})
+	}
+	sp.keys = append(sp.keys, spillKey{key: key, top: pendingTask{prio: pts[0].prio, seq: pts[0].seq}, refs: refs})
+	return true
+}
+
+// PRECONDITION: `q.Lock()`
+// load back the batches that are due: those that come before the first task in the queue
+func (sp *spill) reload(q *queue) {
+	for len(sp.keys) > 0 {
+		var i int
+		for k := 1; k < len(sp.keys); k++ {
+			if sp.keys[k].top.before(&sp.keys[i].top) {
+				i = k
+			}
+		}
+		if q.pq.Len() > 0 && !sp.keys[i].top.before(q.pq[0]) {
+			return
+		}
+		sk := sp.keys[i]
+		sp.keys = append(sp.keys[:i], sp.keys[i+1:]...)
+		sp.load(&sk, q)
+	}
+	clear(sp.jobs)
+}
+
+func (sp *spill) load(sk *spillKey, q *queue) {
+	sts, err := sp.db.unspillTasks(sk.key)
+	if err != nil {
+		nlog.Errorln("failed to load spilled download tasks:", err)
+		sp.fail(sk, q, err)
+		return
+	}
+	pq := &q.pq
+	for i := range sts {
+		st := &sts[i]
+		job, ok := sp.jobs[st.JobID]
+		if !ok {
+			continue
+		}
+		t := &singleTask{
+			xdl: sp.xdl,
+			job: job,
+			obj: dlObj{
+				objName:    st.ObjName,
+				link:       st.Link,
+				fromRemote: st.FromRemote,
+				force:      st.Force,
+				timeout:    time.Duration(st.Timeout),
+				mirrors:    st.Mirrors,
+				headers:    st.Headers,
+			},
+		}
+		if st.Bck != nil {
+			t.obj.bck = meta.CloneBck(st.Bck)
+		}
+		if st.CksumType != "" {
+			t.obj.cksum = cos.NewCksum(st.CksumType, st.CksumValue)
+		}
+		heap.Push(pq, &pendingTask{t: t, seq: st.Seq, prio: job.priority()})
+	}
+}
+
+// PRECONDITION: `q.Lock()`
+// fail the tasks of the batch that cannot be loaded: they are no longer pending
+func (sp *spill) fail(sk *spillKey, q *queue, err error) {
+	var (
+		n   int
+		msg = "failed to load spilled task: " + err.Error()
+	)
+	for _, ref := range sk.refs {
+		t := &singleTask{xdl: sp.xdl, job: ref.job, obj: ref.obj}
+		t.job.throttler().release() // (acquired prior to queuing - see `dispatcher.doSingle`)
+		if !q.removeFromSet(t.jobID(), t.uid()) {
+			continue // e.g., the job's been aborted
+		}
+		t.markFailed(msg, CauseSpill)
+		n++
+	}
+	if n > 0 {
+		sp.xdl.SubPending(n)
+	}
+}
+
+// PRECONDITION: `q.Lock()`
+func (sp *spill) cleanup() {
+	for _, k := range sp.keys {
+		sp.db.deleteSpilled(k.key)
+	}
+	sp.keys = nil
+	clear(sp.jobs)
+}
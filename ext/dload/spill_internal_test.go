@@ -0,0 +1,191 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newSpillQueue(t *testing.T) *queue {
+	driver, err := kvdb.NewBuntDB(":memory:")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { driver.Close() })
+
+	q := newQueue()
+	q.sp = newSpill(newDownloadDB(driver), &Xact{}, "/tmp/mp1")
+	return q
+}
+
+// put without blocking (fails the test otherwise)
+func spillPut(t *testing.T, q *queue, jobs []*sliceDlJob, num int) {
+	for i := range num {
+		task := &singleTask{
+			job: jobs[i%len(jobs)],
+			obj: dlObj{objName: fmt.Sprintf("obj-%06d", i), link: fmt.Sprintf("http://example.com/obj-%06d", i)},
+		}
+		q.mu.Lock()
+		ok, ch := q.putCh(task)
+		q.mu.Unlock()
+		tassert.Fatalf(t, ok, "failed to put %s", task.obj.objName)
+		select {
+		case ch <- struct{}{}:
+			q.put(task)
+		default:
+			t.Fatalf("queue blocked at %d (len %d)", i, len(q.slots))
+		}
+	}
+}
+
+func spillExists(q *queue, task *singleTask) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.exists(task.jobID(), task.uid())
+}
+
+func TestQueueSpill(t *testing.T) {
+	var (
+		q   = newSpillQueue(t)
+		bck = meta.NewBck("spill", apc.AIS, cmn.NsGlobal)
+		job = &sliceDlJob{baseDlJob: baseDlJob{id: "job1", bck: bck}}
+		num = 5*queueSize + 7
+	)
+	spillPut(t, q, []*sliceDlJob{job}, num)
+	tassert.Errorf(t, len(q.sp.keys) > 0, "expected spilled batches")
+	tassert.Errorf(t, q.pq.Len() <= queueSize, "queue overflow: %d", q.pq.Len())
+
+	// dedup: including spilled
+	q.mu.Lock()
+	ok, _ := q.putCh(&singleTask{job: job, obj: dlObj{objName: "obj-000000", link: "http://example.com/obj-000000"}})
+	q.mu.Unlock()
+	tassert.Errorf(t, !ok, "expected spilled task to be deduplicated")
+
+	// all tasks, once and in order
+	for i := range num {
+		task := q.get()
+		tassert.Fatalf(t, task != nil, "nil task at %d", i)
+		expected := fmt.Sprintf("obj-%06d", i)
+		tassert.Fatalf(t, task.obj.objName == expected, "expected %s, got %s", expected, task.obj.objName)
+		tassert.Fatalf(t, task.job == job, "expected job %s, got %v", job.ID(), task.job)
+		tassert.Fatalf(t, spillExists(q, task), "task %s does not exist", task.obj.objName)
+		tassert.Fatalf(t, q.del(task), "failed to delete %s", task.obj.objName)
+	}
+	tassert.Errorf(t, len(q.sp.keys) == 0 && q.pq.Len() == 0, "expected nothing spilled")
+	tassert.Errorf(t, len(q.m) == 0, "expected no pending tasks, got %d jobs", len(q.m))
+
+	q.close()
+	tassert.Errorf(t, q.get() == nil, "expected nil upon close")
+	q.cleanup()
+}
+
+func TestQueueSpillAbort(t *testing.T) {
+	var (
+		q    = newSpillQueue(t)
+		bck  = meta.NewBck("spill", apc.AIS, cmn.NsGlobal)
+		job1 = &sliceDlJob{baseDlJob: baseDlJob{id: "job1", bck: bck}}
+		job2 = &sliceDlJob{baseDlJob: baseDlJob{id: "job2", bck: bck}}
+		num  = 3 * queueSize
+	)
+	spillPut(t, q, []*sliceDlJob{job1, job2}, num)
+
+	q.mu.Lock()
+	removed := q.removeJob(job1.ID())
+	q.mu.Unlock()
+	tassert.Errorf(t, removed == num/2, "expected %d removed, got %d", num/2, removed)
+
+	var cnt1, cnt2 int
+	for range num {
+		task := q.get()
+		tassert.Fatalf(t, task != nil, "nil task")
+		if !spillExists(q, task) {
+			tassert.Errorf(t, task.job == job1, "unexpected (removed) task of %s", task.jobID())
+			cnt1++
+			continue
+		}
+		tassert.Errorf(t, task.job == job2, "unexpected task of %s", task.jobID())
+		q.del(task)
+		cnt2++
+	}
+	tassert.Errorf(t, cnt1 == num/2 && cnt2 == num/2, "expected %d/%d, got %d/%d", num/2, num/2, cnt1, cnt2)
+	q.cleanup()
+}
+
+func TestQueueSpillPriority(t *testing.T) {
+	var (
+		q    = newSpillQueue(t)
+		bck  = meta.NewBck("spill", apc.AIS, cmn.NsGlobal)
+		low  = &sliceDlJob{baseDlJob: baseDlJob{id: "low", bck: bck}}
+		high = &sliceDlJob{baseDlJob: baseDlJob{id: "high", bck: bck, prio: 10}}
+		num  = 3*queueSize + 7
+	)
+	// high-priority tasks get spilled too (while there's nothing less urgent)
+	spillPut(t, q, []*sliceDlJob{high}, num)
+	spillPut(t, q, []*sliceDlJob{low}, num)
+	tassert.Errorf(t, len(q.sp.keys) > 0, "expected spilled batches")
+
+	// all high-priority tasks in order, and only then the rest
+	for _, job := range []*sliceDlJob{high, low} {
+		for i := range num {
+			task := q.get()
+			tassert.Fatalf(t, task != nil, "nil task at %d", i)
+			expected := fmt.Sprintf("obj-%06d", i)
+			tassert.Fatalf(t, task.job == job && task.obj.objName == expected, "expected %s/%s, got %s/%s",
+				job.ID(), expected, task.jobID(), task.obj.objName)
+			q.del(task)
+		}
+	}
+	tassert.Errorf(t, len(q.sp.keys) == 0 && q.pq.Len() == 0, "expected nothing pending")
+	q.cleanup()
+}
+
+// a batch that fails to load: its tasks are failed, no longer pending
+func TestQueueSpillLoadFailure(t *testing.T) {
+	q := newSpillQueue(t)
+	tgt, store := core.T, g.store
+	core.T = mock.NewTarget(nil)
+	g.store = &infoStore{downloaderDB: q.sp.db, dljobs: make(map[string]*dljob)}
+	t.Cleanup(func() { core.T, g.store = tgt, store })
+
+	var (
+		bck = meta.NewBck("spill", apc.AIS, cmn.NsGlobal)
+		job = &sliceDlJob{baseDlJob: baseDlJob{id: "job1", bck: bck}}
+		num = 3 * queueSize
+		xdl = q.sp.xdl
+	)
+	g.store.dljobs[job.ID()] = &dljob{id: job.ID()}
+	spillPut(t, q, []*sliceDlJob{job}, num)
+	for range num {
+		xdl.IncPending()
+	}
+	tassert.Fatalf(t, len(q.sp.keys) > 0, "expected spilled batches")
+	lost := q.sp.keys[len(q.sp.keys)-1]
+	q.sp.db.deleteSpilled(lost.key)
+
+	var cnt int
+	for range num - len(lost.refs) {
+		task := q.get()
+		tassert.Fatalf(t, task != nil, "nil task at %d", cnt)
+		tassert.Fatalf(t, q.del(task), "failed to delete %s", task.obj.objName)
+		xdl.DecPending()
+		cnt++
+	}
+	tassert.Errorf(t, len(q.sp.keys) == 0 && q.pq.Len() == 0, "expected nothing queued")
+	tassert.Errorf(t, len(q.m) == 0, "expected no pending tasks, got %d jobs", len(q.m))
+	tassert.Errorf(t, xdl.Pending() == 0, "expected zero pending, got %d", xdl.Pending())
+	errCnt := g.store.dljobs[job.ID()].errorCnt.Load()
+	tassert.Errorf(t, int(errCnt) == len(lost.refs), "expected %d failed, got %d", len(lost.refs), errCnt)
+
+	q.close()
+	tassert.Errorf(t, q.get() == nil, "expected nil upon close")
+	q.cleanup()
+}
@@ -0,0 +1,101 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Transient (stream-through) download: GET from the origin and write the content directly
+// into the client's response - no job, no LOM, nothing stored. Same origin request
+// (custom headers, GCS user agent, signing) and same content validation as the regular download.
+
+// ErrStreamAborted is returned once the response status has already been sent -
+// the caller must then abort the connection rather than write an error.
+var ErrStreamAborted = errors.New("stream-through download aborted")
+
+// Stream fetches `body.Link` and streams it back via `w`, failing when the content exceeds `maxSize`.
+func Stream(ctx context.Context, w http.ResponseWriter, body *StreamBody, maxSize int64) (int64, error) {
+	timeout := cmn.GCO.Get().Downloader.Timeout.D()
+	if body.Timeout != "" {
+		timeout, _ = time.ParseDuration(body.Timeout) // validated
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	link := cmn.PrependProtocol(body.Link)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return 0, cmn.NewErrFailedTo(nil, "create request", redactLink(link), err, http.StatusBadRequest)
+	}
+	link = redactLink(link) // (from here on, for printing only)
+	cmn.CopyHeaders(req.Header, body.Headers)
+	if cos.IsGoogleStorageURL(req.URL) {
+		req.Header.Add("User-Agent", gcsUA)
+	}
+	if err := signReq(req); err != nil {
+		return 0, err
+	}
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return 0, cmn.NewErrFailedTo(nil, "GET", link, err, http.StatusBadGateway)
+	}
+	defer cos.Close(resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return 0, cmn.NewErrFailedTo(nil, "GET", link, fmt.Errorf("%q does not exist", link), http.StatusNotFound)
+	case resp.StatusCode >= http.StatusBadRequest:
+		return 0, cmn.NewErrFailedTo(nil, "GET", link, fmt.Errorf("status %d", resp.StatusCode), http.StatusBadGateway)
+	case resp.ContentLength > maxSize:
+		err := fmt.Errorf("size of %q (%s) exceeds the configured maximum (%s)", link,
+			cos.ToSizeIEC(resp.ContentLength, 2), cos.ToSizeIEC(maxSize, 0))
+		return 0, cmn.NewErrFailedTo(nil, "stream", link, err, http.StatusRequestEntityTooLarge)
+	}
+
+	var (
+		size int64
+		r    io.ReadCloser = resp.Body
+	)
+	if v := newValidator(body.Validator); v != nil {
+		r = v.wrap(r)
+	}
+	r = &progressReader{r: r, reporter: func(n int64) { size += n }}
+
+	hdr := w.Header()
+	if ct := resp.Header.Get(cos.HdrContentType); ct != "" {
+		hdr.Set(cos.HdrContentType, ct)
+	}
+	if resp.ContentLength >= 0 {
+		hdr.Set(cos.HdrContentLength, resp.Header.Get(cos.HdrContentLength))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	// NOTE: when the size is not known upfront the limit applies as we go
+	if _, err := io.Copy(w, io.LimitReader(r, maxSize)); err != nil {
+		return size, fmt.Errorf("%w: %v", ErrStreamAborted, err)
+	}
+	if size == maxSize {
+		// probe for more (and let the validator, if any, see EOF)
+		var b [1]byte
+		n, err := r.Read(b[:])
+		if n > 0 {
+			return size, fmt.Errorf("%w: %q exceeds the configured maximum size (%s)", ErrStreamAborted, link,
+				cos.ToSizeIEC(maxSize, 0))
+		}
+		if err != nil && err != io.EOF {
+			return size, fmt.Errorf("%w: %v", ErrStreamAborted, err)
+		}
+	}
+	return size, nil
+}
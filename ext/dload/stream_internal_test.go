@@ -0,0 +1,95 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestStream(t *testing.T) {
+	const maxSize = 16
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/small":
+			w.Header().Set(cos.HdrContentType, "text/plain")
+			w.Write([]byte("0123456789"))
+		case "/exact":
+			w.Write([]byte(strings.Repeat("x", maxSize)))
+		case "/large": // Content-Length known upfront
+			w.Write([]byte(strings.Repeat("x", 2*maxSize)))
+		case "/chunked": // size not known upfront
+			w.Write([]byte(strings.Repeat("x", maxSize/2)))
+			w.(http.Flusher).Flush()
+			w.Write([]byte(strings.Repeat("x", maxSize)))
+		case "/html":
+			w.Write([]byte("<html></html>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer origin.Close()
+	g.clientH = origin.Client()
+
+	tests := []struct {
+		path      string
+		validator string
+		content   string
+		ecode     int  // response status when failed prior to streaming
+		aborted   bool // failed while streaming
+	}{
+		{path: "/small", content: "0123456789"},
+		{path: "/exact", content: strings.Repeat("x", maxSize)},
+		{path: "/large", ecode: http.StatusRequestEntityTooLarge},
+		{path: "/chunked", aborted: true},
+		{path: "/html", validator: ValidatorNotHTML, aborted: true},
+		{path: "/missing", ecode: http.StatusNotFound},
+	}
+	for _, test := range tests {
+		body := &StreamBody{Link: origin.URL + test.path, Timeout: "10s", Validator: test.validator}
+		tassert.CheckFatal(t, body.Validate())
+
+		w := httptest.NewRecorder()
+		_, err := Stream(context.Background(), w, body, maxSize)
+		switch {
+		case test.ecode != 0:
+			var errf *cmn.ErrFailedTo
+			tassert.Fatalf(t, errors.As(err, &errf), "%s: expected failure, got %v", test.path, err)
+			w = httptest.NewRecorder()
+			cmn.WriteErr(w, httptest.NewRequest(http.MethodPost, "/", http.NoBody), err)
+			tassert.Errorf(t, w.Code == test.ecode, "%s: expected status %d, got %d", test.path, test.ecode, w.Code)
+		case test.aborted:
+			tassert.Errorf(t, errors.Is(err, ErrStreamAborted), "%s: expected abort, got %v", test.path, err)
+		default:
+			tassert.CheckError(t, err)
+			tassert.Errorf(t, w.Body.String() == test.content, "%s: content mismatch", test.path)
+		}
+	}
+}
+
+func TestStreamBodyValidate(t *testing.T) {
+	tests := []struct {
+		body  StreamBody
+		valid bool
+	}{
+		{StreamBody{Link: "http://example.com/a.txt"}, true},
+		{StreamBody{Link: "example.com/a.txt", Timeout: "1m", Validator: ValidatorJSON}, true},
+		{StreamBody{}, false},
+		{StreamBody{Link: "http://example.com/a.txt", Timeout: "soon"}, false},
+		{StreamBody{Link: "http://example.com/a.txt", Validator: "md5"}, false},
+	}
+	for _, test := range tests {
+		err := test.body.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, err=%v", test.body, test.valid, err)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -18,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/stats"
 )
@@ -27,9 +30,11 @@ const (
 )
 
 const (
-	retryCnt         = 10  // number of retries to external resource
 	reqTimeoutFactor = 1.2 // newTimeout = prevTimeout * reqTimeoutFactor
+	maxRetryBackoff  = time.Minute
 	internalErrorMsg = "internal server error"
+	deadlineErrorMsg = "job deadline exceeded"
+	cancelErrorMsg   = "cancelled by user"
 )
 
 type singleTask struct {
@@ -38,23 +43,35 @@ type singleTask struct {
 	obj         dlObj
 	started     atomic.Time
 	ended       atomic.Time
-	currentSize atomic.Int64       // current file size (updated as the download progresses)
-	totalSize   atomic.Int64       // total size (nonzero iff Content-Length header was provided by the source)
-	downloadCtx context.Context    // w/ cancel function
-	getCtx      context.Context    // w/ timeout and size
-	cancel      context.CancelFunc // to cancel in-progress download
+	currentSize atomic.Int64            // current file size (updated as the download progresses)
+	totalSize   atomic.Int64            // total size (nonzero iff Content-Length header was provided by the source)
+	headers     cos.StrKVs              // captured from the first successful response (see `Base.CaptureHeaders`)
+	finalURL    string                  // the last URL in the chain of redirects, if any
+	mirror      string                  // fallback link that served the content (see `dlObj.mirrors`)
+	mu          sync.Mutex              // guards the three above (written by the worker, read by status requests)
+	downloadCtx context.Context         // w/ cancel function
+	getCtx      context.Context         // w/ timeout and size
+	cancel      context.CancelFunc      // to cancel in-progress download
+	abortCause  ratomic.Pointer[string] // set by `abort` (see `TaskErrInfo.Cause`)
+	part        *partial                // resumable download in progress, if any (see resume.go)
+	fl          *flight                 // the download shared with identical tasks, if any (see flight.go)
+	errInfo     *TaskErrInfo            // the failure, if any (see `flights.finish`)
+	detached    atomic.Bool             // aborted while still downloading for others (see flight.go)
+	failed      bool                    // (see `_markFailed` and `persist`)
+	requeued    bool                    // aborted because the mountpath is being disabled (see `dispatcher.requeue`)
 }
 
-// List of HTTP status codes which we shouldn'task retry (just report the job failed).
-var terminalStatuses = map[int]struct{}{
-	http.StatusNotFound:          {},
-	http.StatusPaymentRequired:   {},
-	http.StatusUnauthorized:      {},
-	http.StatusForbidden:         {},
-	http.StatusMethodNotAllowed:  {},
-	http.StatusNotAcceptable:     {},
-	http.StatusProxyAuthRequired: {},
-	http.StatusGone:              {},
+// HTTP status codes worth retrying: 408 (Request Timeout), 429 (Too Many Requests) and 5xx, except those that
+// won't change with time (501 Not Implemented, 505 HTTP Version Not Supported)
+func retriableStatus(code int) bool {
+	switch {
+	case code == http.StatusTooManyRequests, code == http.StatusRequestTimeout:
+		return true
+	case code < http.StatusInternalServerError:
+		return false
+	default:
+		return code != http.StatusNotImplemented && code != http.StatusHTTPVersionNotSupported
+	}
 }
 
 ////////////////
@@ -67,12 +84,12 @@ func (task *singleTask) init() {
 }
 
 func (task *singleTask) download(lom *core.LOM) {
-	err := lom.InitBck(task.job.Bck())
+	err := lom.InitBck(task.bck())
 	if err == nil {
 		err = lom.Load(true /*cache it*/, false /*locked*/)
 	}
 	if err != nil && !os.IsNotExist(err) {
-		task.markFailed(internalErrorMsg)
+		task.markFailed(internalErrorMsg, task.failCause(lom, false /*origin*/))
 		return
 	}
 
@@ -80,7 +97,42 @@ func (task *singleTask) download(lom *core.LOM) {
 		nlog.Infof("Starting download for %v", task)
 	}
 
+	// per-origin circuit breaker (see `DownloaderConf.BreakerErrs`);
+	// with mirrors, the breakers are consulted link by link (see `downloadLocal`)
+	var (
+		brk  = task.xdl.dispatcher.brk
+		host string
+	)
+	if brk != nil && !task.obj.fromRemote && len(task.obj.mirrors) == 0 {
+		host = linkHost(task.obj.link)
+		if err := brk.allow(host); err != nil {
+			if cmn.Rom.FastV(4, cos.SmoduleDload) {
+				nlog.Infoln(task, err)
+			}
+			task.markFailed(err.Error(), CauseBreakerOpen)
+			return
+		}
+	}
+
 	task.started.Store(time.Now())
+	vlabs := g.store.jobVlabs(task.jobID())
+	tstats := core.T.StatsUpdater()
+	tstats.AddWith(cos.NamedVal64{Name: stats.DloadJobInflight, Value: 1, VarLabs: vlabs})
+	defer tstats.AddWith(cos.NamedVal64{Name: stats.DloadJobInflight, Value: -1, VarLabs: vlabs})
+
+	if task.job.headOnly() {
+		err = task.preflight()
+		task.ended.Store(time.Now())
+		brk.done(host, err)
+		if err != nil {
+			task.markLinkFailed(err, CauseOriginError)
+		} else {
+			g.store.incFinished(task.jobID())
+			g.store.incBck(task.jobID(), task.obj.bck, bckFinished)
+		}
+		return
+	}
+
 	lom.SetAtimeUnix(task.started.Load().UnixNano())
 	if task.obj.fromRemote {
 		err = task.downloadRemote(lom)
@@ -88,66 +140,215 @@ func (task *singleTask) download(lom *core.LOM) {
 		err = task.downloadLocal(lom)
 	}
 	task.ended.Store(time.Now())
+	if err != nil && task.job.expired() {
+		brk.done(host, context.Canceled) // (inconclusive)
+	} else {
+		brk.done(host, err)
+	}
 
 	if err != nil {
-		task.markFailed(err.Error())
+		if cause := task.abortCause.Load(); cause != nil && *cause == CauseMpathDisabled {
+			task.requeued = true // (not failing - see `jogger.work`)
+			return
+		}
+		if task.job.expired() && !task.detached.Load() {
+			task.markFailed(deadlineErrorMsg, CauseJobTimeout)
+		} else if errors.Is(err, errBreakerOpen) {
+			task.markFailed(err.Error(), CauseBreakerOpen)
+		} else if isErrCksum(err) {
+			task.markFailed(err.Error(), CauseCksumMismatch)
+		} else if task.obj.fromRemote {
+			task._markFailed(TaskErrInfo{Err: err.Error(), Cause: task.failCause(lom, true /*origin*/), Code: errStatus(err)})
+		} else {
+			task.markLinkFailed(err, task.failCause(lom, true /*origin*/))
+		}
+		return
+	}
+
+	if task.detached.Load() {
+		// the download (shared with others) succeeded but this task's been aborted
+		cause := *task.abortCause.Load()
+		task.markFailed(abortErrMsg(cause), cause)
+		task.errInfo = nil // (not the download's failure - see `flights.finish`)
 		return
 	}
 
 	g.store.incFinished(task.jobID())
+	g.store.incBck(task.jobID(), task.obj.bck, bckFinished)
 
-	vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
+	bvlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 	lsize := task.currentSize.Load()
-	core.T.StatsUpdater().AddWith(
-		cos.NamedVal64{Name: stats.DloadSize, Value: lsize, VarLabs: vlabs},
-		cos.NamedVal64{Name: stats.DloadLatencyTotal, Value: int64(task.ended.Load().Sub(task.started.Load())), VarLabs: vlabs},
+	g.store.addSize(task.jobID(), lsize)
+	tstats.AddWith(
+		cos.NamedVal64{Name: stats.DloadSize, Value: lsize, VarLabs: bvlabs},
+		cos.NamedVal64{Name: stats.DloadLatencyTotal, Value: int64(task.ended.Load().Sub(task.started.Load())), VarLabs: bvlabs},
+		cos.NamedVal64{Name: stats.DloadJobSize, Value: lsize, VarLabs: vlabs},
 	)
 	task.xdl.ObjsAdd(1, lsize)
 }
 
-func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*err is fatal*/, error) {
-	ctx, cancel := context.WithTimeout(task.downloadCtx, timeout)
+func (task *singleTask) _dlocal(lom *core.LOM, link string, timeout time.Duration) (bool /*err is fatal*/, error) {
+	task.loadPartial(lom, link)
+
+	// wait for the target-wide in-flight budget, if configured, prior to issuing the request - and
+	// on the task's context, so that the wait does not count against the request timeout
+	in := task.xdl.dispatcher.inflight
+	n, err := in.acquire(task.downloadCtx, task.expectedSize())
+	if err != nil {
+		return false, err
+	}
+	defer in.release(n)
+
+	// (with the idle-read deadline, the timeout bounds the wait for the response only - see idle.go)
+	ctx, received, cancel := attemptCtx(task.downloadCtx, timeout, cmn.GCO.Get().Downloader.ReadIdleTimeout.D())
 	defer cancel()
 
 	task.getCtx = ctx
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.obj.link, http.NoBody)
+	req, err := task.newReq(ctx, link)
 	if err != nil {
 		return true, err
 	}
+	task.rangeReq(req)
+
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return false, attemptErr(ctx, err)
+	}
+	received(resp)
+
+	fatal, err := task._dput(lom, link, req, resp)
+	cos.Close(resp.Body)
+	if errA := attemptErr(ctx, err); errA != err {
+		return false, errA // (retriable, even when failing to store)
+	}
+	return fatal, err
+}
 
-	// Add custom headers, if any
+// GET the link, with the job's (and the object's) custom headers and credentials
+func (task *singleTask) newReq(ctx context.Context, link string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add custom headers, if any (per-object ones override the job's)
 	cmn.CopyHeaders(req.Header, task.job.Headers())
+	cmn.CopyHeaders(req.Header, task.obj.headers)
 
 	// Set "User-Agent" header when doing requests to Google Cloud Storage.
 	// This should increase the number of connections to GCS.
 	if cos.IsGoogleStorageURL(req.URL) {
 		req.Header.Add("User-Agent", gcsUA)
 	}
-
-	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
-	if err != nil {
-		return false, err
+	if err := authReq(req, task.job.auth()); err != nil {
+		return nil, err
 	}
-
-	fatal, err := task._dput(lom, req, resp)
-	cos.Close(resp.Body)
-	return fatal, err
+	return req, nil
 }
 
-func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Response) (bool /*err is fatal*/, error) {
+func (task *singleTask) _dput(lom *core.LOM, link string, req *http.Request, resp *http.Response) (bool /*err is fatal*/, error) {
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && task.part != nil {
+		size := task.part.Size
+		task.dropPartial()
+		return false, &errInterrupted{errors.New("range not satisfiable"), 0, size} // (restart)
+	}
 	if resp.StatusCode >= http.StatusBadRequest {
 		if resp.StatusCode == http.StatusNotFound {
-			return false, cmn.NewErrHTTP(req, fmt.Errorf("%q does not exist", task.obj.link), http.StatusNotFound)
+			return false, cmn.NewErrHTTP(req, fmt.Errorf("%q does not exist", redactLink(link)), http.StatusNotFound)
 		}
 		return false, cmn.NewErrHTTP(req,
-			fmt.Errorf("failed to download %q: status %d", task.obj.link, resp.StatusCode),
+			fmt.Errorf("failed to download %q: status %d", redactLink(link), resp.StatusCode),
 			resp.StatusCode)
 	}
 
-	r := task.wrapReader(resp.Body)
-	size := attrsFromLink(task.obj.link, resp, lom)
-	task.setTotalSize(size)
+	if u := resp.Request.URL.String(); u != link {
+		task.mu.Lock()
+		task.finalURL = redactLink(u)
+		task.mu.Unlock()
+	}
+
+	if prefix, ok := task.job.extractTo(); ok {
+		if mime := extractMime(resp, task.obj.objName); mime != "" {
+			return task._dextract(lom, mime, prefix, resp)
+		}
+	}
+
+	if task.job.captureHeaders() {
+		task.captureHeaders(resp.Header)
+	}
+
+	cksum, err := task.expectedCksum(resp)
+	if err != nil {
+		return true, err
+	}
+	enc := task.encoding(resp)
+	if enc != "" && !decodable(enc) {
+		return true, fmt.Errorf("%q: unsupported %s %q (expecting gzip or deflate)", redactLink(link), cos.HdrContentEncoding, enc)
+	}
+
+	var (
+		r    io.ReadCloser
+		size = attrsFromLink(link, resp, lom)
+	)
+	p, err := task.partialFrom(lom, link, resp)
+	if err != nil {
+		return false, err
+	}
+	if p != nil {
+		task.setTotalSize(p.Size)
+		if p.ChunkSize == 0 {
+			if fatal, err := p.fetch(task, resp.Body); err != nil {
+				return fatal, err
+			}
+		} else {
+			if fatal, err := p.fetchChunks(task, link, resp.Body); err != nil {
+				return fatal, err
+			}
+			// chunks get assembled out of order - verify the workfile as a whole (see chunk.go)
+			if cksum != nil {
+				if err := p.verify(cksum, lom.Cname()); err != nil {
+					task.dropPartial()
+					return true, err
+				}
+				cksum = nil
+			}
+		}
+		fh, err := os.Open(p.Wfqn)
+		if err != nil {
+			task.dropPartial()
+			return true, err
+		}
+		// from here on, the partial download is either stored or invalid
+		defer func() {
+			if task.part == p {
+				task.dropPartial()
+			}
+		}()
+		r, size = task.wrapValidator(fh), p.Size
+	} else if enc != "" {
+		// verify the content as received (prior to decoding); the rest of it (validation, progress,
+		// post-processing) applies to the decoded content, the size of which is not known in advance
+		if r, err = newDecoder(wrapCksum(resp.Body, cksum, lom), enc); err != nil {
+			return false, err
+		}
+		r, size, cksum = task.wrapReader(r), -1, nil
+		task.totalSize.Store(0)
+		dropSourceCksums(lom)
+	} else {
+		r = task.wrapReader(resp.Body)
+		task.setTotalSize(size)
+	}
+	r = wrapCksum(r, cksum, lom) // (verify the source content - prior to post-processing, if any)
+	if link != task.obj.link {
+		lom.SetCustomKey(cmn.MirrorObjMD, redactLink(link))
+	}
+	if pp := task.job.postProcessor(); pp != nil {
+		r = pp.wrap(r)
+		size = -1 // size and checksum of the stored (transformed) content get computed upon PUT
+		dropSourceCksums(lom)
+	}
+	setMetadata(lom, task.job.metadata()) // stored atomically with the object
 
 	params := core.AllocPutParams()
 	{
@@ -169,13 +370,55 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 	return false, nil
 }
 
+// the object's own link and then, if need be, its mirrors - in order, each with its own retries
 func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
+	if len(task.obj.mirrors) == 0 {
+		return task._retry(lom, task.obj.link)
+	}
+	brk := task.xdl.dispatcher.brk
+	for i, link := range append([]string{task.obj.link}, task.obj.mirrors...) {
+		if i > 0 {
+			nlog.Warningln(task, "failing over to mirror", redactLink(link), "[", err, "]")
+			task.reset()
+		}
+		host := linkHost(link)
+		if err = brk.allow(host); err != nil {
+			continue
+		}
+		err = task._retry(lom, link)
+		if err != nil && task.job.expired() {
+			brk.done(host, context.Canceled) // (inconclusive)
+			return err
+		}
+		brk.done(host, err)
+		if err == nil {
+			if i > 0 {
+				task.mu.Lock()
+				task.mirror = redactLink(link)
+				task.mu.Unlock()
+			}
+			return nil
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
+			return err
+		}
+	}
+	return err
+}
+
+// retry transient failures with exponential backoff (see `DownloaderConf.MaxRetries`)
+func (task *singleTask) _retry(lom *core.LOM, link string) (err error) {
 	var (
+		config  = cmn.GCO.Get()
+		retries = config.Downloader.MaxRetryCount()
+		backoff = config.Downloader.RetryBackoffDur()
 		timeout = task.initialTimeout()
 		fatal   bool
+		errI    *errInterrupted
+		errIdle *errReadIdle
 	)
-	for i := range retryCnt {
-		fatal, err = task._dlocal(lom, timeout)
+	for i := 0; ; i++ {
+		fatal, err = task._dlocal(lom, link, timeout)
 		if err == nil || fatal {
 			return err
 		}
@@ -184,23 +427,103 @@ func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
 		if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
 			return err // canceled or stopped, so just return
 		}
-		if errors.Is(err, context.DeadlineExceeded) {
-			nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying", task, i, retryCnt, timeout)
+		herr := cmn.Err2HTTPErr(err)
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying", task, i, retries, timeout)
 			timeout = time.Duration(float64(timeout) * reqTimeoutFactor)
-		} else if herr := cmn.Err2HTTPErr(err); herr != nil {
-			nlog.Warningf("%s [retries: %d/%d]: failed to perform request: %v (code: %d)", task, i, retryCnt, err, herr.Status)
-			if _, exists := terminalStatuses[herr.Status]; exists {
+		case herr != nil:
+			if !retriableStatus(herr.Status) {
 				return err // nothing we can do
 			}
-		} else {
-			if !cos.IsRetriableConnErr(err) {
-				return err // ditto
-			}
-			nlog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, retryCnt, err)
+			nlog.Warningf("%s [retries: %d/%d]: failed to perform request: %v (code: %d)", task, i, retries, err, herr.Status)
+		case errors.As(err, &errI):
+			nlog.Warningf("%s [retries: %d/%d]: %v - resuming", task, i, retries, err)
+		case errors.As(err, &errIdle):
+			nlog.Warningf("%s [retries: %d/%d]: %v - retrying", task, i, retries, err)
+		case !cos.IsRetriableConnErr(err):
+			return err // ditto
+		default:
+			nlog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, retries, err)
+		}
+		if i >= retries {
+			return err
 		}
 		task.reset()
+		if errB := task.backoff(backoff, i); errB != nil {
+			return errB
+		}
 	}
-	return err
+}
+
+// sleep prior to the (i+1)-th retry; canceling the task (or the job) interrupts the wait
+func (task *singleTask) backoff(backoff time.Duration, i int) error {
+	return waitBackoff(task.downloadCtx, backoff, i)
+}
+
+// exponential backoff, up to `maxRetryBackoff`
+func waitBackoff(ctx context.Context, backoff time.Duration, i int) error {
+	sleep := min(backoff<<min(i, 16), maxRetryBackoff)
+	timer := time.NewTimer(sleep)
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	}
+}
+
+// transient failure of a request (other than the object's own GET - see `_retry`)
+func retriableErr(err error) bool {
+	if herr := cmn.Err2HTTPErr(err); herr != nil {
+		return retriableStatus(herr.Status)
+	}
+	var errIdle *errReadIdle
+	return errors.Is(err, context.DeadlineExceeded) || errors.As(err, &errIdle) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		cos.IsRetriableConnErr(err)
+}
+
+// HEAD the link, with the job's (and the object's) custom headers (the caller must close the response body)
+func (task *singleTask) head() (*http.Response, error) {
+	return headLink(task.obj.link, mergeHeaders(task.job.Headers(), task.obj.headers), task.job.auth())
+}
+
+// HeadModeOnly: record reachability, size, and (optionally) response headers
+func (task *singleTask) preflight() error {
+	resp, err := task.head() //nolint:bodyclose // cos.Close
+	if err != nil {
+		return err
+	}
+	cos.Close(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return cmn.NewErrHTTP(resp.Request, fmt.Errorf("%q is unreachable: status %d", redactLink(task.obj.link), resp.StatusCode),
+			resp.StatusCode)
+	}
+	task.setTotalSize(resp.ContentLength)
+	if task.job.captureHeaders() {
+		task.captureHeaders(resp.Header)
+	}
+	return nil
+}
+
+// the first successful response wins
+func (task *singleTask) captureHeaders(hdr http.Header) {
+	task.mu.Lock()
+	if task.headers == nil {
+		task.headers = captureHeaders(hdr)
+	}
+	task.mu.Unlock()
+}
+
+func captureHeaders(hdr http.Header) cos.StrKVs {
+	kvs := make(cos.StrKVs, len(CapturedHeaders))
+	for _, key := range CapturedHeaders {
+		if v := hdr.Get(key); v != "" {
+			kvs[key] = v
+		}
+	}
+	return kvs
 }
 
 func (task *singleTask) setTotalSize(size int64) {
@@ -209,82 +532,247 @@ func (task *singleTask) setTotalSize(size int64) {
 	}
 }
 
+// the number of bytes to download, if known prior to the request (the remainder, when resuming)
+func (task *singleTask) expectedSize() int64 {
+	if p := task.part; p != nil {
+		return p.Size - p.resumeAt()
+	}
+	return task.totalSize.Load()
+}
+
 func (task *singleTask) reset() {
 	task.totalSize.Store(0)
 	task.currentSize.Store(0)
 }
 
 func (task *singleTask) downloadRemote(lom *core.LOM) error {
+	// wait for the in-flight budget (see `_dlocal`); remote object size is not known in advance
+	in := task.xdl.dispatcher.inflight
+	n, err := in.acquire(task.downloadCtx, 0)
+	if err != nil {
+		return err
+	}
+	defer in.release(n)
+
 	// Set custom context values (used by `ais/backend/*`).
 	ctx, cancel := context.WithTimeout(task.downloadCtx, task.initialTimeout())
 	defer cancel()
 
-	ctx = context.WithValue(ctx, cos.CtxReadWrapper, cos.ReadWrapperFunc(task.wrapReader))
+	wrap := task.wrapReader
+	if cksum := task.obj.cksum; cksum != nil {
+		wrap = func(r io.ReadCloser) io.ReadCloser { return wrapCksum(task.wrapReader(r), cksum, lom) }
+	}
+	ctx = context.WithValue(ctx, cos.CtxReadWrapper, cos.ReadWrapperFunc(wrap))
 	ctx = context.WithValue(ctx, cos.CtxSetSize, cos.SetSizeFunc(task.setTotalSize))
 	task.getCtx = ctx
 
 	// Do final GET (prefetch) request.
-	_, err := core.T.GetCold(ctx, lom, task.xdl.Kind(), cmn.OwtGetTryLock)
+	if _, err = core.T.GetCold(ctx, lom, task.xdl.Kind(), cmn.OwtGetTryLock); err != nil {
+		return err
+	}
+
+	// NOTE: unlike `_dput`, custom metadata (if any) is added post-commit
+	// (cold GET stores the remote object's own metadata)
+	if md := task.job.metadata(); len(md) > 0 {
+		lom.Lock(true)
+		if err = lom.Load(true /*cache it*/, true /*locked*/); err == nil {
+			setMetadata(lom, md)
+			err = lom.Persist()
+		}
+		lom.Unlock(true)
+	}
 	return err
 }
 
 func (task *singleTask) initialTimeout() time.Duration {
-	config := cmn.GCO.Get()
-	timeout := config.Downloader.Timeout.D()
-	if task.job.Timeout() != 0 {
-		timeout = task.job.Timeout()
+	switch {
+	case task.obj.timeout != 0:
+		return task.obj.timeout // per-object
+	case task.job.Timeout() != 0:
+		return task.job.Timeout()
+	default:
+		return cmn.GCO.Get().Downloader.Timeout.D()
 	}
-	return timeout
 }
 
 func (task *singleTask) wrapReader(r io.ReadCloser) io.ReadCloser {
+	return task.wrapProgress(task.wrapValidator(r))
+}
+
+// Fail the read (and the PUT) upon invalid content.
+func (task *singleTask) wrapValidator(r io.ReadCloser) io.ReadCloser {
+	if v := task.job.validator(); v != nil {
+		r = v.wrap(r)
+	}
+	return r
+}
+
+func (task *singleTask) wrapProgress(r io.ReadCloser) io.ReadCloser {
 	// Create a custom reader to monitor progress every time we read from response body stream.
 	r = &progressReader{
 		r: r,
 		reporter: func(n int64) {
 			task.currentSize.Add(n)
+			task.job.meter().add(n)
 			nl.OnProgress(task.job.Notif())
 		},
 	}
 	// Wrap around throttler reader (noop if throttling is disabled).
 	r = task.job.throttler().wrapReader(task.getCtx, r)
-	return r
+	// Pace reads under the target-wide and the job's own bandwidth limits, if any.
+	return wrapBandwidth(task.getCtx, r, task.xdl.dispatcher.bw, task.job.bandwidth())
 }
 
-// Probably we need to extend the persistent database (db.go) so that it will contain
-// also information about specific tasks.
-func (task *singleTask) markFailed(statusMsg string) {
+func (task *singleTask) markFailed(statusMsg, cause string) {
+	task._markFailed(TaskErrInfo{Err: statusMsg, Cause: cause})
+}
+
+// same as above, with the link's failure classified (see `TaskErrInfo.Class`)
+func (task *singleTask) markLinkFailed(err error, cause string) {
+	task._markFailed(TaskErrInfo{Err: err.Error(), Cause: cause, Class: classifyLinkErr(err), Code: errStatus(err)})
+}
+
+func (task *singleTask) _markFailed(errInfo TaskErrInfo) {
 	core.T.StatsUpdater().Inc(stats.ErrDloadCount)
-	g.store.persistError(task.jobID(), task.obj.objName, statusMsg)
+	errInfo.Name = task.obj.objName
+	if !task.obj.fromRemote {
+		errInfo.Link = redactLink(task.obj.link)
+	}
+	g.store.persistError(task.jobID(), errInfo)
+	task.errInfo = &errInfo
+	state := ObjFailed
+	switch errInfo.Cause {
+	case CauseClientCancel, CauseObjCancel, CauseJobTimeout, CauseShutdown:
+		state = ObjAborted
+	}
+	task.failed = true
+	task.setState(state, errInfo.Err)
 	g.store.incErrorCnt(task.jobID())
+	g.store.incBck(task.jobID(), task.obj.bck, bckError)
+}
+
+// prior to re-dispatching (see `dispatcher.requeue`)
+func (task *singleTask) rewind() {
+	task.abortCause.Store(nil)
+	task.started.Store(time.Time{})
+	task.ended.Store(time.Time{})
+	task.currentSize.Store(0)
+	task.totalSize.Store(0)
+	if task.part != nil {
+		task.dropPartial() // (the workfile is on the mountpath that's being disabled)
+	}
+	task.mu.Lock()
+	task.headers, task.finalURL, task.mirror = nil, "", ""
+	task.mu.Unlock()
+	task.requeued = false
+	task.fl, task.errInfo = nil, nil
+	task.detached.Store(false)
+}
+
+// cancel in-progress download and record the cause
+// (the first one wins: e.g., job abort followed by the downloader stopping);
+// a shared download continues as long as there are other tasks waiting for it (see flight.go)
+func (task *singleTask) abort(cause string) {
+	task.abortCause.CompareAndSwap(nil, &cause)
+	fl := task.fl
+	if fl == nil {
+		task.cancel()
+		return
+	}
+	last := fl.leave(task)
+	switch {
+	case task != fl.leader:
+		task.cancel() // stop waiting
+		if last {
+			fl.leader.cancel()
+		}
+	case last || cause == CauseShutdown || cause == CauseMpathDisabled:
+		task.cancel()
+	default:
+		task.detached.Store(true)
+	}
 }
 
+// why the download failed: aborted, destination mountpath gone, or (when `origin`) the origin itself
+func (task *singleTask) failCause(lom *core.LOM, origin bool) string {
+	// (a detached task fails on its own only when the shared download gets canceled)
+	if cause := task.abortCause.Load(); cause != nil && (!task.detached.Load() || task.downloadCtx.Err() != nil) {
+		return *cause
+	}
+	if mi := lom.Mountpath(); mi != nil {
+		if _, ok := fs.GetAvail()[mi.Path]; !ok {
+			return CauseMpathDisabled
+		}
+	}
+	if origin {
+		return CauseOriginError
+	}
+	return ""
+}
+
+// (the task has run)
 func (task *singleTask) persist() {
 	if err := g.store.persistTaskInfo(task); err != nil {
 		nlog.Errorln(err)
 	}
+	if !task.failed {
+		task.setState(ObjFinished, "")
+	}
+}
+
+// record the object's state in the downloader DB (see `ObjState`)
+func (task *singleTask) setState(state, errMsg string) {
+	st := &ObjState{State: state, Err: errMsg, Size: task.currentSize.Load()}
+	task.mu.Lock()
+	st.Headers, st.Mirror = task.headers, task.mirror
+	task.mu.Unlock()
+	if state != ObjPending && state != ObjRunning {
+		st.EndTime = time.Now()
+	}
+	g.store.setObjState(task.jobID(), task.obj.objName, st)
 }
 
 func (task *singleTask) jobID() string { return task.job.ID() }
 
+func (task *singleTask) bck() *cmn.Bck {
+	if task.obj.bck != nil {
+		return task.obj.bck.Bucket()
+	}
+	return task.job.Bck()
+}
+
+// identifies the destination object - not the link, given mirrors (see `dlObj.mirrors`)
 func (task *singleTask) uid() string {
-	return fmt.Sprintf("%s|%s|%s|%v", task.obj.link, task.job.Bck(), task.obj.objName, task.obj.fromRemote)
+	return fmt.Sprintf("%s|%s|%v", task.bck(), task.obj.objName, task.obj.fromRemote)
 }
 
+// (see `jogger.running`)
+func (task *singleTask) key() string { return task.jobID() + "|" + task.uid() }
+
 func (task *singleTask) ToTaskDlInfo() TaskDlInfo {
-	ended := task.ended.Load()
-	return TaskDlInfo{
+	var (
+		ended = task.ended.Load()
+		src   = task
+	)
+	if fl := task.fl; fl != nil && fl.leader != task && ended.IsZero() {
+		src = fl.leader // following (see flight.go)
+	}
+	info := TaskDlInfo{
 		Name:       task.obj.objName,
-		Downloaded: task.currentSize.Load(),
-		Total:      task.totalSize.Load(),
-		StartTime:  task.started.Load(),
+		Downloaded: src.currentSize.Load(),
+		Total:      src.totalSize.Load(),
+		StartTime:  src.started.Load(),
 		EndTime:    ended,
 	}
+	task.mu.Lock()
+	info.Headers, info.FinalURL, info.Mirror = task.headers, task.finalURL, task.mirror
+	task.mu.Unlock()
+	return info
 }
 
 func (task *singleTask) String() (str string) {
 	return fmt.Sprintf(
 		"{id: %q, obj_name: %q, link: %q, from_remote: %v, bucket: %q}",
-		task.jobID(), task.obj.objName, task.obj.link, task.obj.fromRemote, task.job.Bck(),
+		task.jobID(), task.obj.objName, redactLink(task.obj.link), task.obj.fromRemote, task.bck(),
 	)
 }
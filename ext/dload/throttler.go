@@ -8,9 +8,23 @@ import (
 	"context"
 	"errors"
 	"io"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// charged against `inflight` when the size is not known in advance
+const inflightUnknownSize = 64 * cos.MiB
+
+// bandwidth: burst size is 1/bwBurstDiv of the per-second rate (and no smaller than bwMinBurst)
+const (
+	bwBurstDiv = 10
+	bwMinBurst = 32 * cos.KiB
 )
 
 var errThrottlerStopped = errors.New("throttler has been stopped")
@@ -27,6 +41,26 @@ type (
 		stopCh            *cos.StopCh
 	}
 
+	// target-wide ceiling on the total number of in-flight bytes,
+	// across all jobs (see `DownloaderConf.MaxInflight`)
+	inflight struct {
+		sema  *semaphore.Weighted
+		limit int64
+	}
+
+	// per-bucket cap on the number of concurrently running jobs (see `DownloaderConf.MaxJobsPerBck`);
+	// a finishing job hands its slot over to the next one in line
+	bckQueue struct {
+		active  map[string]int        // bucket => number of running jobs
+		waiting map[string][]*waiting // bucket => jobs waiting for a slot (FIFO)
+		limit   int
+		mu      sync.Mutex
+	}
+	waiting struct {
+		job   jobif
+		dljob *dljob
+	}
+
 	throughputThrottler interface {
 		acquireAllowance(ctx context.Context, n int) error
 	}
@@ -36,6 +70,23 @@ type (
 		ctx context.Context
 		r   io.ReadCloser
 	}
+
+	// bytes-per-second token bucket: target-wide, shared by all joggers (see `DownloaderConf.MaxBandwidth`),
+	// or per job (see `Limits.BytesPerSecond`); tokens go negative ("debt") when a read exceeds
+	// what's available, and the reader then waits it out
+	bandwidth struct {
+		rate   float64 // bytes per second
+		burst  float64 // (and also the max size of a single paced read)
+		tokens float64
+		last   int64 // mono time of the last refill
+		mu     sync.Mutex
+	}
+	bwReader struct {
+		ctx context.Context
+		r   io.ReadCloser
+		bws []*bandwidth
+		max int
+	}
 )
 
 func (t *throttler) init(limits Limits) {
@@ -167,3 +218,229 @@ func (tr *throttledReader) Read(p []byte) (n int, err error) {
 func (tr *throttledReader) Close() (err error) {
 	return tr.r.Close()
 }
+
+///////////////
+// bandwidth //
+///////////////
+
+func newBandwidth(bps int64) *bandwidth {
+	if bps <= 0 {
+		return nil // unlimited
+	}
+	burst := max(float64(bps)/bwBurstDiv, bwMinBurst)
+	return &bandwidth{rate: float64(bps), burst: burst, tokens: burst, last: mono.NanoTime()}
+}
+
+// charge `n` (already received) bytes and return the time to wait before the next read
+func (bw *bandwidth) charge(n int) time.Duration {
+	bw.mu.Lock()
+	now := mono.NanoTime()
+	bw.tokens = min(bw.tokens+bw.rate*float64(now-bw.last)/float64(time.Second), bw.burst)
+	bw.last = now
+	bw.tokens -= float64(n)
+	debt := -bw.tokens
+	bw.mu.Unlock()
+	if debt <= 0 {
+		return 0
+	}
+	return time.Duration(debt / bw.rate * float64(time.Second))
+}
+
+// returns `r` as is when there are no limits
+func wrapBandwidth(ctx context.Context, r io.ReadCloser, bws ...*bandwidth) io.ReadCloser {
+	br := &bwReader{ctx: ctx, r: r}
+	for _, bw := range bws {
+		if bw == nil {
+			continue
+		}
+		br.bws = append(br.bws, bw)
+		if br.max == 0 || int(bw.burst) < br.max {
+			br.max = int(bw.burst)
+		}
+	}
+	if len(br.bws) == 0 {
+		return r
+	}
+	return br
+}
+
+// NOTE: canceling the context (abort, timeout) interrupts the wait
+func (br *bwReader) Read(p []byte) (n int, err error) {
+	if len(p) > br.max {
+		p = p[:br.max]
+	}
+	n, err = br.r.Read(p)
+	if n == 0 {
+		return n, err
+	}
+	var wait time.Duration
+	for _, bw := range br.bws {
+		wait = max(wait, bw.charge(n))
+	}
+	if wait == 0 {
+		return n, err
+	}
+	timer := time.NewTimer(wait)
+	select {
+	case <-timer.C:
+	case <-br.ctx.Done():
+		timer.Stop()
+		if err == nil {
+			err = br.ctx.Err()
+		}
+	}
+	return n, err
+}
+
+func (br *bwReader) Close() error { return br.r.Close() }
+
+//////////////
+// inflight //
+//////////////
+
+func newInflight(limit int64) *inflight {
+	if limit <= 0 {
+		return nil // unlimited
+	}
+	return &inflight{sema: semaphore.NewWeighted(limit), limit: limit}
+}
+
+// blocks until `size` bytes fit under the ceiling or the context is done;
+// returns the number of bytes to release (zero when unlimited)
+func (in *inflight) acquire(ctx context.Context, size int64) (int64, error) {
+	if in == nil {
+		return 0, nil
+	}
+	n := size
+	if n <= 0 {
+		n = inflightUnknownSize
+	}
+	n = min(n, in.limit) // objects larger than the limit still get to run (alone)
+	if err := in.sema.Acquire(ctx, n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (in *inflight) release(n int64) {
+	if n > 0 {
+		in.sema.Release(n)
+	}
+}
+
+//////////////
+// bckQueue //
+//////////////
+
+func newBckQueue(limit int) *bckQueue {
+	if limit <= 0 {
+		return nil // unlimited
+	}
+	return &bckQueue{
+		active:  make(map[string]int, 4),
+		waiting: make(map[string][]*waiting, 4),
+		limit:   limit,
+	}
+}
+
+func _qkey(job jobif) string { return job.Bck().Cname("") }
+
+// takes a slot if available; otherwise, puts the job in line - unless `dljob` is nil
+// (in which case the caller rejects the job)
+func (q *bckQueue) acquire(job jobif, dljob *dljob) (admitted bool) {
+	if q == nil {
+		return true
+	}
+	key := _qkey(job)
+	q.mu.Lock()
+	switch {
+	case q.active[key] < q.limit:
+		q.active[key]++
+		admitted = true
+	case dljob != nil:
+		dljob.queued.Store(true)
+		q.waiting[key] = append(q.waiting[key], &waiting{job: job, dljob: dljob})
+	}
+	q.mu.Unlock()
+	return admitted
+}
+
+// frees the slot or passes it on to the next job in line (returned)
+func (q *bckQueue) release(job jobif) jobif {
+	if q == nil {
+		return nil
+	}
+	key := _qkey(job)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if line := q.waiting[key]; len(line) > 0 {
+		next := line[0]
+		if len(line) == 1 {
+			delete(q.waiting, key)
+		} else {
+			q.waiting[key] = line[1:]
+		}
+		next.dljob.queued.Store(false)
+		return next.job
+	}
+	if q.active[key]--; q.active[key] <= 0 {
+		delete(q.active, key)
+	}
+	return nil
+}
+
+// removes a job from the line; returns nil if the job is not waiting
+func (q *bckQueue) remove(jobID string) jobif {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for key, line := range q.waiting {
+		idx := slices.IndexFunc(line, func(w *waiting) bool { return w.job.ID() == jobID })
+		if idx < 0 {
+			continue
+		}
+		w := line[idx]
+		if len(line) == 1 {
+			delete(q.waiting, key)
+		} else {
+			q.waiting[key] = slices.Delete(line, idx, idx+1)
+		}
+		w.dljob.queued.Store(false)
+		return w.job
+	}
+	return nil
+}
+
+// returns the number of jobs ahead in line and the bucket (empty when the job is not waiting)
+func (q *bckQueue) ahead(jobID string) (n int, bck string) {
+	if q == nil {
+		return 0, ""
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for key, line := range q.waiting {
+		if idx := slices.IndexFunc(line, func(w *waiting) bool { return w.job.ID() == jobID }); idx >= 0 {
+			return idx, key
+		}
+	}
+	return 0, ""
+}
+
+// empties the line (upon stop)
+func (q *bckQueue) drain() (jobs []jobif) {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	for key, line := range q.waiting {
+		for _, w := range line {
+			w.dljob.queued.Store(false)
+			jobs = append(jobs, w.job)
+		}
+		delete(q.waiting, key)
+	}
+	q.mu.Unlock()
+	return jobs
+}
@@ -0,0 +1,62 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBandwidthPacing(t *testing.T) {
+	const (
+		rate = 256 * cos.KiB
+		size = 128 * cos.KiB
+	)
+	bw := newBandwidth(rate)
+	rc := io.NopCloser(bytes.NewReader(nil))
+	tassert.Fatalf(t, wrapBandwidth(context.Background(), rc, nil, nil) == rc, "expected no wrapping when unlimited")
+
+	// two readers sharing the same (target-wide) bucket
+	var (
+		started = time.Now()
+		errCh   = make(chan error, 2)
+	)
+	for range 2 {
+		go func() {
+			r := wrapBandwidth(context.Background(), io.NopCloser(bytes.NewReader(make([]byte, size))), bw, nil)
+			n, err := io.Copy(io.Discard, r)
+			if err == nil && n != size {
+				err = io.ErrShortWrite
+			}
+			errCh <- err
+		}()
+	}
+	for range 2 {
+		tassert.CheckFatal(t, <-errCh)
+	}
+	// 2*size at `rate`, less the initial burst
+	expected := time.Duration(float64(2*size-bw.burst) / rate * float64(time.Second))
+	elapsed := time.Since(started)
+	tassert.Errorf(t, elapsed >= expected*9/10, "too fast: %v (expected at least %v)", elapsed, expected)
+}
+
+func TestBandwidthCancel(t *testing.T) {
+	bw := newBandwidth(bwMinBurst) // ~1 burst per second
+	ctx, cancel := context.WithCancel(context.Background())
+	r := wrapBandwidth(ctx, io.NopCloser(bytes.NewReader(make([]byte, 64*cos.MiB))), bw)
+
+	time.AfterFunc(100*time.Millisecond, cancel)
+	started := time.Now()
+	_, err := io.Copy(io.Discard, r)
+	tassert.Errorf(t, errors.Is(err, context.Canceled), "expected context canceled, got %v", err)
+	tassert.Errorf(t, time.Since(started) < 5*time.Second, "cancel took too long: %v", time.Since(started))
+}
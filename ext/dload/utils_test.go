@@ -5,6 +5,9 @@
 package dload_test
 
 import (
+	"bytes"
+	"compress/gzip"
+	"strings"
 	"testing"
 	"time"
 
@@ -44,6 +47,139 @@ func TestNormalizeObjName(t *testing.T) {
 	}
 }
 
+func TestNameRule(t *testing.T) {
+	tests := []struct {
+		rule     dload.NameRule
+		objName  string
+		expected string
+	}{
+		{dload.NameRule{StripPrefix: "raw-"}, "raw-file.tar", "file.tar"},
+		{dload.NameRule{StripPrefix: "raw-"}, "file.tar", "file.tar"},
+		{dload.NameRule{Lowercase: true}, "File.TAR", "file.tar"},
+		{dload.NameRule{Regex: "_", Replace: "/"}, "a_b_c.tar", "a/b/c.tar"},
+		{dload.NameRule{Regex: `^shard-(\d+)\.tgz$`, Replace: "${1}.tgz"}, "shard-0042.tgz", "0042.tgz"},
+		{dload.NameRule{StripPrefix: "X-", Regex: "-", Replace: "_", Lowercase: true}, "X-Some-File", "some_file"},
+	}
+	for _, test := range tests {
+		tassert.CheckFatal(t, test.rule.Validate())
+		actual, err := test.rule.Apply(test.objName)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, actual == test.expected, "%+v: %q => expected %q, got %q", test.rule, test.objName, test.expected, actual)
+	}
+
+	// invalid rules and results
+	rule := &dload.NameRule{Regex: "(["}
+	tassert.Errorf(t, rule.Validate() != nil, "expected invalid regex to fail validation")
+	rule = &dload.NameRule{Replace: "x"}
+	tassert.Errorf(t, rule.Validate() != nil, "expected replace without regex to fail validation")
+	rule = &dload.NameRule{StripPrefix: "file"}
+	tassert.CheckFatal(t, rule.Validate())
+	_, err := rule.Apply("file")
+	tassert.Errorf(t, err != nil, "expected empty object name to fail")
+
+	// collisions
+	body := &dload.MultiBody{
+		Base:           dload.Base{Bck: cmn.Bck{Name: "bck"}, NameRule: &dload.NameRule{Lowercase: true}},
+		ObjectsPayload: []any{"http://a.com/File", "http://b.com/file"},
+	}
+	tassert.CheckFatal(t, body.Validate())
+	_, err = body.ExtractPayload()
+	tassert.Errorf(t, err != nil, "expected name collision error")
+}
+
+func TestCksumManifestValidate(t *testing.T) {
+	m := &dload.CksumManifest{URL: "https://example.com/data/SHA256SUMS"}
+	tassert.CheckFatal(t, m.Validate())
+	tassert.Errorf(t, m.Type == cos.ChecksumSHA256, "expected default type %q, got %q", cos.ChecksumSHA256, m.Type)
+
+	m = &dload.CksumManifest{URL: "example.com/MD5SUMS", Type: cos.ChecksumMD5, Strict: true}
+	tassert.CheckFatal(t, m.Validate())
+
+	for _, m := range []*dload.CksumManifest{
+		{},
+		{URL: "https://example.com/SUMS", Type: "sha1"},
+		{URL: "https://example.com/SUMS", Type: cos.ChecksumNone},
+	} {
+		tassert.Errorf(t, m.Validate() != nil, "expected %+v to fail validation", m)
+	}
+
+	// nil-safe
+	var nilm *dload.CksumManifest
+	tassert.CheckFatal(t, nilm.Validate())
+}
+
+func TestRangeParseTemplate(t *testing.T) {
+	const tmpl = "https://example.com/shard-{0000..0999}.tar"
+	b := &dload.RangeBody{Template: tmpl}
+	pt, err := b.ParseTemplate(cmn.DfltDloadMaxRange)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, pt.Count() == 1000, "expected 1000, got %d", pt.Count())
+
+	b.ExpectedCount = 1000
+	_, err = b.ParseTemplate(cmn.DfltDloadMaxRange)
+	tassert.CheckFatal(t, err)
+
+	b.ExpectedCount = 100
+	_, err = b.ParseTemplate(cmn.DfltDloadMaxRange)
+	tassert.Errorf(t, err != nil, "expected count mismatch error")
+
+	b.ExpectedCount = 0
+	_, err = b.ParseTemplate(999)
+	tassert.Errorf(t, err != nil, "expected max-range error")
+}
+
+func TestStartAfter(t *testing.T) {
+	now := time.Now()
+	at, err := dload.ParseStartAfter("6h", now)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, at.Equal(now.Add(6*time.Hour)), "unexpected start time %v", at)
+
+	for _, s := range []string{"-1h", "tomorrow", now.Add(-time.Minute).Format(time.RFC3339)} {
+		_, err := dload.ParseStartAfter(s, now)
+		tassert.Errorf(t, err != nil, "expected %q to fail", s)
+	}
+
+	b := &dload.Base{Bck: cmn.Bck{Name: "b"}, StartAfter: "2h", Deadline: "1h"}
+	tassert.Errorf(t, b.Validate() != nil, "expected deadline preceding start to fail validation")
+	b.Deadline = "3h"
+	tassert.CheckFatal(t, b.Validate())
+}
+
+func TestReadRequest(t *testing.T) {
+	const body = `{"bucket": {"name": "b"}, "objects": {"a": "https://example.com/a"}}`
+	b, err := dload.ReadRequest(strings.NewReader(body), "", int64(len(body)))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == body, "plain: content mismatch")
+
+	var zbuf bytes.Buffer
+	zw := gzip.NewWriter(&zbuf)
+	zw.Write([]byte(body))
+	tassert.CheckFatal(t, zw.Close())
+	b, err = dload.ReadRequest(bytes.NewReader(zbuf.Bytes()), "gzip", int64(zbuf.Len()))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == body, "gzip: content mismatch")
+
+	_, err = dload.ReadRequest(strings.NewReader(body), "gzip", int64(len(body)))
+	tassert.Errorf(t, err != nil, "expected invalid gzip to fail")
+	_, err = dload.ReadRequest(strings.NewReader(body), "br", int64(len(body)))
+	tassert.Errorf(t, err != nil, "expected unsupported encoding to fail")
+}
+
+func TestReadRequestMaxSize(t *testing.T) {
+	tools.ShortSkipf(t)
+	var (
+		zbuf  bytes.Buffer
+		zw    = gzip.NewWriter(&zbuf)
+		chunk = make([]byte, cos.MiB)
+	)
+	for range 257 {
+		zw.Write(chunk)
+	}
+	tassert.CheckFatal(t, zw.Close())
+	_, err := dload.ReadRequest(&zbuf, "gzip", int64(zbuf.Len()))
+	tassert.Errorf(t, err != nil, "expected decompressed size limit to be enforced")
+}
+
 func TestCompareObject(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 	var (
@@ -51,12 +187,9 @@ func TestCompareObject(t *testing.T) {
 		dst = &dload.DstElement{
 			Link: "https://storage.googleapis.com/minikube/iso/minikube-v0.23.2.iso.sha256",
 		}
-		clientConf cmn.ClientConf
 	)
 	// initialize http clients
-	clientConf.Timeout = 5 * cos.Duration(time.Second)
-	clientConf.TimeoutLong = 15 * cos.Duration(time.Second)
-	dload.Init(nil, &clientConf)
+	dload.Init(nil)
 
 	// Modify local object to contain invalid (meta)data.
 	customMD := cos.StrKVs{
@@ -0,0 +1,170 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Content validation (see `Base.Validator`): the validator wraps the response body and fails
+// the read (and, therefore, the PUT) - so that invalid content never gets committed.
+// To add a validator, implement the interface and register it in `validators` below.
+
+// bytes sniffed by ValidatorNotHTML (same as `http.DetectContentType`)
+const sniffLen = 512
+
+var errValidatorClosed = errors.New("validated reader closed")
+
+type (
+	validator interface {
+		wrap(r io.ReadCloser) io.ReadCloser
+	}
+
+	notHTML   struct{}
+	validJSON struct{}
+
+	// ValidatorNotHTML
+	sniffReader struct {
+		r       io.ReadCloser
+		br      *bufio.Reader
+		checked bool
+	}
+	// ValidatorJSON
+	jsonReader struct {
+		r     io.ReadCloser
+		pw    *io.PipeWriter
+		errCh chan error
+		err   error
+		done  bool
+	}
+)
+
+var validators = map[string]validator{
+	ValidatorNotHTML: notHTML{},
+	ValidatorJSON:    validJSON{},
+}
+
+func validatorNames() []string {
+	return []string{ValidatorNotHTML, ValidatorJSON}
+}
+
+func newValidator(name string) validator {
+	if name == "" {
+		return nil
+	}
+	return validators[name]
+}
+
+//
+// not-html: sniff the first `sniffLen` bytes
+//
+
+func (notHTML) wrap(r io.ReadCloser) io.ReadCloser {
+	return &sniffReader{r: r, br: bufio.NewReaderSize(r, sniffLen)}
+}
+
+func (sr *sniffReader) Read(b []byte) (int, error) {
+	if !sr.checked {
+		sr.checked = true
+		buf, err := sr.br.Peek(sniffLen)
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		if ct := http.DetectContentType(buf); strings.HasPrefix(ct, "text/html") {
+			return 0, fmt.Errorf("content validation (%s) failed: detected %q", ValidatorNotHTML, ct)
+		}
+	}
+	return sr.br.Read(b)
+}
+
+func (sr *sniffReader) Close() error { return sr.r.Close() }
+
+//
+// json: stream the content through a decoder; a sequence of JSON values (e.g., JSON Lines) is valid
+//
+
+func (validJSON) wrap(r io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	jr := &jsonReader{r: r, pw: pw, errCh: make(chan error, 1)}
+	go func() {
+		err := decodeJSON(pr)
+		if err != nil {
+			err = fmt.Errorf("content validation (%s) failed: %w", ValidatorJSON, err)
+		}
+		pr.CloseWithError(err) // unblocks the writer (nil error => io.ErrClosedPipe)
+		jr.errCh <- err
+	}()
+	return jr
+}
+
+func decodeJSON(r io.Reader) error {
+	var (
+		dec   = json.NewDecoder(r)
+		depth int
+		cnt   int
+	)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			switch {
+			case depth > 0:
+				return io.ErrUnexpectedEOF
+			case cnt == 0:
+				return errors.New("empty content")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		cnt++
+		if delim, ok := tok.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+			default:
+				depth--
+			}
+		}
+	}
+}
+
+func (jr *jsonReader) Read(b []byte) (n int, err error) {
+	if jr.done {
+		return 0, jr.err
+	}
+	n, err = jr.r.Read(b)
+	if n > 0 {
+		if _, errW := jr.pw.Write(b[:n]); errW != nil {
+			return n, jr.finish(errW)
+		}
+	}
+	if err == io.EOF {
+		jr.pw.Close()
+		return n, jr.finish(io.EOF)
+	}
+	return n, err
+}
+
+// returns the decoder's error, if any, in place of `err`
+func (jr *jsonReader) finish(err error) error {
+	jr.done = true
+	if errV := <-jr.errCh; errV != nil {
+		err = errV
+	}
+	jr.err = err
+	return err
+}
+
+func (jr *jsonReader) Close() error {
+	jr.pw.CloseWithError(errValidatorClosed)
+	return jr.r.Close()
+}
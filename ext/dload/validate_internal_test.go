@@ -0,0 +1,138 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func readValidated(name, content string) (string, error) {
+	r := newValidator(name).wrap(io.NopCloser(strings.NewReader(content)))
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	return string(b), err
+}
+
+func TestValidatorNotHTML(t *testing.T) {
+	tests := []struct {
+		content string
+		valid   bool
+	}{
+		{"", true},
+		{"plain text", true},
+		{"\x00\x01\x02binary", true},
+		{`{"a": 1}`, true},
+		{"<!DOCTYPE html><html><body>Not Found</body></html>", false},
+		{"\n  <html><head><title>Login</title></head></html>", false},
+		{strings.Repeat("x", 2*sniffLen) + "<html>", true},
+	}
+	for _, test := range tests {
+		out, err := readValidated(ValidatorNotHTML, test.content)
+		if test.valid {
+			tassert.Errorf(t, err == nil, "%.32q: unexpected error %v", test.content, err)
+			tassert.Errorf(t, out == test.content, "%.32q: content mismatch", test.content)
+		} else {
+			tassert.Errorf(t, err != nil, "%.32q: expected validation error", test.content)
+		}
+	}
+}
+
+func TestValidatorJSON(t *testing.T) {
+	tests := []struct {
+		content string
+		valid   bool
+	}{
+		{`{"a": [1, 2, {"b": null}], "c": "d"}`, true},
+		{`[]`, true},
+		{"{\"a\": 1}\n{\"a\": 2}\n", true}, // JSON Lines
+		{`{"a": "` + strings.Repeat("x", 256*1024) + `"}`, true},
+		{"", false},
+		{`{"a": 1`, false},
+		{`{"a" 1}`, false},
+		{`{"a": "b`, false},
+		{"<html><body>Not Found</body></html>", false},
+		{`{"a": 1} trailing`, false},
+	}
+	for _, test := range tests {
+		out, err := readValidated(ValidatorJSON, test.content)
+		if test.valid {
+			tassert.Errorf(t, err == nil, "%.32q: unexpected error %v", test.content, err)
+			tassert.Errorf(t, out == test.content, "%.32q: content mismatch", test.content)
+		} else {
+			tassert.Errorf(t, err != nil, "%.32q: expected validation error", test.content)
+		}
+	}
+}
+
+func TestValidatorClose(t *testing.T) {
+	// closing prior to EOF must not block (nor leak the decoder)
+	r := newValidator(ValidatorJSON).wrap(io.NopCloser(strings.NewReader(`[1, 2, 3]`)))
+	buf := make([]byte, 2)
+	_, err := r.Read(buf)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, r.Close())
+}
+
+func TestValidateBase(t *testing.T) {
+	base := &Base{Validator: "xml"}
+	base.Bck.Name = "bck"
+	tassert.Errorf(t, base.Validate() != nil, "expected unknown validator to fail")
+
+	base.Validator = ValidatorJSON
+	tassert.CheckError(t, base.Validate())
+
+	base.Extract = true
+	tassert.Errorf(t, base.Validate() != nil, "expected %q with extract to fail", ValidatorJSON)
+
+	base.Validator = ValidatorNotHTML
+	tassert.CheckError(t, base.Validate())
+
+	base.Extract, base.HeadMode = false, HeadModeOnly
+	tassert.Errorf(t, base.Validate() != nil, "expected validator with head_mode %q to fail", HeadModeOnly)
+
+	base.Validator, base.HeadMode = "", ""
+	base.Priority = -MaxPriority
+	tassert.CheckError(t, base.Validate())
+	base.Priority = MaxPriority + 1
+	tassert.Errorf(t, base.Validate() != nil, "expected out-of-range priority to fail")
+}
+
+func TestValidateAdminObjName(t *testing.T) {
+	b := &AdminBody{ObjName: "a/b"}
+	tassert.Errorf(t, b.Validate(false) != nil, "expected 'objname' without job ID to fail")
+	b.ID = "5JjIuGemR"
+	tassert.CheckError(t, b.Validate(true))
+}
+
+func TestValidateMetadata(t *testing.T) {
+	tests := []struct {
+		md    cos.StrKVs
+		valid bool
+	}{
+		{nil, true},
+		{cos.StrKVs{"dataset": "imagenet", "version": "2"}, false}, // "version" is reserved
+		{cos.StrKVs{"dataset": "imagenet", "dataset_version": "2"}, true},
+		{cos.StrKVs{"": "x"}, false},
+		{cos.StrKVs{"a=b": "x"}, false},
+		{cos.StrKVs{" a": "x"}, false},
+		{cos.StrKVs{cmn.SourceObjMD: "x"}, false},
+		{cos.StrKVs{strings.Repeat("k", maxMetadataKeyLen+1): "x"}, false},
+		{cos.StrKVs{"k": strings.Repeat("v", maxMetadataValLen+1)}, false},
+	}
+	for _, test := range tests {
+		err := validateMetadata(test.md)
+		if test.valid {
+			tassert.Errorf(t, err == nil, "%v: unexpected error %v", test.md, err)
+		} else {
+			tassert.Errorf(t, err != nil, "%v: expected error", test.md)
+		}
+	}
+}
@@ -5,6 +5,7 @@
 package dload
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"regexp"
@@ -143,6 +144,7 @@ type (
 		regex      *regexp.Regexp // regex of descriptions to return if id is empty
 		response   *response      // where the outcome of the request is written
 		onlyActive bool           // request status of only active tasks
+		objName    string         // abort a single object of the job (see `AdminBody.ObjName`)
 	}
 
 	progressReader struct {
@@ -215,21 +217,106 @@ func (xld *Xact) Download(job jobif) (resp any, statusCode int, err error) {
 	xld.IncPending()
 	defer xld.DecPending()
 
+	var (
+		d        = xld.dispatcher
+		admitted bool
+	)
+	// reject right away (see `DownloaderConf.RejectOverCap`)
+	if d.bckq != nil && d.config.Downloader.RejectOverCap && time.Until(job.startAfter()) <= 0 {
+		if !d.bckq.acquire(job, nil) {
+			return fmt.Sprintf("bucket %s: too many running download jobs (max %d)", job.Bck().Cname(""), d.bckq.limit),
+				http.StatusTooManyRequests, nil
+		}
+		admitted = true
+	}
+
 	dljob := g.store.setJob(job)
+	if mj, ok := job.(*multiDlJob); ok && mj.app != nil {
+		d.openJob(mj)
+	}
+	if dljob.scheduled.Load() {
+		d.schedule(job)
+		return dljob.id, http.StatusOK, nil
+	}
+	if !admitted && !d.admit(job, dljob) {
+		return dljob.id, http.StatusOK, nil // queued
+	}
 
 	select {
-	case xld.dispatcher.workCh <- job:
+	case d.workCh <- job:
 		return dljob.id, http.StatusOK, nil // TODO -- FIXME: dljob.clone() all the way to client (+below)
 	default:
 		select {
-		case xld.dispatcher.workCh <- job:
+		case d.workCh <- job:
 			return dljob.id, http.StatusOK, nil
 		case <-time.After(cmn.Rom.CplaneOperation()):
+			d.release(job)
+			d.closeJob(job.ID())
 			return "downloader job queue is full", http.StatusTooManyRequests, nil
 		}
 	}
 }
 
+// append the next page of objects to an open job (see `MultiBody.Append`)
+func (xld *Xact) AppendJob(id string, bck *meta.Bck, payload *MultiBody) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	defer xld.DecPending()
+
+	j := xld.dispatcher.openedJob(id)
+	if j == nil {
+		return nil, http.StatusNotFound, fmt.Errorf("download job %q not found or not open for appending", id)
+	}
+	if !j.bck.Equal(bck, false /*sameID*/, false /*sameBackend*/) {
+		return nil, http.StatusBadRequest, fmt.Errorf("%s: cannot append objects to a different bucket %s", j, bck.Cname(""))
+	}
+	objects, err := payload.ExtractPayload()
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	bcks, err := objBcks(j.bck, payload.ObjProviders)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	objs, err := buildDlObjs(j.bck, objects, bcks)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if len(payload.ObjTimeouts) > 0 {
+		if err := setTimeouts(objs, payload.ObjTimeouts); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+	if len(payload.ObjMirrors) > 0 {
+		if err := setMirrors(objs, payload.ObjMirrors); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+	if len(payload.ObjChecksums) > 0 {
+		if err := setCksums(objs, payload.ObjChecksums); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+	if len(payload.ObjHeaders) > 0 {
+		if err := setHeaders(objs, payload.ObjHeaders); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+	var expected int64
+	if payload.ValidateLinks {
+		if expected, err = j.preflight(objs); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+	total, err := j.append(objs, payload.Seal)
+	if err != nil {
+		return nil, http.StatusConflict, err
+	}
+	g.store.growTotal(id, total)
+	g.store.addExpected(id, expected)
+	xld.dispatcher.statusCache.del(id)
+	return id, http.StatusOK, nil
+}
+
 func (xld *Xact) AbortJob(id string) (resp any, statusCode int, err error) {
 	xld.IncPending()
 	req := &request{action: actAbort, id: id}
@@ -238,6 +325,26 @@ func (xld *Xact) AbortJob(id string) (resp any, statusCode int, err error) {
 	return
 }
 
+// mountpath enabled or attached: start downloading to it
+func (xld *Xact) ReqEnableMountpath(mpath string) {
+	xld.dispatcher.mpathReq(apc.ActMountpathEnable, mpath)
+}
+
+// mountpath being disabled or detached: stop downloading to it
+// (objects in flight and pending get re-dispatched to other mountpaths)
+func (xld *Xact) ReqDisableMountpath(mpath string) {
+	xld.dispatcher.mpathReq(apc.ActMountpathDisable, mpath)
+}
+
+// cancel a single object, while the rest of the job continues
+func (xld *Xact) AbortObj(id, objName string) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actAbort, id: id, objName: objName}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
 func (xld *Xact) RemoveJob(id string) (resp any, statusCode int, err error) {
 	xld.IncPending()
 	req := &request{action: actRemove, id: id}
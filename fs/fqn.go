@@ -36,6 +36,8 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileXdev         = "xdev"           // finalizing workfile that resides on a different filesystem
+	WorkfileDlPartial    = "dl-partial"     // resumable download: content received so far
 )
 
 type ParsedFQN struct {
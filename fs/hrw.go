@@ -16,6 +16,7 @@ import (
 // aka highest random weight (HRW)
 // See also: core/meta/hrw.go
 
+// NOTE: must remain consistent with meta.MpathHrw (the same selection given the same mountpaths)
 func Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
 	var (
 		maxH  uint64
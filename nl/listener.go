@@ -5,9 +5,13 @@
 package nl
 
 import (
+	"errors"
+	"maps"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -32,12 +36,16 @@ type Listener interface {
 	Cause() string
 	Bcks() []*cmn.Bck
 	AddErr(error)
+	AddNodeErr(daeID string, err error)
+	NodeErrs() cos.StrKVs
 	Err() error
 	ErrCnt() int
 	UUID() string
 	SetAborted()
 	Aborted() bool
+	Trail() *Trail // (copy)
 	Status() *Status
+	Describe() string // human-readable progress synthesized from node stats (caller must hold rlock)
 	SetStats(daeID string, stats any)
 	NodeStats() *NodeStats
 	QueryArgs() cmn.HreqArgs
@@ -51,6 +59,12 @@ type Listener interface {
 	SetOwner(string)
 	LastUpdated(*meta.Snode) int64
 	ProgressInterval() time.Duration
+	Paused() bool
+	SetPaused(bool) (changed bool)
+	TTL() time.Duration
+	SetTTL(time.Duration)
+	Rate() (float64, time.Duration) // progress rate (units per second) and ETA; zero(s) when unknown
+	Pct() (float64, bool)           // cluster-wide progress in percent, approximate (caller must hold rlock)
 
 	// detailed ref-counting
 	ActiveNotifiers() meta.NodeMap
@@ -58,12 +72,22 @@ type Listener interface {
 	ActiveCount() int
 	HasFinished(*meta.Snode) bool
 	MarkFinished(*meta.Snode)
+	Merge(other Listener) (added int)
+	MarkStarted(node *meta.Snode, ts int64) (first bool)
+	OnStarted(nl Listener)
+	MarkFirstErr() (first bool)
+	OnFirstErr(nl Listener, daeID string, err error)
+	StartTime() int64
+	NodeStartTime(*meta.Snode) int64
 	NodesTardy(periodicNotifTime time.Duration) (nodes meta.NodeMap, tardy bool)
 }
 
 type (
 	Callback func(n Listener)
 
+	// (daeID: the node that reported the error)
+	ErrCallback func(n Listener, daeID string, err error)
+
 	NodeStats struct {
 		stats map[string]any // daeID => Stats (e.g. cmn.SnapExt)
 		sync.RWMutex
@@ -74,8 +98,11 @@ type (
 		Srcs        meta.NodeMap     // all notifiers
 		ActiveSrcs  meta.NodeMap     // running notifiers
 		F           Callback         `json:"-"` // optional listening-side callback
+		FS          Callback         `json:"-"` // optional listening-side callback when the first notifier starts
+		FE          ErrCallback      `json:"-"` // optional listening-side callback upon the first error (the job may continue)
 		Stats       *NodeStats       // [daeID => Stats (e.g. cmn.SnapExt)]
 		lastUpdated map[string]int64 // [daeID => last update time(nanoseconds)]
+		startedAt   map[string]int64 // [daeID => time started (nanoseconds)]
 
 		Common struct {
 			UUID  string
@@ -85,24 +112,51 @@ type (
 			Bck   []*cmn.Bck
 		}
 
-		errs      cos.Errs      // reported error and count
-		progress  time.Duration // time interval to monitor the progress
-		addedTime atomic.Int64  // Time when `nl` is added
+		NodeErrsX cos.StrKVs // [daeID => error] per-node attribution (unlike `errs`, survives failover)
+		TrailX    *Trail     // state transitions (see trail.go)
+
+		errs      cos.Errs                  // reported error and count
+		progress  time.Duration             // time interval to monitor the progress
+		addedTime atomic.Int64              // Time when `nl` is added
+		paused    atomic.Bool               // skip periodic stats sync (in-memory, not replicated)
+		ttl       time.Duration             // expire if not started within (since added); zero: never
+		hadErr    bool                      // first error already seen (under lock; see `MarkFirstErr`)
+		rate      ratomic.Pointer[rateRing] // recent progress samples (see `Rate`)
 
 		// runtime
-		EndTimeX atomic.Int64 // timestamp when finished
-		mu       sync.RWMutex
-		AbortedX atomic.Bool // sets if the xaction is Aborted
+		StartTimeX atomic.Int64 // timestamp when the first notifier started (zero: submitted but not yet running)
+		EndTimeX   atomic.Int64 // timestamp when finished
+		mu         sync.RWMutex
+		nerrMu     sync.Mutex  // protects NodeErrsX
+		AbortedX   atomic.Bool // sets if the xaction is Aborted
 	}
 
 	Status struct {
-		Kind     string `json:"kind"`     // xaction kind
-		UUID     string `json:"uuid"`     // xaction UUID
-		ErrMsg   string `json:"err"`      // error
-		EndTimeX int64  `json:"end_time"` // time xaction ended
-		AbortedX bool   `json:"aborted"`  // true if aborted
+		NodeErrs   cos.StrKVs `json:"node_errs,omitempty"`  // per-node errors (daeID => error)
+		Kind       string     `json:"kind"`                 // xaction kind
+		UUID       string     `json:"uuid"`                 // xaction UUID
+		ErrMsg     string     `json:"err"`                  // error
+		Desc       string     `json:"desc,omitempty"`       // human-readable progress (see `Listener.Describe`)
+		Rate       float64    `json:"rate,omitempty"`       // progress rate, e.g. objects per second (see `Listener.Rate`)
+		ETA        int64      `json:"eta,omitempty"`        // estimated time to completion (nanoseconds; when total is known)
+		Pct        float64    `json:"pct,omitempty"`        // cluster-wide progress in percent (see `Listener.Pct`; zero when unknown)
+		PctApprox  bool       `json:"pct_approx,omitempty"` // some of the nodes did not report their totals
+		StartTimeX int64      `json:"start_time,omitempty"` // time xaction started running (see `Started` notification)
+		EndTimeX   int64      `json:"end_time"`             // time xaction ended
+		AbortedX   bool       `json:"aborted"`              // true if aborted
+		Trail      *Trail     `json:"trail,omitempty"`      // state transitions (see `ListenerBase.Trail`)
 	}
 	StatusVec []Status
+
+	// per-kind counts of running and (recently) finished jobs - a cluster-jobs overview
+	// (compare with `Status` above)
+	KindSummary struct {
+		Running  int   `json:"running"`
+		Finished int   `json:"finished"`           // including failed
+		Failed   int   `json:"failed"`             // finished with error(s) or aborted
+		LastEnd  int64 `json:"last_end,omitempty"` // most recent end time (Unix nano)
+	}
+	Summary map[string]*KindSummary // [kind => summary]
 )
 
 //////////////////
@@ -116,6 +170,7 @@ func NewNLB(uuid, action, cause string, srcs meta.NodeMap, progress time.Duratio
 		errs:        cos.NewErrs(),
 		progress:    progress,
 		lastUpdated: make(map[string]int64, len(srcs)),
+		TrailX:      newTrail(),
 	}
 	nlb.Common.UUID = uuid
 	nlb.Common.Kind = action
@@ -133,7 +188,6 @@ func (nlb *ListenerBase) RUnlock() { nlb.mu.RUnlock() }
 func (nlb *ListenerBase) Notifiers() meta.NodeMap         { return nlb.Srcs }
 func (nlb *ListenerBase) UUID() string                    { return nlb.Common.UUID }
 func (nlb *ListenerBase) Aborted() bool                   { return nlb.AbortedX.Load() }
-func (nlb *ListenerBase) SetAborted()                     { nlb.AbortedX.CAS(false, true) }
 func (nlb *ListenerBase) EndTime() int64                  { return nlb.EndTimeX.Load() }
 func (nlb *ListenerBase) Finished() bool                  { return nlb.EndTime() > 0 }
 func (nlb *ListenerBase) ProgressInterval() time.Duration { return nlb.progress }
@@ -144,13 +198,32 @@ func (nlb *ListenerBase) Kind() string                    { return nlb.Common.Ki
 func (nlb *ListenerBase) Cause() string                   { return nlb.Common.Cause }
 func (nlb *ListenerBase) Bcks() []*cmn.Bck                { return nlb.Common.Bck }
 func (nlb *ListenerBase) AddedTime() int64                { return nlb.addedTime.Load() }
-func (nlb *ListenerBase) SetAddedTime()                   { nlb.addedTime.Store(mono.NanoTime()) }
+func (nlb *ListenerBase) Paused() bool                    { return nlb.paused.Load() }
+func (nlb *ListenerBase) SetPaused(v bool) bool           { return nlb.paused.CAS(!v, v) }
+func (nlb *ListenerBase) TTL() time.Duration              { return nlb.ttl }
+func (nlb *ListenerBase) SetTTL(ttl time.Duration)        { nlb.ttl = ttl }
 
 func (nlb *ListenerBase) ActiveNotifiers() meta.NodeMap { return nlb.ActiveSrcs }
 func (nlb *ListenerBase) ActiveCount() int              { return len(nlb.ActiveSrcs) }
 func (nlb *ListenerBase) FinCount() int                 { return len(nlb.Srcs) - nlb.ActiveCount() }
 
+func (nlb *ListenerBase) Trail() *Trail { return nlb.TrailX.Snap() }
+
+func (nlb *ListenerBase) SetAddedTime() {
+	nlb.addedTime.Store(mono.NanoTime())
+	nlb.TrailX.add(EvAdded, "", time.Now().UnixNano())
+}
+
+func (nlb *ListenerBase) SetAborted() {
+	if nlb.AbortedX.CAS(false, true) {
+		nlb.TrailX.add(EvAborted, "", time.Now().UnixNano())
+	}
+}
+
 func (nlb *ListenerBase) MarkFinished(node *meta.Snode) {
+	if _, ok := nlb.ActiveSrcs[node.ID()]; ok {
+		nlb.TrailX.add(EvFinished, node.ID(), time.Now().UnixNano())
+	}
 	delete(nlb.ActiveSrcs, node.ID())
 }
 
@@ -158,10 +231,86 @@ func (nlb *ListenerBase) HasFinished(node *meta.Snode) bool {
 	return !nlb.ActiveSrcs.Contains(node.ID())
 }
 
+// re-registration (same UUID): unions the notifiers - those that have joined the job since - and
+// refreshes mutable fields; a finished listener stays finished
+// (the caller must hold the lock)
+func (nlb *ListenerBase) Merge(other Listener) (added int) {
+	if nlb.Finished() {
+		return 0
+	}
+	active := other.ActiveNotifiers()
+	for id, si := range other.Notifiers() {
+		if nlb.Srcs.Contains(id) {
+			continue
+		}
+		if added == 0 {
+			nlb.Srcs = maps.Clone(nlb.Srcs) // (not to modify the map passed to `NewNLB`)
+		}
+		nlb.Srcs[id] = si
+		if active.Contains(id) {
+			nlb.ActiveSrcs[id] = si
+		}
+		added++
+	}
+	if progress := other.ProgressInterval(); progress > 0 {
+		nlb.progress = progress
+	}
+	if ttl := other.TTL(); ttl > 0 {
+		nlb.ttl = ttl
+	}
+	return added
+}
+
+// records the time a given notifier started; returns true if it is the first one
+// (the caller must hold the lock)
+func (nlb *ListenerBase) MarkStarted(node *meta.Snode, ts int64) bool {
+	if nlb.startedAt == nil {
+		nlb.startedAt = make(map[string]int64, len(nlb.Srcs))
+	}
+	if _, ok := nlb.startedAt[node.ID()]; !ok {
+		nlb.startedAt[node.ID()] = ts
+	}
+	if !nlb.StartTimeX.CAS(0, ts) {
+		return false
+	}
+	nlb.TrailX.add(EvStarted, node.ID(), ts)
+	return true
+}
+
+// is called once, when the first notifier reports `Started`
+func (nlb *ListenerBase) OnStarted(nl Listener) {
+	if nlb.FS != nil {
+		nlb.FS(nl)
+	}
+}
+
+// returns true once, upon the transition from no errors to the first error
+// (the caller must hold the lock)
+func (nlb *ListenerBase) MarkFirstErr() bool {
+	if nlb.hadErr || nlb.ErrCnt() == 0 {
+		return false
+	}
+	nlb.hadErr = true
+	return true
+}
+
+// is called once, when the first error gets reported - typically, long before the job finishes
+func (nlb *ListenerBase) OnFirstErr(nl Listener, daeID string, err error) {
+	if nlb.FE != nil {
+		nlb.FE(nl, daeID, err)
+	}
+}
+
+func (nlb *ListenerBase) StartTime() int64 { return nlb.StartTimeX.Load() }
+
+// under rlock
+func (nlb *ListenerBase) NodeStartTime(si *meta.Snode) int64 { return nlb.startedAt[si.ID()] }
+
 // is called after all Notifiers will have notified OR on failure (err != nil)
 func (nlb *ListenerBase) Callback(nl Listener, ts int64) {
 	if nlb.EndTimeX.CAS(0, 1) {
 		nlb.EndTimeX.Store(ts)
+		nlb.TrailX.add(EvDone, "", ts)
 		if nlb.F != nil {
 			nlb.F(nl)
 		}
@@ -171,13 +320,62 @@ func (nlb *ListenerBase) Callback(nl Listener, ts int64) {
 func (nlb *ListenerBase) AddErr(err error) { nlb.errs.Add(err) }
 func (nlb *ListenerBase) ErrCnt() int      { return nlb.errs.Cnt() }
 
+// AddNodeErr adds the error and attributes it to the node that reported it;
+// only the first error reported by a given node is retained
+func (nlb *ListenerBase) AddNodeErr(daeID string, err error) {
+	nlb.AddErr(err)
+	nlb.nerrMu.Lock()
+	if nlb.NodeErrsX == nil {
+		nlb.NodeErrsX = make(cos.StrKVs, 4)
+	}
+	if _, ok := nlb.NodeErrsX[daeID]; !ok {
+		nlb.NodeErrsX[daeID] = err.Error()
+	}
+	nlb.nerrMu.Unlock()
+}
+
+// returns a copy (or nil)
+func (nlb *ListenerBase) NodeErrs() (errs cos.StrKVs) {
+	nlb.nerrMu.Lock()
+	if l := len(nlb.NodeErrsX); l > 0 {
+		errs = make(cos.StrKVs, l)
+		for daeID, msg := range nlb.NodeErrsX {
+			errs[daeID] = msg
+		}
+	}
+	nlb.nerrMu.Unlock()
+	return errs
+}
+
 func (nlb *ListenerBase) Err() error {
 	if nlb.ErrCnt() == 0 {
-		return nil
+		return nlb.nodeErr() // e.g., restored upon failover
 	}
 	return &nlb.errs
 }
 
+func (nlb *ListenerBase) nodeErr() error {
+	errs := nlb.NodeErrs()
+	if len(errs) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(errs))
+	for daeID := range errs {
+		ids = append(ids, daeID)
+	}
+	sort.Strings(ids)
+	var sb strings.Builder
+	for i, daeID := range ids {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(daeID)
+		sb.WriteString(": ")
+		sb.WriteString(errs[daeID])
+	}
+	return errors.New(sb.String())
+}
+
 func (nlb *ListenerBase) SetStats(daeID string, stats any) {
 	_, ok := nlb.Srcs[daeID]
 	debug.Assert(ok)
@@ -186,6 +384,7 @@ func (nlb *ListenerBase) SetStats(daeID string, stats any) {
 		nlb.lastUpdated = make(map[string]int64, len(nlb.Srcs))
 	}
 	nlb.lastUpdated[daeID] = mono.NanoTime()
+	nlb.sampleRate()
 }
 
 func (nlb *ListenerBase) LastUpdated(si *meta.Snode) int64 {
@@ -215,7 +414,30 @@ func (nlb *ListenerBase) NodesTardy(periodicNotifTime time.Duration) (nodes meta
 }
 
 func (nlb *ListenerBase) Status() *Status {
-	return &Status{Kind: nlb.Kind(), UUID: nlb.UUID(), EndTimeX: nlb.EndTimeX.Load(), AbortedX: nlb.Aborted()}
+	status := &Status{
+		Kind:       nlb.Kind(),
+		UUID:       nlb.UUID(),
+		StartTimeX: nlb.StartTime(),
+		EndTimeX:   nlb.EndTimeX.Load(),
+		AbortedX:   nlb.Aborted(),
+		NodeErrs:   nlb.NodeErrs(),
+		Trail:      nlb.Trail(),
+	}
+	if !nlb.Finished() {
+		rate, eta := nlb.Rate()
+		status.Rate, status.ETA = rate, int64(eta)
+	}
+	return status
+}
+
+// generic fallback (compare w/ kind-specific implementations, e.g. xact.NotifXactListener)
+func (nlb *ListenerBase) Describe() string {
+	return nlb.Kind() + ": " + FinDesc(nlb)
+}
+
+// e.g. "2/3 nodes finished"
+func FinDesc(nlb *ListenerBase) string {
+	return strconv.Itoa(nlb.FinCount()) + "/" + strconv.Itoa(len(nlb.Notifiers())) + " nodes finished"
 }
 
 func (nlb *ListenerBase) _name(l int) *strings.Builder {
@@ -283,6 +505,18 @@ func (nlb *ListenerBase) String() string {
 func (ns *Status) Finished() bool { return ns.EndTimeX > 0 }
 func (ns *Status) Aborted() bool  { return ns.AbortedX }
 
+// e.g. "42%", or "~42%" when approximate; empty when unknown
+func (ns *Status) PctString() string {
+	if ns.Pct <= 0 {
+		return ""
+	}
+	s := strconv.FormatFloat(ns.Pct, 'f', 0, 64) + "%"
+	if ns.PctApprox {
+		s = "~" + s
+	}
+	return s
+}
+
 func (ns *Status) String() (s string) {
 	s = ns.Kind + "[" + ns.UUID + "]"
 	switch {
@@ -0,0 +1,38 @@
+// Package nl provides interfaces for AIStore notifications
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package nl
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testListener struct{ ListenerBase }
+
+func (*testListener) UnmarshalStats([]byte) (any, bool, bool, error) { return nil, false, false, nil }
+func (*testListener) QueryArgs() cmn.HreqArgs                        { return cmn.HreqArgs{} }
+
+func TestMerge(t *testing.T) {
+	var (
+		t1, t2, t3 = &meta.Snode{DaeID: "t1"}, &meta.Snode{DaeID: "t2"}, &meta.Snode{DaeID: "t3"}
+		srcs       = meta.NodeMap{"t1": t1, "t2": t2}
+		nlb        = NewNLB("uuid", "kind", "", srcs, 0)
+		other      = &testListener{*NewNLB("uuid", "kind", "", meta.NodeMap{"t2": t2, "t3": t3}, 0)}
+	)
+	added := nlb.Merge(other)
+	tassert.Errorf(t, added == 1, "expected 1 added, got %d", added)
+	tassert.Errorf(t, len(nlb.Notifiers()) == 3 && nlb.ActiveCount() == 3, "expected 3 notifiers, all active, got (%d, %d)",
+		len(nlb.Notifiers()), nlb.ActiveCount())
+
+	// the map passed to NewNLB stays as is
+	tassert.Errorf(t, len(srcs) == 2 && !srcs.Contains("t3"), "caller's map modified: %v", srcs)
+
+	// idempotent
+	added = nlb.Merge(other)
+	tassert.Errorf(t, added == 0 && len(nlb.Notifiers()) == 3, "expected nothing added, got (%d, %d)", added, len(nlb.Notifiers()))
+}
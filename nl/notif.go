@@ -17,6 +17,7 @@ type (
 	Base struct {
 		F func(n core.Notif, err error, aborted bool) // notification callback
 		P func(n core.Notif)                          // on progress notification callback
+		S func(n core.Notif)                          // on started notification callback
 
 		Dsts []string // node IDs to notify
 
@@ -33,6 +34,7 @@ type (
 
 func (base *Base) OnFinishedCB() func(core.Notif, error, bool /*aborted*/) { return base.F }
 func (base *Base) OnProgressCB() func(core.Notif)                          { return base.P }
+func (base *Base) OnStartedCB() func(core.Notif)                           { return base.S }
 func (base *Base) Upon(u core.Upon) bool                                   { return base != nil && base.When&u != 0 }
 func (base *Base) Subscribers() []string                                   { return base.Dsts }
 func (base *Base) LastNotifTime() int64                                    { return base.lastNotified.Load() }
@@ -63,6 +65,15 @@ func OnProgress(n core.Notif) {
 	}
 }
 
+func OnStarted(n core.Notif) {
+	if n == nil || !n.Upon(core.UponStart) {
+		return
+	}
+	if cb := n.OnStartedCB(); cb != nil {
+		cb(n)
+	}
+}
+
 func OnFinished(n core.Notif, err error, aborted bool) {
 	if cb := n.OnFinishedCB(); cb != nil {
 		cb(n, err, aborted)
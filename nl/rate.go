@@ -0,0 +1,150 @@
+// Package nl provides interfaces for AIStore notifications
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package nl
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Instantaneous rate and ETA: listener keeps a short ring of recent (aggregated) progress
+// samples, one per `SetStats`; the rate is computed over the ring's window.
+
+const (
+	DfltRateWindow = 8  // number of progress samples (default)
+	MaxRateWindow  = 64 // (bounds memory per listener)
+
+	// same-round updates from different nodes coalesce into one sample
+	rateCoalesce = 100 * time.Millisecond
+)
+
+type (
+	// optionally implemented by node stats (e.g., xaction snapshot) to facilitate rate and ETA
+	Progress interface {
+		Progress() (done, total int64) // total: zero when unknown
+	}
+
+	rateSample struct {
+		ts    int64 // mono time
+		done  int64
+		total int64
+	}
+	rateRing struct {
+		samples []rateSample
+		next    int
+		cnt     int
+		mu      sync.Mutex
+	}
+)
+
+func newRateRing(window int) *rateRing {
+	window = min(max(window, 2), MaxRateWindow)
+	return &rateRing{samples: make([]rateSample, window)}
+}
+
+func (rr *rateRing) add(s rateSample) {
+	rr.mu.Lock()
+	if rr.cnt > 0 {
+		last := (rr.next - 1 + len(rr.samples)) % len(rr.samples)
+		if s.ts-rr.samples[last].ts < int64(rateCoalesce) {
+			rr.samples[last].done, rr.samples[last].total = s.done, s.total
+			rr.mu.Unlock()
+			return
+		}
+	}
+	rr.samples[rr.next] = s
+	rr.next = (rr.next + 1) % len(rr.samples)
+	rr.cnt = min(rr.cnt+1, len(rr.samples))
+	rr.mu.Unlock()
+}
+
+// returns (done units per second, estimated time to completion); zero(s) when cannot tell
+func (rr *rateRing) rate() (rate float64, eta time.Duration) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	if rr.cnt < 2 {
+		return 0, 0
+	}
+	var (
+		l     = len(rr.samples)
+		first = rr.samples[(rr.next-rr.cnt+l)%l]
+		last  = rr.samples[(rr.next-1+l)%l]
+		dt    = time.Duration(last.ts - first.ts)
+	)
+	if dt <= 0 || last.done <= first.done {
+		return 0, 0
+	}
+	rate = float64(last.done-first.done) / dt.Seconds()
+	if last.total > last.done {
+		eta = time.Duration(float64(last.total-last.done) / rate * float64(time.Second))
+	}
+	return rate, eta
+}
+
+//////////////////
+// ListenerBase //
+//////////////////
+
+// optional: override the default number of samples (`DfltRateWindow`); must be called prior to the first `SetStats`
+func (nlb *ListenerBase) SetRateWindow(window int) { nlb.rate.Store(newRateRing(window)) }
+
+// (caller must hold the lock)
+func (nlb *ListenerBase) sampleRate() {
+	var (
+		s  = rateSample{ts: mono.NanoTime()}
+		ok bool
+	)
+	nlb.Stats.Range(func(_ string, stats any) bool {
+		if p, is := stats.(Progress); is {
+			done, total := p.Progress()
+			s.done += done
+			s.total += total
+			ok = true
+		}
+		return true
+	})
+	if !ok {
+		return
+	}
+	rr := nlb.rate.Load()
+	if rr == nil {
+		rr = newRateRing(DfltRateWindow)
+		nlb.rate.Store(rr)
+	}
+	rr.add(s)
+}
+
+// Cluster-wide progress in percent: the sum of per-node done over the sum of per-node totals.
+// Approximate when some of the notifiers do not (or did not yet) report their totals;
+// zero when none does. (Caller must hold the rlock.)
+func (nlb *ListenerBase) Pct() (pct float64, approx bool) {
+	var (
+		done, total int64
+		cnt         int
+	)
+	nlb.Stats.Range(func(_ string, stats any) bool {
+		if p, is := stats.(Progress); is {
+			if d, t := p.Progress(); t > 0 {
+				done += min(d, t)
+				total += t
+				cnt++
+			}
+		}
+		return true
+	})
+	if total == 0 {
+		return 0, false
+	}
+	return float64(done) * 100 / float64(total), cnt < len(nlb.Srcs)
+}
+
+func (nlb *ListenerBase) Rate() (float64, time.Duration) {
+	if rr := nlb.rate.Load(); rr != nil {
+		return rr.rate()
+	}
+	return 0, 0
+}
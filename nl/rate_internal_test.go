@@ -0,0 +1,67 @@
+// Package nl provides interfaces for AIStore notifications
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package nl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRateRing(t *testing.T) {
+	rr := newRateRing(3)
+	rate, eta := rr.rate()
+	tassert.Errorf(t, rate == 0 && eta == 0, "expected zeros with no samples, got %f, %v", rate, eta)
+
+	sec := int64(time.Second)
+	rr.add(rateSample{ts: 1 * sec, done: 0, total: 1000})
+	rr.add(rateSample{ts: 2 * sec, done: 100, total: 1000})
+	rate, eta = rr.rate()
+	tassert.Errorf(t, rate == 100, "expected 100/s, got %f", rate)
+	tassert.Errorf(t, eta == 9*time.Second, "expected 9s, got %v", eta)
+
+	// same-round update coalesces
+	rr.add(rateSample{ts: 2*sec + 1, done: 200, total: 1000})
+	tassert.Errorf(t, rr.cnt == 2, "expected 2 samples, got %d", rr.cnt)
+
+	// the oldest sample falls off the window: (200 => 800) in 2s
+	rr.add(rateSample{ts: 3 * sec, done: 500, total: 1000})
+	rr.add(rateSample{ts: 4 * sec, done: 800, total: 1000})
+	rate, eta = rr.rate()
+	tassert.Errorf(t, rate == 300, "expected 300/s, got %f", rate)
+	tassert.Errorf(t, eta > 0 && eta < time.Second, "expected sub-second ETA, got %v", eta)
+
+	// unknown total: rate only
+	rr = newRateRing(DfltRateWindow)
+	rr.add(rateSample{ts: 1 * sec, done: 10})
+	rr.add(rateSample{ts: 3 * sec, done: 30})
+	rate, eta = rr.rate()
+	tassert.Errorf(t, rate == 10 && eta == 0, "expected (10/s, 0), got (%f, %v)", rate, eta)
+}
+
+type testProgress struct{ done, total int64 }
+
+func (p testProgress) Progress() (int64, int64) { return p.done, p.total }
+
+func TestPct(t *testing.T) {
+	nlb := &ListenerBase{Srcs: meta.NodeMap{"t1": nil, "t2": nil, "t3": nil}, Stats: NewNodeStats()}
+	pct, approx := nlb.Pct()
+	tassert.Errorf(t, pct == 0 && !approx, "expected unknown, got (%f, %t)", pct, approx)
+
+	nlb.Stats.Store("t1", testProgress{done: 10, total: 100})
+	nlb.Stats.Store("t2", testProgress{done: 50}) // total unknown
+	pct, approx = nlb.Pct()
+	tassert.Errorf(t, pct == 10 && approx, "expected (10, approximate), got (%f, %t)", pct, approx)
+
+	nlb.Stats.Store("t2", testProgress{done: 50, total: 100})
+	nlb.Stats.Store("t3", testProgress{done: 300, total: 200}) // (overshoot)
+	pct, approx = nlb.Pct()
+	tassert.Errorf(t, pct == 65 && !approx, "expected (65, exact), got (%f, %t)", pct, approx)
+
+	status := &Status{Pct: pct, PctApprox: true}
+	tassert.Errorf(t, status.PctString() == "~65%", "unexpected %q", status.PctString())
+}
@@ -0,0 +1,84 @@
+// Package nl provides interfaces for AIStore notifications
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package nl
+
+import (
+	"slices"
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Trail: timestamped state transitions of a given listener (added, started, each notifier
+// finished, aborted, done) - a causal timeline for post-mortems that complements the (numeric) stats.
+// Replicated along with the listener (IC) and retained after it finishes.
+
+// state transitions
+const (
+	EvAdded    = "added"
+	EvStarted  = "started"  // first notifier started
+	EvFinished = "finished" // a given notifier finished
+	EvAborted  = "aborted"
+	EvDone     = "done"
+)
+
+// (bounds memory per listener when there are many notifiers)
+const MaxTrail = 32
+
+type (
+	Event struct {
+		Ev   string `json:"ev"`
+		Node string `json:"node,omitempty"` // notifier (daeID), if applicable
+		Ts   int64  `json:"ts"`             // Unix nano
+	}
+	// when full, keeps the first and the last MaxTrail/2 events and counts the ones in between
+	Trail struct {
+		Events  []Event `json:"events"`
+		Dropped int     `json:"dropped,omitempty"`
+		mu      sync.Mutex
+	}
+)
+
+func newTrail() *Trail { return &Trail{Events: make([]Event, 0, 8)} }
+
+// nil-safe (e.g., listener unmarshaled from an older peer)
+func (t *Trail) add(ev, node string, ts int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// once-only transitions (also, the ones that arrive replicated)
+	if ev != EvFinished {
+		for i := range t.Events {
+			if t.Events[i].Ev == ev {
+				return
+			}
+		}
+	}
+	if len(t.Events) >= MaxTrail {
+		t.Events = slices.Delete(t.Events, MaxTrail/2, MaxTrail/2+1)
+		t.Dropped++
+	}
+	t.Events = append(t.Events, Event{Ev: ev, Node: node, Ts: ts})
+}
+
+// returns a copy (or nil)
+func (t *Trail) Snap() *Trail {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	snap := &Trail{Events: slices.Clone(t.Events), Dropped: t.Dropped}
+	t.mu.Unlock()
+	return snap
+}
+
+func (t *Trail) MarshalJSON() ([]byte, error) {
+	type trail Trail // (no methods)
+	snap := t.Snap()
+	return jsoniter.Marshal((*trail)(snap))
+}
@@ -0,0 +1,46 @@
+// Package nl provides interfaces for AIStore notifications
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package nl
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestTrail(t *testing.T) {
+	tr := newTrail()
+	tr.add(EvAdded, "", 1)
+	tr.add(EvStarted, "t1", 2)
+	tr.add(EvStarted, "t2", 3) // once-only
+	for i := range 100 {
+		tr.add(EvFinished, "t"+strconv.Itoa(i), int64(10+i))
+	}
+	tr.add(EvDone, "", 200)
+	tr.add(EvDone, "", 201) // e.g., replicated
+
+	snap := tr.Snap()
+	tassert.Fatalf(t, len(snap.Events) == MaxTrail, "expected %d events, got %d", MaxTrail, len(snap.Events))
+	tassert.Errorf(t, snap.Dropped == 103-MaxTrail, "expected %d dropped, got %d", 103-MaxTrail, snap.Dropped)
+	tassert.Errorf(t, snap.Events[0].Ev == EvAdded && snap.Events[1].Ev == EvStarted, "head not retained: %+v", snap.Events[:2])
+	last := snap.Events[len(snap.Events)-1]
+	tassert.Errorf(t, last.Ev == EvDone && last.Ts == 200, "tail not retained: %+v", last)
+	prev := snap.Events[len(snap.Events)-2]
+	tassert.Errorf(t, prev.Ev == EvFinished && prev.Node == "t99", "expected the last finished notifier, got %+v", prev)
+
+	// survives marshal/unmarshal
+	b, err := jsoniter.Marshal(tr)
+	tassert.CheckFatal(t, err)
+	out := &Trail{}
+	tassert.CheckFatal(t, jsoniter.Unmarshal(b, out))
+	tassert.Errorf(t, len(out.Events) == MaxTrail && out.Dropped == snap.Dropped, "round-trip mismatch: %s", string(b))
+
+	var none *Trail
+	none.add(EvAdded, "", 1) // no-op
+	tassert.Errorf(t, none.Snap() == nil, "expected nil")
+}
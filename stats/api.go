@@ -48,6 +48,7 @@ const (
 	VlabBucket    = "bucket"
 	VlabXkind     = "xkind"
 	VlabMountpath = "mountpath"
+	VlabJob       = "job" // job ID (bounded cardinality - see, e.g., ext/dload)
 )
 
 type (
@@ -172,6 +172,7 @@ var (
 	EmptyBckXlabs = map[string]string{VlabBucket: "", VlabXkind: ""}
 
 	mpathVlabs = []string{VlabMountpath}
+	JobVlabs   = []string{VlabJob}
 )
 
 var ignoreIdle = [...]string{"kalive", Uptime, "disk."}
@@ -347,6 +348,9 @@ func (r *runner) IncWith(name string, vlabs map[string]string) {
 	r.core.incWith(cos.NamedVal64{Name: name, Value: 1, VarLabs: vlabs})
 }
 
+// (ditto) - retire variable-labeled series when the corresponding (bounded) entity goes away
+func (r *runner) DelWith(name string, vlabs map[string]string) { r.core.delWith(name, vlabs) }
+
 // (ditto)
 func (r *runner) IncBck(name string, bck *cmn.Bck) {
 	r.IncWith(name, map[string]string{VlabBucket: bck.Cname("")})
@@ -99,6 +99,8 @@ func (s *coreStats) addWith(nv cos.NamedVal64) { s.add(nv.Name, nv.Value) }
 func (s *coreStats) inc(name string)           { s.add(name, 1) }    // (for the sake of Prometheus optimization)
 func (s *coreStats) incWith(nv cos.NamedVal64) { s.add(nv.Name, 1) } // ditto
 
+func (*coreStats) delWith(string, map[string]string) {} // no variable labels
+
 func (s *coreStats) add(name string, val int64) {
 	v, ok := s.Tracker[name]
 	debug.Assertf(ok, "invalid metric name %q", name)
@@ -109,7 +111,7 @@ func (s *coreStats) add(name string, val int64) {
 	case KindThroughput:
 		ratomic.AddInt64(&v.Value, val)
 		ratomic.AddInt64(&v.cumulative, val)
-	case KindCounter, KindSize, KindTotal:
+	case KindCounter, KindSize, KindTotal, KindGauge:
 		ratomic.AddInt64(&v.Value, val)
 	default:
 		debug.Assert(false, v.kind)
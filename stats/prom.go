@@ -170,6 +170,18 @@ func (s *coreStats) incWith(nv cos.NamedVal64) {
 	v.iadd.incWith(v, nv)
 }
 
+func (s *coreStats) delWith(name string, vlabs map[string]string) {
+	v, ok := s.Tracker[name]
+	debug.Assertf(ok, "invalid metric name %q", name)
+
+	switch vprom := v.iadd.(type) {
+	case counterVec:
+		vprom.Delete(vlabs)
+	case gaugeVec:
+		vprom.Delete(vlabs)
+	}
+}
+
 func (s *coreStats) updateUptime(d time.Duration) {
 	v := s.Tracker[Uptime]
 	ratomic.StoreInt64(&v.Value, d.Nanoseconds())
@@ -48,7 +48,8 @@ const (
 
 	ErrFSHCCount = errPrefix + "fshc.n"
 
-	ErrDloadCount = errPrefix + "dl.n"
+	ErrDloadCount    = errPrefix + "dl.n"
+	ErrDloadJobCount = errPrefix + "dl.job.n" // per download job (variable label: VlabJob)
 
 	// IO errors (must have ioErrPrefix)
 	IOErrGetCount    = ioErrPrefix + "get.n"
@@ -91,6 +92,11 @@ const (
 	// Downloader
 	DloadSize = "dl.size"
 
+	// Downloader: per job (variable label: VlabJob)
+	DloadJobSize     = "dl.job.size"
+	DloadJobCount    = "dl.job.n"
+	DloadJobInflight = "dl.job.inflight"
+
 	// KindThroughput
 	GetThroughput = "get.bps" // bytes per second
 	PutThroughput = "put.bps" // ditto
@@ -385,6 +391,12 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "downloader: number of download errors",
 		},
 	)
+	r.reg(snode, ErrDloadJobCount, KindCounter,
+		&Extra{
+			Help:    "downloader: number of download errors by a given job",
+			VarLabs: JobVlabs,
+		},
+	)
 
 	r.reg(snode, IOErrGetCount, KindCounter,
 		&Extra{
@@ -445,6 +457,24 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckVlabs,
 		},
 	)
+	r.reg(snode, DloadJobSize, KindSize,
+		&Extra{
+			Help:    "downloader: total downloaded size (bytes) by a given job",
+			VarLabs: JobVlabs,
+		},
+	)
+	r.reg(snode, DloadJobCount, KindCounter,
+		&Extra{
+			Help:    "downloader: number of objects finished (downloaded or skipped) by a given job",
+			VarLabs: JobVlabs,
+		},
+	)
+	r.reg(snode, DloadJobInflight, KindGauge,
+		&Extra{
+			Help:    "downloader: number of objects currently being downloaded by a given job",
+			VarLabs: JobVlabs,
+		},
+	)
 
 	// rate limit
 	r.reg(snode, RatelimGetRetryCount, KindCounter,
@@ -154,6 +154,17 @@ func (sb *Streams) Close(gracefully bool) {
 // when (nodes == nil) transmit via all established streams in a bundle
 // otherwise, restrict to the specified subset (nodes)
 func (sb *Streams) Send(obj *transport.Obj, roc cos.ReadOpenCloser, nodes ...*meta.Snode) error {
+	return sb.send(obj, roc, -1, nodes)
+}
+
+// same as Send but with multiple streams per destination (multiplier > 1) always selects
+// the same stream for a given key, thus preserving the send order for the key
+// (instead of round-robin that may reorder)
+func (sb *Streams) SendPinned(obj *transport.Obj, roc cos.ReadOpenCloser, key uint64, nodes ...*meta.Snode) error {
+	return sb.send(obj, roc, int(key%uint64(sb.multiplier)), nodes)
+}
+
+func (sb *Streams) send(obj *transport.Obj, roc cos.ReadOpenCloser, pin int, nodes []*meta.Snode) error {
 	debug.Assert(!transport.ReservedOpcode(obj.Hdr.Opcode))
 	streams := sb.get()
 
@@ -185,7 +196,7 @@ func (sb *Streams) Send(obj *transport.Obj, roc cos.ReadOpenCloser, nodes ...*me
 			if core.T.SID() == sid {
 				continue
 			}
-			if err := sb.sendOne(obj, roc, robin, idx, cnt); err != nil {
+			if err := sb.sendOne(obj, roc, robin, idx, cnt, pin); err != nil {
 				return err
 			}
 			idx++
@@ -205,7 +216,7 @@ func (sb *Streams) Send(obj *transport.Obj, roc cos.ReadOpenCloser, nodes ...*me
 		obj.SetPrc(cnt)
 		for idx, di := range nodes {
 			robin := streams[di.ID()]
-			if err := sb.sendOne(obj, roc, robin, idx, cnt); err != nil {
+			if err := sb.sendOne(obj, roc, robin, idx, cnt, pin); err != nil {
 				return err
 			}
 		}
@@ -273,8 +284,8 @@ func (sb *Streams) get() (bun bundle) {
 	return
 }
 
-// one obj, one stream
-func (sb *Streams) sendOne(obj *transport.Obj, roc cos.ReadOpenCloser, robin *robin, idx, cnt int) error {
+// one obj, one stream (pin >= 0 selects the stream, otherwise round-robin)
+func (sb *Streams) sendOne(obj *transport.Obj, roc cos.ReadOpenCloser, robin *robin, idx, cnt, pin int) error {
 	obj.Hdr.SID = core.T.SID()
 	one := obj
 	one.Reader = roc
@@ -294,7 +305,10 @@ func (sb *Streams) sendOne(obj *transport.Obj, roc cos.ReadOpenCloser, robin *ro
 	}
 snd:
 	i := 0
-	if sb.multiplier > 1 {
+	switch {
+	case pin >= 0:
+		i = pin % len(robin.stsdest)
+	case sb.multiplier > 1:
 		i = int(robin.i.Inc()) % len(robin.stsdest)
 	}
 	s := robin.stsdest[i]
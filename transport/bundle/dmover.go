@@ -275,6 +275,15 @@ func (dm *DM) Send(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode)
 	return
 }
 
+// see Streams.SendPinned
+func (dm *DM) SendPinned(obj *transport.Obj, roc cos.ReadOpenCloser, key uint64, tsi *meta.Snode) (err error) {
+	err = dm.data.streams.SendPinned(obj, roc, key, tsi)
+	if err == nil && !transport.ReservedOpcode(obj.Hdr.Opcode) {
+		dm.xctn.OutObjsAdd(1, obj.Size())
+	}
+	return
+}
+
 func (dm *DM) ACK(hdr *transport.ObjHdr, cb transport.ObjSentCB, tsi *meta.Snode) error {
 	return dm.ack.streams.Send(&transport.Obj{Hdr: *hdr, Callback: cb}, nil, tsi)
 }
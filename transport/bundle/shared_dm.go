@@ -6,12 +6,14 @@
 package bundle
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -19,6 +21,8 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact"
+
+	onexxh "github.com/OneOfOne/xxhash"
 )
 
 // TODO -- FIXME:
@@ -29,9 +33,12 @@ const Sepa = "|"
 // - Close() vs usage (when len(rxcbs) > 0); provide xctn.onFinished() => UnregRecv
 // - limitation: hdr.Opaque is exclusively reserved xaction ID
 
+const dfltSDMName = "shared-dm"
+
 type sharedDM struct {
 	dm    DM
 	rxcbs map[string]transport.RecvObj
+	name  string // transport name (default: `dfltSDMName`)
 	ocmu  sync.Mutex
 	rxmu  sync.Mutex
 }
@@ -39,10 +46,19 @@ type sharedDM struct {
 // global
 var SDM sharedDM
 
-// called upon target startup
-func InitSDM(config *cmn.Config, compression string) {
+// called upon target startup; empty `trname` translates as the default
+func InitSDM(config *cmn.Config, compression, trname string) error {
+	if trname == "" {
+		trname = dfltSDMName
+	}
+	// (used as URL path element; note that CheckAlphaPlus also excludes `Sepa`)
+	if err := cos.CheckAlphaPlus(trname, "shared-dm transport name"); err != nil {
+		return err
+	}
+	SDM.name = trname
 	extra := Extra{Config: config, Compression: compression}
 	SDM.dm.init(SDM.trname(), SDM.recv, cmn.OwtNone, extra)
+	return nil
 }
 
 func (sdm *sharedDM) isOpen() bool { return sdm.dm.stage.opened.Load() }
@@ -54,15 +70,55 @@ func (sdm *sharedDM) IsActive() (active bool) {
 	return
 }
 
-// constant (until and unless we run multiple shared-DMs)
-func (*sharedDM) trname() string { return "shared-dm" }
+// (set once upon startup)
+func (sdm *sharedDM) trname() string { return sdm.name }
 
 func (sdm *sharedDM) _already() {
 	nlog.WarningDepth(2, core.T.String(), sdm.trname(), "is already open")
 }
 
 // called on-demand
-func (sdm *sharedDM) Open() error {
+func (sdm *sharedDM) Open() error { return sdm._open(nil) }
+
+// (OpenCtx states)
+const (
+	openPending = iota
+	openDone
+	openAbandoned
+)
+
+// OpenCtx is Open that stops waiting when the context is canceled or expires;
+// an attempt that completes after that gets rolled back, so that the next Open starts clean
+func (sdm *sharedDM) OpenCtx(ctx context.Context) error {
+	if sdm.isOpen() {
+		sdm._already()
+		return nil
+	}
+	var (
+		state atomic.Int32 // openPending
+		errCh = make(chan error, 1)
+	)
+	go func() {
+		errCh <- sdm._open(&state)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		if !state.CAS(openPending, openAbandoned) {
+			return <-errCh // completed in the meantime
+		}
+		if sdm.isOpen() {
+			// opened by another (concurrent) caller - nothing to roll back
+			return nil
+		}
+		err := fmt.Errorf("%s: failed to open %s: %w", core.T, sdm.trname(), context.Cause(ctx))
+		nlog.ErrorDepth(1, err)
+		return err
+	}
+}
+
+func (sdm *sharedDM) _open(state *atomic.Int32) error {
 	if sdm.isOpen() {
 		sdm._already()
 		return nil
@@ -80,18 +136,36 @@ func (sdm *sharedDM) Open() error {
 	sdm.rxmu.Unlock()
 
 	if err := sdm.dm.RegRecv(); err != nil {
+		sdm._rollback(false /*unreg*/)
 		sdm.ocmu.Unlock()
-		nlog.ErrorDepth(1, core.T.String(), err)
+		nlog.ErrorDepth(2, core.T.String(), err)
 		debug.AssertNoErr(err)
 		return err
 	}
+	if state != nil && !state.CAS(openPending, openDone) {
+		// abandoned by OpenCtx
+		sdm._rollback(true /*unreg*/)
+		sdm.ocmu.Unlock()
+		nlog.WarningDepth(2, core.T.String(), "rolled back late open", sdm.trname())
+		return fmt.Errorf("%s: open %s abandoned", core.T, sdm.trname())
+	}
 	sdm.dm.Open()
 	sdm.ocmu.Unlock()
 
-	nlog.InfoDepth(1, core.T.String(), "open", sdm.trname())
+	nlog.InfoDepth(2, core.T.String(), "open", sdm.trname())
 	return nil
 }
 
+// under ocmu lock
+func (sdm *sharedDM) _rollback(unreg bool) {
+	sdm.rxmu.Lock()
+	sdm.rxcbs = nil
+	sdm.rxmu.Unlock()
+	if unreg {
+		sdm.dm.UnregRecv()
+	}
+}
+
 // nothing running + 10m inactivity
 func (sdm *sharedDM) Close() error {
 	if !sdm.isOpen() {
@@ -164,6 +238,21 @@ func (sdm *sharedDM) Send(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.
 	return sdm.dm.Send(obj, roc, tsi)
 }
 
+// SendOrdered guarantees per-xid ordering: all sends of a given xaction to a given destination
+// go over the same underlying stream (selected by xid hash), while different xactions still
+// spread across (multiplier) streams.
+// The tradeoff: a single "hot" xaction is limited to the throughput of one stream - use Send
+// when ordering is not required.
+// Note also that the order is the order of SendOrdered calls: concurrent senders of the same
+// xid must synchronize on their own.
+func (sdm *sharedDM) SendOrdered(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode) error {
+	xid := cos.UnsafeS(obj.Hdr.Opaque)
+	if i := strings.Index(xid, Sepa); i > 0 {
+		xid = xid[:i]
+	}
+	return sdm.dm.SendPinned(obj, roc, onexxh.Checksum64S(cos.UnsafeB(xid), cos.MLCG32), tsi)
+}
+
 func (sdm *sharedDM) recv(hdr *transport.ObjHdr, r io.Reader, err error) error {
 	if err != nil {
 		return err
@@ -0,0 +1,71 @@
+// Package bundle provides multi-streaming transport with the functionality
+// to dynamically (un)register receive endpoints, establish long-lived flows, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package bundle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+func init() {
+	mock.NewTarget(nil)
+	transport.Init(mock.NewStatsTracker())
+}
+
+func newTestSDM(t *testing.T, trname string) *sharedDM {
+	sdm := &sharedDM{name: trname}
+	sdm.dm.init(trname, sdm.recv, cmn.OwtNone, Extra{Config: cmn.GCO.Get()})
+	return sdm
+}
+
+func TestSharedDMOpenCtx(t *testing.T) {
+	// the open is blocked (ocmu held) past the deadline: the late attempt gets rolled back
+	sdm := newTestSDM(t, "sdm-timeout")
+	sdm.rxcbs = map[string]transport.RecvObj{} // (non-nil until rolled back)
+	sdm.ocmu.Lock()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	err := sdm.OpenCtx(ctx)
+	cancel()
+	tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expected deadline exceeded, got %v", err)
+	sdm.ocmu.Unlock()
+
+	for i := 0; ; i++ {
+		sdm.rxmu.Lock()
+		done := sdm.rxcbs == nil
+		sdm.rxmu.Unlock()
+		if done {
+			break
+		}
+		tassert.Fatalf(t, i < 1000, "late open was not rolled back")
+		time.Sleep(time.Millisecond)
+	}
+	sdm.ocmu.Lock() // (wait for the rollback to complete)
+	tassert.Errorf(t, !sdm.dm.stage.regged.Load() && !sdm.isOpen(), "expected clean state after rollback")
+	sdm.ocmu.Unlock()
+	// the next open starts clean: the endpoint is not registered
+	tassert.CheckError(t, sdm.dm.RegRecv())
+	sdm.dm.UnregRecv()
+
+	// opened concurrently (by another caller) while this one was waiting: not an error
+	sdm = newTestSDM(t, "sdm-concurrent")
+	sdm.ocmu.Lock()
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		sdm.dm.stage.opened.Store(true) // (simulate)
+		cancel()
+	}()
+	err = sdm.OpenCtx(ctx)
+	tassert.CheckError(t, err)
+	sdm.ocmu.Unlock()
+}
@@ -66,6 +66,7 @@ func (*dummyStatsTracker) Inc(string)
 func (*dummyStatsTracker) Get(string) int64                                          { return 0 }
 func (*dummyStatsTracker) AddWith(...cos.NamedVal64)                                 {}
 func (*dummyStatsTracker) IncWith(string, map[string]string)                         {}
+func (*dummyStatsTracker) DelWith(string, map[string]string)                         {}
 func (*dummyStatsTracker) ClrFlag(string, cos.NodeStateFlags)                        {}
 func (*dummyStatsTracker) SetFlag(string, cos.NodeStateFlags)                        {}
 func (*dummyStatsTracker) SetClrFlag(string, cos.NodeStateFlags, cos.NodeStateFlags) {}
@@ -7,6 +7,7 @@ package xact
 import (
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -63,6 +64,25 @@ func (*NotifXactListener) UnmarshalStats(rawMsg []byte) (stats any, finished, ab
 	return
 }
 
+// e.g. "copy-objects: 900 objects (1.2GiB), 2/3 nodes finished"
+func (nxb *NotifXactListener) Describe() string {
+	var objs, size int64
+	nxb.Stats.Range(func(_ string, stats any) bool {
+		snap, ok := stats.(*core.Snap)
+		if !ok {
+			snap = &core.Snap{}
+			if err := cos.MorphMarshal(stats, snap); err != nil {
+				return true
+			}
+		}
+		objs += snap.Stats.Objs
+		size += snap.Stats.Bytes
+		return true
+	})
+	return nxb.Kind() + ": " + strconv.FormatInt(objs, 10) + " object" + cos.Plural(int(objs)) +
+		" (" + cos.ToSizeIEC(size, 1) + "), " + nl.FinDesc(&nxb.ListenerBase)
+}
+
 func (nxb *NotifXactListener) QueryArgs() cmn.HreqArgs {
 	args := cmn.HreqArgs{Method: http.MethodGet, Query: make(url.Values, 2)}
 	args.Query.Set(apc.QparamWhat, apc.WhatXactStats)